@@ -1,15 +1,31 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"GopherChessParty/internal/config"
+	"GopherChessParty/internal/eventbus"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/keyring"
 	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/mailer"
+	"GopherChessParty/internal/oidc"
 	"GopherChessParty/internal/repository"
 	"GopherChessParty/internal/routers"
 	"GopherChessParty/internal/services"
 )
 
+// shutdownTimeout время на завершение активных запросов при остановке
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	// Загрузка конфига
 	cfg := config.MustLoad()
@@ -17,26 +33,145 @@ func main() {
 	// Создание Логгера
 	log := logger.New(cfg.Application)
 
-	// Подключение к базе данных и создание репозитория
-	connection := repository.MustNewConnection(cfg.Database)
+	// Подкоманда migrate работает со схемой базы и не запускает сервер
+	if args := flag.Args(); len(args) > 0 && args[0] == "migrate" {
+		if err := runMigrate(log, cfg, args[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Проверка схемы базы и применение миграций при запуске
+	mustMigrate(log, cfg)
+
+	// Подключение к базе данных и создание репозиториев: memory работает без базы
+	var connection *repository.Connection
+	var userRepo interfaces.IUserRepo
+	var gameRepo interfaces.IGameRepo
+	var sessionRepo interfaces.ISessionRepo
+	var twoFactorRepo interfaces.ITwoFactorRepo
+	var userTokenRepo interfaces.IUserTokenRepo
+	var loginFailureRepo interfaces.ILoginFailureRepo
+	var personalTokenRepo interfaces.IPersonalTokenRepo
+	var challengeRepo interfaces.IChallengeRepo
+	var identityRepo interfaces.IIdentityRepo
+	if cfg.Database.Driver == "memory" {
+		memoryUsers := repository.NewMemoryUserRepository()
+		userRepo = memoryUsers
+		gameRepo = repository.NewMemoryGameRepository(memoryUsers)
+		sessionRepo = repository.NewMemorySessionRepository(memoryUsers)
+		twoFactorRepo = repository.NewMemoryTwoFactorRepository(memoryUsers)
+		userTokenRepo = repository.NewMemoryUserTokenRepository(memoryUsers)
+		loginFailureRepo = repository.NewMemoryLoginFailureRepository()
+		personalTokenRepo = repository.NewMemoryPersonalTokenRepository(memoryUsers)
+		challengeRepo = repository.NewMemoryChallengeRepository(memoryUsers)
+		identityRepo = repository.NewMemoryIdentityRepository(memoryUsers)
+	} else {
+		connection = repository.MustNewConnection(cfg.Database)
+		userRepo = repository.NewUserRepository(log, connection)
+		gameRepo = repository.NewGameRepository(log, connection)
+		sessionRepo = repository.NewSessionRepository(log, connection)
+		twoFactorRepo = repository.NewTwoFactorRepository(log, connection)
+		userTokenRepo = repository.NewUserTokenRepository(log, connection)
+		loginFailureRepo = repository.NewLoginFailureRepository(log, connection)
+		personalTokenRepo = repository.NewPersonalTokenRepository(log, connection)
+		challengeRepo = repository.NewChallengeRepository(log, connection)
+		identityRepo = repository.NewIdentityRepository(log, connection)
+	}
+
+	// Шина событий, аренды партий и очередь поиска: в памяти для одного узла, PostgreSQL для нескольких
+	var bus interfaces.IEventBus
+	var leaseRepo interfaces.ILeaseRepo
+	var queueRepo interfaces.IQueueRepo
+	switch cfg.Cluster.Bus {
+	case "memory":
+		bus = eventbus.NewMemoryBus(log)
+		queueRepo = repository.NewMemoryQueue()
+	case "postgres":
+		if cfg.Database.Driver != "postgres" {
+			panic("postgres cluster bus requires the postgres database driver")
+		}
+		bus = eventbus.MustNewPostgresBus(log, cfg.Database)
+		leaseRepo = repository.NewLeaseRepository(log, connection)
+		queueRepo = repository.NewQueueRepository(log, connection)
+	default:
+		panic(fmt.Sprintf("unknown cluster bus %q", cfg.Cluster.Bus))
+	}
 
-	// Создание репозиториев
-	userRepo := repository.NewUserRepository(log, connection)
-	gameRepo := repository.NewGameRepository(log, connection)
+	// Ключи подписи токенов: сменённый ключ проверяет токены, пока не истекут выданные им refresh-токены
+	var keys interfaces.IKeyring
+	if cfg.Auth.KeysDir != "" {
+		keys = keyring.MustNew(log, cfg.Auth.KeysDir, cfg.Auth.ExpRefresh)
+	}
+
+	// Отправка писем: SMTP или, для локальной разработки, в файлы или лог
+	mail := mailer.MustNew(log, cfg.Mailer, cfg.Application.Env)
+
+	// Провайдеры входа OpenID Connect
+	providers := make([]interfaces.IIdentityProvider, 0, len(cfg.OIDC.Providers))
+	for _, provider := range cfg.OIDC.Providers {
+		providers = append(providers, oidc.MustNew(provider))
+	}
 
 	// Создание сервиса
 	userService := services.NewUserService(log, userRepo)
-	gameService := services.NewGameService(log, gameRepo)
-	authService := services.NewAuthService(log, cfg.Auth)
-	matchService := services.NewMatchService(log)
+	gameService := services.NewGameService(log, gameRepo, cfg.Game)
+	authService := services.NewAuthService(log, cfg.Auth, sessionRepo, userRepo, keys)
+	clusterService := services.NewClusterService(log, bus, leaseRepo, cfg.Cluster)
+	matchService := services.NewMatchService(log, queueRepo, clusterService.NodeID())
+	twoFactorService := services.NewTwoFactorService(log, twoFactorRepo)
+	accountService := services.NewAccountService(log, userTokenRepo, mail, cfg.Mailer)
+	loginThrottleService := services.NewLoginThrottleService(log, loginFailureRepo)
+	personalTokenService := services.NewPersonalTokenService(log, personalTokenRepo)
+	challengeService := services.NewChallengeService(log, challengeRepo)
+	oidcService := services.NewOIDCService(log, identityRepo, providers)
+
+	service := services.NewService(
+		userService,
+		gameService,
+		authService,
+		matchService,
+		clusterService,
+		twoFactorService,
+		accountService,
+		loginThrottleService,
+		personalTokenService,
+		challengeService,
+		oidcService,
+		log,
+	)
 
-	service := services.NewService(userService, gameService, authService, matchService, log)
+	// Восстановление идущих партий после перезапуска
+	service.RecoverGames()
+
+	// Назначение администраторов из конфига
+	service.BootstrapAdmins(context.Background(), cfg.Auth.Admins)
 
 	// Создание экземпляра Gin
-	router := routers.New(service, log)
+	router := routers.New(service, log, cfg.Application)
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.Application.Port),
+		Handler: router,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		err := server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	<-ctx.Done()
 
-	err := router.Run(fmt.Sprintf(":%d", cfg.Application.Port))
-	if err != nil {
-		panic(err)
+	// Плавная остановка: сокеты получают сообщение о перезапуске, аренды партий освобождаются
+	log.Info("shutting down")
+	service.Shutdown()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Error(err)
 	}
+	_ = bus.Close()
 }
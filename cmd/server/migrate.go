@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	exc "errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"GopherChessParty/internal/config"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/migrator"
+)
+
+const migrateUsage = "usage: server [--config path] migrate status|up [n]|down [n]"
+
+// runMigrate выполняет подкоманду migrate: status показывает состояние схемы,
+// up применяет n ожидающих миграций (все по умолчанию), down откатывает n последних (одну по умолчанию)
+func runMigrate(log interfaces.ILogger, cfg *config.Config, args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("%s", migrateUsage)
+	}
+	n := 0
+	if args[0] == "down" {
+		n = 1
+	}
+	if len(args) == 2 {
+		var err error
+		if n, err = strconv.Atoi(args[1]); err != nil || n <= 0 {
+			return fmt.Errorf("invalid migration count %q: %s", args[1], migrateUsage)
+		}
+	}
+	if cfg.Database.Driver != "postgres" {
+		return fmt.Errorf("migrate supports only the postgres driver, got %q", cfg.Database.Driver)
+	}
+
+	ctx := context.Background()
+	m := migrator.MustNew(log, cfg.Database)
+	defer m.Close()
+	switch args[0] {
+	case "status":
+		return printMigrationStatus(ctx, m)
+	case "up":
+		applied, err := m.Up(ctx, n)
+		fmt.Printf("applied %d migrations\n", applied)
+		return err
+	case "down":
+		reverted, err := m.Down(ctx, n)
+		fmt.Printf("reverted %d migrations\n", reverted)
+		return err
+	default:
+		return fmt.Errorf("unknown migrate command %q: %s", args[0], migrateUsage)
+	}
+}
+
+func printMigrationStatus(ctx context.Context, m *migrator.Migrator) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tDESCRIPTION\tSTATE\tEXECUTED AT")
+	for _, migration := range status.Migrations {
+		state, executedAt := "pending", ""
+		if migration.Applied {
+			state, executedAt = "applied", migration.ExecutedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%s\t%s\n",
+			migration.Version,
+			migration.Description,
+			state,
+			executedAt,
+		)
+	}
+	for _, version := range status.Unknown {
+		fmt.Fprintf(w, "%s\t\tunknown\t\n", version)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("current: %s, expected: %s\n", status.Current, status.Expected)
+	if err := m.Check(ctx); err != nil {
+		fmt.Println(err)
+	}
+	return nil
+}
+
+// mustMigrate применяет ожидающие миграции, если это включено в конфиге, и отказывается запускать
+// сервер на схеме, которая новее бинарника или разошлась с ним. Если применение при запуске
+// выключено, отставшая схема только попадает в лог: миграции применяет оператор.
+func mustMigrate(log interfaces.ILogger, cfg *config.Config) {
+	if cfg.Database.Driver != "postgres" {
+		return
+	}
+	ctx := context.Background()
+	m := migrator.MustNew(log, cfg.Database)
+	defer m.Close()
+	if cfg.Database.MigrateOnStart {
+		if _, err := m.Up(ctx, 0); err != nil {
+			panic(err)
+		}
+	}
+	err := m.Check(ctx)
+	if exc.Is(err, errors.ErrSchemaBehind) && !cfg.Database.MigrateOnStart {
+		log.Warn("database schema is behind the binary", "error", err.Error())
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+}
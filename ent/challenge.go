@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/ent/user"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Challenge is the model entity for the Challenge schema.
+type Challenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ChallengerID holds the value of the "challenger_id" field.
+	ChallengerID uuid.UUID `json:"challenger_id,omitempty"`
+	// DestUserID holds the value of the "dest_user_id" field.
+	DestUserID uuid.UUID `json:"dest_user_id,omitempty"`
+	// Color holds the value of the "color" field.
+	Color challenge.Color `json:"color,omitempty"`
+	// Status holds the value of the "status" field.
+	Status challenge.Status `json:"status,omitempty"`
+	// GameID holds the value of the "game_id" field.
+	GameID *uuid.UUID `json:"game_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChallengeQuery when eager-loading is set.
+	Edges        ChallengeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChallengeEdges holds the relations/edges for other nodes in the graph.
+type ChallengeEdges struct {
+	// Challenger holds the value of the challenger edge.
+	Challenger *User `json:"challenger,omitempty"`
+	// DestUser holds the value of the dest_user edge.
+	DestUser *User `json:"dest_user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ChallengerOrErr returns the Challenger value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChallengeEdges) ChallengerOrErr() (*User, error) {
+	if e.Challenger != nil {
+		return e.Challenger, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "challenger"}
+}
+
+// DestUserOrErr returns the DestUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChallengeEdges) DestUserOrErr() (*User, error) {
+	if e.DestUser != nil {
+		return e.DestUser, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "dest_user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Challenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case challenge.FieldGameID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case challenge.FieldColor, challenge.FieldStatus:
+			values[i] = new(sql.NullString)
+		case challenge.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case challenge.FieldID, challenge.FieldChallengerID, challenge.FieldDestUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Challenge fields.
+func (c *Challenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case challenge.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case challenge.FieldChallengerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field challenger_id", values[i])
+			} else if value != nil {
+				c.ChallengerID = *value
+			}
+		case challenge.FieldDestUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field dest_user_id", values[i])
+			} else if value != nil {
+				c.DestUserID = *value
+			}
+		case challenge.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				c.Color = challenge.Color(value.String)
+			}
+		case challenge.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = challenge.Status(value.String)
+			}
+		case challenge.FieldGameID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field game_id", values[i])
+			} else if value.Valid {
+				c.GameID = new(uuid.UUID)
+				*c.GameID = *value.S.(*uuid.UUID)
+			}
+		case challenge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Challenge.
+// This includes values selected through modifiers, order, etc.
+func (c *Challenge) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryChallenger queries the "challenger" edge of the Challenge entity.
+func (c *Challenge) QueryChallenger() *UserQuery {
+	return NewChallengeClient(c.config).QueryChallenger(c)
+}
+
+// QueryDestUser queries the "dest_user" edge of the Challenge entity.
+func (c *Challenge) QueryDestUser() *UserQuery {
+	return NewChallengeClient(c.config).QueryDestUser(c)
+}
+
+// Update returns a builder for updating this Challenge.
+// Note that you need to call Challenge.Unwrap() before calling this method if this Challenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Challenge) Update() *ChallengeUpdateOne {
+	return NewChallengeClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Challenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Challenge) Unwrap() *Challenge {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Challenge is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Challenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("Challenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("challenger_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.ChallengerID))
+	builder.WriteString(", ")
+	builder.WriteString("dest_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.DestUserID))
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(fmt.Sprintf("%v", c.Color))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", ")
+	if v := c.GameID; v != nil {
+		builder.WriteString("game_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Challenges is a parsable slice of Challenge.
+type Challenges []*Challenge
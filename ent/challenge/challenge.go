@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package challenge
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the challenge type in the database.
+	Label = "challenge"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChallengerID holds the string denoting the challenger_id field in the database.
+	FieldChallengerID = "challenger_id"
+	// FieldDestUserID holds the string denoting the dest_user_id field in the database.
+	FieldDestUserID = "dest_user_id"
+	// FieldColor holds the string denoting the color field in the database.
+	FieldColor = "color"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldGameID holds the string denoting the game_id field in the database.
+	FieldGameID = "game_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeChallenger holds the string denoting the challenger edge name in mutations.
+	EdgeChallenger = "challenger"
+	// EdgeDestUser holds the string denoting the dest_user edge name in mutations.
+	EdgeDestUser = "dest_user"
+	// Table holds the table name of the challenge in the database.
+	Table = "challenges"
+	// ChallengerTable is the table that holds the challenger relation/edge.
+	ChallengerTable = "challenges"
+	// ChallengerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ChallengerInverseTable = "users"
+	// ChallengerColumn is the table column denoting the challenger relation/edge.
+	ChallengerColumn = "challenger_id"
+	// DestUserTable is the table that holds the dest_user relation/edge.
+	DestUserTable = "challenges"
+	// DestUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	DestUserInverseTable = "users"
+	// DestUserColumn is the table column denoting the dest_user relation/edge.
+	DestUserColumn = "dest_user_id"
+)
+
+// Columns holds all SQL columns for challenge fields.
+var Columns = []string{
+	FieldID,
+	FieldChallengerID,
+	FieldDestUserID,
+	FieldColor,
+	FieldStatus,
+	FieldGameID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Color defines the type for the "color" enum field.
+type Color string
+
+// ColorRandom is the default value of the Color enum.
+const DefaultColor = ColorRandom
+
+// Color values.
+const (
+	ColorWhite  Color = "white"
+	ColorBlack  Color = "black"
+	ColorRandom Color = "random"
+)
+
+func (c Color) String() string {
+	return string(c)
+}
+
+// ColorValidator is a validator for the "color" field enum values. It is called by the builders before save.
+func ColorValidator(c Color) error {
+	switch c {
+	case ColorWhite, ColorBlack, ColorRandom:
+		return nil
+	default:
+		return fmt.Errorf("challenge: invalid enum value for color field: %q", c)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusCreated is the default value of the Status enum.
+const DefaultStatus = StatusCreated
+
+// Status values.
+const (
+	StatusCreated  Status = "created"
+	StatusAccepted Status = "accepted"
+	StatusDeclined Status = "declined"
+	StatusCanceled Status = "canceled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusCreated, StatusAccepted, StatusDeclined, StatusCanceled:
+		return nil
+	default:
+		return fmt.Errorf("challenge: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Challenge queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChallengerID orders the results by the challenger_id field.
+func ByChallengerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChallengerID, opts...).ToFunc()
+}
+
+// ByDestUserID orders the results by the dest_user_id field.
+func ByDestUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestUserID, opts...).ToFunc()
+}
+
+// ByColor orders the results by the color field.
+func ByColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColor, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByGameID orders the results by the game_id field.
+func ByGameID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGameID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByChallengerField orders the results by challenger field.
+func ByChallengerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChallengerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDestUserField orders the results by dest_user field.
+func ByDestUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDestUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newChallengerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChallengerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ChallengerTable, ChallengerColumn),
+	)
+}
+func newDestUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DestUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DestUserTable, DestUserColumn),
+	)
+}
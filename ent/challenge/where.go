@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package challenge
+
+import (
+	"time"
+
+	"GopherChessParty/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldLTE(FieldID, id))
+}
+
+// ChallengerID applies equality check predicate on the "challenger_id" field. It's identical to ChallengerIDEQ.
+func ChallengerID(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldChallengerID, v))
+}
+
+// DestUserID applies equality check predicate on the "dest_user_id" field. It's identical to DestUserIDEQ.
+func DestUserID(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldDestUserID, v))
+}
+
+// GameID applies equality check predicate on the "game_id" field. It's identical to GameIDEQ.
+func GameID(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldGameID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChallengerIDEQ applies the EQ predicate on the "challenger_id" field.
+func ChallengerIDEQ(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldChallengerID, v))
+}
+
+// ChallengerIDNEQ applies the NEQ predicate on the "challenger_id" field.
+func ChallengerIDNEQ(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNEQ(FieldChallengerID, v))
+}
+
+// ChallengerIDIn applies the In predicate on the "challenger_id" field.
+func ChallengerIDIn(vs ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldIn(FieldChallengerID, vs...))
+}
+
+// ChallengerIDNotIn applies the NotIn predicate on the "challenger_id" field.
+func ChallengerIDNotIn(vs ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotIn(FieldChallengerID, vs...))
+}
+
+// DestUserIDEQ applies the EQ predicate on the "dest_user_id" field.
+func DestUserIDEQ(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldDestUserID, v))
+}
+
+// DestUserIDNEQ applies the NEQ predicate on the "dest_user_id" field.
+func DestUserIDNEQ(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNEQ(FieldDestUserID, v))
+}
+
+// DestUserIDIn applies the In predicate on the "dest_user_id" field.
+func DestUserIDIn(vs ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldIn(FieldDestUserID, vs...))
+}
+
+// DestUserIDNotIn applies the NotIn predicate on the "dest_user_id" field.
+func DestUserIDNotIn(vs ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotIn(FieldDestUserID, vs...))
+}
+
+// ColorEQ applies the EQ predicate on the "color" field.
+func ColorEQ(v Color) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldColor, v))
+}
+
+// ColorNEQ applies the NEQ predicate on the "color" field.
+func ColorNEQ(v Color) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNEQ(FieldColor, v))
+}
+
+// ColorIn applies the In predicate on the "color" field.
+func ColorIn(vs ...Color) predicate.Challenge {
+	return predicate.Challenge(sql.FieldIn(FieldColor, vs...))
+}
+
+// ColorNotIn applies the NotIn predicate on the "color" field.
+func ColorNotIn(vs ...Color) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotIn(FieldColor, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Challenge {
+	return predicate.Challenge(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// GameIDEQ applies the EQ predicate on the "game_id" field.
+func GameIDEQ(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldGameID, v))
+}
+
+// GameIDNEQ applies the NEQ predicate on the "game_id" field.
+func GameIDNEQ(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNEQ(FieldGameID, v))
+}
+
+// GameIDIn applies the In predicate on the "game_id" field.
+func GameIDIn(vs ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldIn(FieldGameID, vs...))
+}
+
+// GameIDNotIn applies the NotIn predicate on the "game_id" field.
+func GameIDNotIn(vs ...uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotIn(FieldGameID, vs...))
+}
+
+// GameIDGT applies the GT predicate on the "game_id" field.
+func GameIDGT(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldGT(FieldGameID, v))
+}
+
+// GameIDGTE applies the GTE predicate on the "game_id" field.
+func GameIDGTE(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldGTE(FieldGameID, v))
+}
+
+// GameIDLT applies the LT predicate on the "game_id" field.
+func GameIDLT(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldLT(FieldGameID, v))
+}
+
+// GameIDLTE applies the LTE predicate on the "game_id" field.
+func GameIDLTE(v uuid.UUID) predicate.Challenge {
+	return predicate.Challenge(sql.FieldLTE(FieldGameID, v))
+}
+
+// GameIDIsNil applies the IsNil predicate on the "game_id" field.
+func GameIDIsNil() predicate.Challenge {
+	return predicate.Challenge(sql.FieldIsNull(FieldGameID))
+}
+
+// GameIDNotNil applies the NotNil predicate on the "game_id" field.
+func GameIDNotNil() predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotNull(FieldGameID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Challenge {
+	return predicate.Challenge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasChallenger applies the HasEdge predicate on the "challenger" edge.
+func HasChallenger() predicate.Challenge {
+	return predicate.Challenge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChallengerTable, ChallengerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChallengerWith applies the HasEdge predicate on the "challenger" edge with a given conditions (other predicates).
+func HasChallengerWith(preds ...predicate.User) predicate.Challenge {
+	return predicate.Challenge(func(s *sql.Selector) {
+		step := newChallengerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDestUser applies the HasEdge predicate on the "dest_user" edge.
+func HasDestUser() predicate.Challenge {
+	return predicate.Challenge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DestUserTable, DestUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDestUserWith applies the HasEdge predicate on the "dest_user" edge with a given conditions (other predicates).
+func HasDestUserWith(preds ...predicate.User) predicate.Challenge {
+	return predicate.Challenge(func(s *sql.Selector) {
+		step := newDestUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Challenge) predicate.Challenge {
+	return predicate.Challenge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Challenge) predicate.Challenge {
+	return predicate.Challenge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Challenge) predicate.Challenge {
+	return predicate.Challenge(sql.NotPredicates(p))
+}
@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChallengeCreate is the builder for creating a Challenge entity.
+type ChallengeCreate struct {
+	config
+	mutation *ChallengeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetChallengerID sets the "challenger_id" field.
+func (cc *ChallengeCreate) SetChallengerID(u uuid.UUID) *ChallengeCreate {
+	cc.mutation.SetChallengerID(u)
+	return cc
+}
+
+// SetDestUserID sets the "dest_user_id" field.
+func (cc *ChallengeCreate) SetDestUserID(u uuid.UUID) *ChallengeCreate {
+	cc.mutation.SetDestUserID(u)
+	return cc
+}
+
+// SetColor sets the "color" field.
+func (cc *ChallengeCreate) SetColor(c challenge.Color) *ChallengeCreate {
+	cc.mutation.SetColor(c)
+	return cc
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (cc *ChallengeCreate) SetNillableColor(c *challenge.Color) *ChallengeCreate {
+	if c != nil {
+		cc.SetColor(*c)
+	}
+	return cc
+}
+
+// SetStatus sets the "status" field.
+func (cc *ChallengeCreate) SetStatus(c challenge.Status) *ChallengeCreate {
+	cc.mutation.SetStatus(c)
+	return cc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *ChallengeCreate) SetNillableStatus(c *challenge.Status) *ChallengeCreate {
+	if c != nil {
+		cc.SetStatus(*c)
+	}
+	return cc
+}
+
+// SetGameID sets the "game_id" field.
+func (cc *ChallengeCreate) SetGameID(u uuid.UUID) *ChallengeCreate {
+	cc.mutation.SetGameID(u)
+	return cc
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (cc *ChallengeCreate) SetNillableGameID(u *uuid.UUID) *ChallengeCreate {
+	if u != nil {
+		cc.SetGameID(*u)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *ChallengeCreate) SetCreatedAt(t time.Time) *ChallengeCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *ChallengeCreate) SetNillableCreatedAt(t *time.Time) *ChallengeCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *ChallengeCreate) SetID(u uuid.UUID) *ChallengeCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *ChallengeCreate) SetNillableID(u *uuid.UUID) *ChallengeCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// SetChallenger sets the "challenger" edge to the User entity.
+func (cc *ChallengeCreate) SetChallenger(u *User) *ChallengeCreate {
+	return cc.SetChallengerID(u.ID)
+}
+
+// SetDestUser sets the "dest_user" edge to the User entity.
+func (cc *ChallengeCreate) SetDestUser(u *User) *ChallengeCreate {
+	return cc.SetDestUserID(u.ID)
+}
+
+// Mutation returns the ChallengeMutation object of the builder.
+func (cc *ChallengeCreate) Mutation() *ChallengeMutation {
+	return cc.mutation
+}
+
+// Save creates the Challenge in the database.
+func (cc *ChallengeCreate) Save(ctx context.Context) (*Challenge, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *ChallengeCreate) SaveX(ctx context.Context) *Challenge {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *ChallengeCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *ChallengeCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *ChallengeCreate) defaults() {
+	if _, ok := cc.mutation.Color(); !ok {
+		v := challenge.DefaultColor
+		cc.mutation.SetColor(v)
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		v := challenge.DefaultStatus
+		cc.mutation.SetStatus(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := challenge.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := challenge.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *ChallengeCreate) check() error {
+	if _, ok := cc.mutation.ChallengerID(); !ok {
+		return &ValidationError{Name: "challenger_id", err: errors.New(`ent: missing required field "Challenge.challenger_id"`)}
+	}
+	if _, ok := cc.mutation.DestUserID(); !ok {
+		return &ValidationError{Name: "dest_user_id", err: errors.New(`ent: missing required field "Challenge.dest_user_id"`)}
+	}
+	if _, ok := cc.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "Challenge.color"`)}
+	}
+	if v, ok := cc.mutation.Color(); ok {
+		if err := challenge.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Challenge.color": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Challenge.status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := challenge.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Challenge.status": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Challenge.created_at"`)}
+	}
+	if len(cc.mutation.ChallengerIDs()) == 0 {
+		return &ValidationError{Name: "challenger", err: errors.New(`ent: missing required edge "Challenge.challenger"`)}
+	}
+	if len(cc.mutation.DestUserIDs()) == 0 {
+		return &ValidationError{Name: "dest_user", err: errors.New(`ent: missing required edge "Challenge.dest_user"`)}
+	}
+	return nil
+}
+
+func (cc *ChallengeCreate) sqlSave(ctx context.Context) (*Challenge, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *ChallengeCreate) createSpec() (*Challenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Challenge{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(challenge.Table, sqlgraph.NewFieldSpec(challenge.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.Color(); ok {
+		_spec.SetField(challenge.FieldColor, field.TypeEnum, value)
+		_node.Color = value
+	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(challenge.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.GameID(); ok {
+		_spec.SetField(challenge.FieldGameID, field.TypeUUID, value)
+		_node.GameID = &value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(challenge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.ChallengerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.ChallengerTable,
+			Columns: []string{challenge.ChallengerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChallengerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.DestUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.DestUserTable,
+			Columns: []string{challenge.DestUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DestUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Challenge.Create().
+//		SetChallengerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChallengeUpsert) {
+//			SetChallengerID(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *ChallengeCreate) OnConflict(opts ...sql.ConflictOption) *ChallengeUpsertOne {
+	cc.conflict = opts
+	return &ChallengeUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Challenge.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *ChallengeCreate) OnConflictColumns(columns ...string) *ChallengeUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &ChallengeUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// ChallengeUpsertOne is the builder for "upsert"-ing
+	//  one Challenge node.
+	ChallengeUpsertOne struct {
+		create *ChallengeCreate
+	}
+
+	// ChallengeUpsert is the "OnConflict" setter.
+	ChallengeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetChallengerID sets the "challenger_id" field.
+func (u *ChallengeUpsert) SetChallengerID(v uuid.UUID) *ChallengeUpsert {
+	u.Set(challenge.FieldChallengerID, v)
+	return u
+}
+
+// UpdateChallengerID sets the "challenger_id" field to the value that was provided on create.
+func (u *ChallengeUpsert) UpdateChallengerID() *ChallengeUpsert {
+	u.SetExcluded(challenge.FieldChallengerID)
+	return u
+}
+
+// SetDestUserID sets the "dest_user_id" field.
+func (u *ChallengeUpsert) SetDestUserID(v uuid.UUID) *ChallengeUpsert {
+	u.Set(challenge.FieldDestUserID, v)
+	return u
+}
+
+// UpdateDestUserID sets the "dest_user_id" field to the value that was provided on create.
+func (u *ChallengeUpsert) UpdateDestUserID() *ChallengeUpsert {
+	u.SetExcluded(challenge.FieldDestUserID)
+	return u
+}
+
+// SetColor sets the "color" field.
+func (u *ChallengeUpsert) SetColor(v challenge.Color) *ChallengeUpsert {
+	u.Set(challenge.FieldColor, v)
+	return u
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *ChallengeUpsert) UpdateColor() *ChallengeUpsert {
+	u.SetExcluded(challenge.FieldColor)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ChallengeUpsert) SetStatus(v challenge.Status) *ChallengeUpsert {
+	u.Set(challenge.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChallengeUpsert) UpdateStatus() *ChallengeUpsert {
+	u.SetExcluded(challenge.FieldStatus)
+	return u
+}
+
+// SetGameID sets the "game_id" field.
+func (u *ChallengeUpsert) SetGameID(v uuid.UUID) *ChallengeUpsert {
+	u.Set(challenge.FieldGameID, v)
+	return u
+}
+
+// UpdateGameID sets the "game_id" field to the value that was provided on create.
+func (u *ChallengeUpsert) UpdateGameID() *ChallengeUpsert {
+	u.SetExcluded(challenge.FieldGameID)
+	return u
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (u *ChallengeUpsert) ClearGameID() *ChallengeUpsert {
+	u.SetNull(challenge.FieldGameID)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChallengeUpsert) SetCreatedAt(v time.Time) *ChallengeUpsert {
+	u.Set(challenge.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChallengeUpsert) UpdateCreatedAt() *ChallengeUpsert {
+	u.SetExcluded(challenge.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Challenge.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(challenge.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChallengeUpsertOne) UpdateNewValues() *ChallengeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(challenge.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Challenge.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChallengeUpsertOne) Ignore() *ChallengeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChallengeUpsertOne) DoNothing() *ChallengeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChallengeCreate.OnConflict
+// documentation for more info.
+func (u *ChallengeUpsertOne) Update(set func(*ChallengeUpsert)) *ChallengeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChallengeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChallengerID sets the "challenger_id" field.
+func (u *ChallengeUpsertOne) SetChallengerID(v uuid.UUID) *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetChallengerID(v)
+	})
+}
+
+// UpdateChallengerID sets the "challenger_id" field to the value that was provided on create.
+func (u *ChallengeUpsertOne) UpdateChallengerID() *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateChallengerID()
+	})
+}
+
+// SetDestUserID sets the "dest_user_id" field.
+func (u *ChallengeUpsertOne) SetDestUserID(v uuid.UUID) *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetDestUserID(v)
+	})
+}
+
+// UpdateDestUserID sets the "dest_user_id" field to the value that was provided on create.
+func (u *ChallengeUpsertOne) UpdateDestUserID() *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateDestUserID()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *ChallengeUpsertOne) SetColor(v challenge.Color) *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *ChallengeUpsertOne) UpdateColor() *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChallengeUpsertOne) SetStatus(v challenge.Status) *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChallengeUpsertOne) UpdateStatus() *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetGameID sets the "game_id" field.
+func (u *ChallengeUpsertOne) SetGameID(v uuid.UUID) *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetGameID(v)
+	})
+}
+
+// UpdateGameID sets the "game_id" field to the value that was provided on create.
+func (u *ChallengeUpsertOne) UpdateGameID() *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateGameID()
+	})
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (u *ChallengeUpsertOne) ClearGameID() *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.ClearGameID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChallengeUpsertOne) SetCreatedAt(v time.Time) *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChallengeUpsertOne) UpdateCreatedAt() *ChallengeUpsertOne {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChallengeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChallengeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChallengeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChallengeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChallengeUpsertOne.ID is not supported by MySQL driver. Use ChallengeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChallengeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChallengeCreateBulk is the builder for creating many Challenge entities in bulk.
+type ChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*ChallengeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Challenge entities in the database.
+func (ccb *ChallengeCreateBulk) Save(ctx context.Context) ([]*Challenge, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Challenge, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *ChallengeCreateBulk) SaveX(ctx context.Context) []*Challenge {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *ChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *ChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Challenge.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChallengeUpsert) {
+//			SetChallengerID(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *ChallengeCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChallengeUpsertBulk {
+	ccb.conflict = opts
+	return &ChallengeUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Challenge.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *ChallengeCreateBulk) OnConflictColumns(columns ...string) *ChallengeUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &ChallengeUpsertBulk{
+		create: ccb,
+	}
+}
+
+// ChallengeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Challenge nodes.
+type ChallengeUpsertBulk struct {
+	create *ChallengeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Challenge.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(challenge.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChallengeUpsertBulk) UpdateNewValues() *ChallengeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(challenge.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Challenge.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChallengeUpsertBulk) Ignore() *ChallengeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChallengeUpsertBulk) DoNothing() *ChallengeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChallengeCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChallengeUpsertBulk) Update(set func(*ChallengeUpsert)) *ChallengeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChallengeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChallengerID sets the "challenger_id" field.
+func (u *ChallengeUpsertBulk) SetChallengerID(v uuid.UUID) *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetChallengerID(v)
+	})
+}
+
+// UpdateChallengerID sets the "challenger_id" field to the value that was provided on create.
+func (u *ChallengeUpsertBulk) UpdateChallengerID() *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateChallengerID()
+	})
+}
+
+// SetDestUserID sets the "dest_user_id" field.
+func (u *ChallengeUpsertBulk) SetDestUserID(v uuid.UUID) *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetDestUserID(v)
+	})
+}
+
+// UpdateDestUserID sets the "dest_user_id" field to the value that was provided on create.
+func (u *ChallengeUpsertBulk) UpdateDestUserID() *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateDestUserID()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *ChallengeUpsertBulk) SetColor(v challenge.Color) *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *ChallengeUpsertBulk) UpdateColor() *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChallengeUpsertBulk) SetStatus(v challenge.Status) *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChallengeUpsertBulk) UpdateStatus() *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetGameID sets the "game_id" field.
+func (u *ChallengeUpsertBulk) SetGameID(v uuid.UUID) *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetGameID(v)
+	})
+}
+
+// UpdateGameID sets the "game_id" field to the value that was provided on create.
+func (u *ChallengeUpsertBulk) UpdateGameID() *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateGameID()
+	})
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (u *ChallengeUpsertBulk) ClearGameID() *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.ClearGameID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChallengeUpsertBulk) SetCreatedAt(v time.Time) *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChallengeUpsertBulk) UpdateCreatedAt() *ChallengeUpsertBulk {
+	return u.Update(func(s *ChallengeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChallengeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChallengeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChallengeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChallengeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
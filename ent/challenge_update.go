@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/ent/predicate"
+	"GopherChessParty/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChallengeUpdate is the builder for updating Challenge entities.
+type ChallengeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChallengeMutation
+}
+
+// Where appends a list predicates to the ChallengeUpdate builder.
+func (cu *ChallengeUpdate) Where(ps ...predicate.Challenge) *ChallengeUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetChallengerID sets the "challenger_id" field.
+func (cu *ChallengeUpdate) SetChallengerID(u uuid.UUID) *ChallengeUpdate {
+	cu.mutation.SetChallengerID(u)
+	return cu
+}
+
+// SetNillableChallengerID sets the "challenger_id" field if the given value is not nil.
+func (cu *ChallengeUpdate) SetNillableChallengerID(u *uuid.UUID) *ChallengeUpdate {
+	if u != nil {
+		cu.SetChallengerID(*u)
+	}
+	return cu
+}
+
+// SetDestUserID sets the "dest_user_id" field.
+func (cu *ChallengeUpdate) SetDestUserID(u uuid.UUID) *ChallengeUpdate {
+	cu.mutation.SetDestUserID(u)
+	return cu
+}
+
+// SetNillableDestUserID sets the "dest_user_id" field if the given value is not nil.
+func (cu *ChallengeUpdate) SetNillableDestUserID(u *uuid.UUID) *ChallengeUpdate {
+	if u != nil {
+		cu.SetDestUserID(*u)
+	}
+	return cu
+}
+
+// SetColor sets the "color" field.
+func (cu *ChallengeUpdate) SetColor(c challenge.Color) *ChallengeUpdate {
+	cu.mutation.SetColor(c)
+	return cu
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (cu *ChallengeUpdate) SetNillableColor(c *challenge.Color) *ChallengeUpdate {
+	if c != nil {
+		cu.SetColor(*c)
+	}
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *ChallengeUpdate) SetStatus(c challenge.Status) *ChallengeUpdate {
+	cu.mutation.SetStatus(c)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *ChallengeUpdate) SetNillableStatus(c *challenge.Status) *ChallengeUpdate {
+	if c != nil {
+		cu.SetStatus(*c)
+	}
+	return cu
+}
+
+// SetGameID sets the "game_id" field.
+func (cu *ChallengeUpdate) SetGameID(u uuid.UUID) *ChallengeUpdate {
+	cu.mutation.SetGameID(u)
+	return cu
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (cu *ChallengeUpdate) SetNillableGameID(u *uuid.UUID) *ChallengeUpdate {
+	if u != nil {
+		cu.SetGameID(*u)
+	}
+	return cu
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (cu *ChallengeUpdate) ClearGameID() *ChallengeUpdate {
+	cu.mutation.ClearGameID()
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *ChallengeUpdate) SetCreatedAt(t time.Time) *ChallengeUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *ChallengeUpdate) SetNillableCreatedAt(t *time.Time) *ChallengeUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetChallenger sets the "challenger" edge to the User entity.
+func (cu *ChallengeUpdate) SetChallenger(u *User) *ChallengeUpdate {
+	return cu.SetChallengerID(u.ID)
+}
+
+// SetDestUser sets the "dest_user" edge to the User entity.
+func (cu *ChallengeUpdate) SetDestUser(u *User) *ChallengeUpdate {
+	return cu.SetDestUserID(u.ID)
+}
+
+// Mutation returns the ChallengeMutation object of the builder.
+func (cu *ChallengeUpdate) Mutation() *ChallengeMutation {
+	return cu.mutation
+}
+
+// ClearChallenger clears the "challenger" edge to the User entity.
+func (cu *ChallengeUpdate) ClearChallenger() *ChallengeUpdate {
+	cu.mutation.ClearChallenger()
+	return cu
+}
+
+// ClearDestUser clears the "dest_user" edge to the User entity.
+func (cu *ChallengeUpdate) ClearDestUser() *ChallengeUpdate {
+	cu.mutation.ClearDestUser()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ChallengeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ChallengeUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ChallengeUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ChallengeUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *ChallengeUpdate) check() error {
+	if v, ok := cu.mutation.Color(); ok {
+		if err := challenge.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Challenge.color": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Status(); ok {
+		if err := challenge.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Challenge.status": %w`, err)}
+		}
+	}
+	if cu.mutation.ChallengerCleared() && len(cu.mutation.ChallengerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Challenge.challenger"`)
+	}
+	if cu.mutation.DestUserCleared() && len(cu.mutation.DestUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Challenge.dest_user"`)
+	}
+	return nil
+}
+
+func (cu *ChallengeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(challenge.Table, challenge.Columns, sqlgraph.NewFieldSpec(challenge.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Color(); ok {
+		_spec.SetField(challenge.FieldColor, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(challenge.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.GameID(); ok {
+		_spec.SetField(challenge.FieldGameID, field.TypeUUID, value)
+	}
+	if cu.mutation.GameIDCleared() {
+		_spec.ClearField(challenge.FieldGameID, field.TypeUUID)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(challenge.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.ChallengerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.ChallengerTable,
+			Columns: []string{challenge.ChallengerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ChallengerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.ChallengerTable,
+			Columns: []string{challenge.ChallengerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.DestUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.DestUserTable,
+			Columns: []string{challenge.DestUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.DestUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.DestUserTable,
+			Columns: []string{challenge.DestUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{challenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// ChallengeUpdateOne is the builder for updating a single Challenge entity.
+type ChallengeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChallengeMutation
+}
+
+// SetChallengerID sets the "challenger_id" field.
+func (cuo *ChallengeUpdateOne) SetChallengerID(u uuid.UUID) *ChallengeUpdateOne {
+	cuo.mutation.SetChallengerID(u)
+	return cuo
+}
+
+// SetNillableChallengerID sets the "challenger_id" field if the given value is not nil.
+func (cuo *ChallengeUpdateOne) SetNillableChallengerID(u *uuid.UUID) *ChallengeUpdateOne {
+	if u != nil {
+		cuo.SetChallengerID(*u)
+	}
+	return cuo
+}
+
+// SetDestUserID sets the "dest_user_id" field.
+func (cuo *ChallengeUpdateOne) SetDestUserID(u uuid.UUID) *ChallengeUpdateOne {
+	cuo.mutation.SetDestUserID(u)
+	return cuo
+}
+
+// SetNillableDestUserID sets the "dest_user_id" field if the given value is not nil.
+func (cuo *ChallengeUpdateOne) SetNillableDestUserID(u *uuid.UUID) *ChallengeUpdateOne {
+	if u != nil {
+		cuo.SetDestUserID(*u)
+	}
+	return cuo
+}
+
+// SetColor sets the "color" field.
+func (cuo *ChallengeUpdateOne) SetColor(c challenge.Color) *ChallengeUpdateOne {
+	cuo.mutation.SetColor(c)
+	return cuo
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (cuo *ChallengeUpdateOne) SetNillableColor(c *challenge.Color) *ChallengeUpdateOne {
+	if c != nil {
+		cuo.SetColor(*c)
+	}
+	return cuo
+}
+
+// SetStatus sets the "status" field.
+func (cuo *ChallengeUpdateOne) SetStatus(c challenge.Status) *ChallengeUpdateOne {
+	cuo.mutation.SetStatus(c)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *ChallengeUpdateOne) SetNillableStatus(c *challenge.Status) *ChallengeUpdateOne {
+	if c != nil {
+		cuo.SetStatus(*c)
+	}
+	return cuo
+}
+
+// SetGameID sets the "game_id" field.
+func (cuo *ChallengeUpdateOne) SetGameID(u uuid.UUID) *ChallengeUpdateOne {
+	cuo.mutation.SetGameID(u)
+	return cuo
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (cuo *ChallengeUpdateOne) SetNillableGameID(u *uuid.UUID) *ChallengeUpdateOne {
+	if u != nil {
+		cuo.SetGameID(*u)
+	}
+	return cuo
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (cuo *ChallengeUpdateOne) ClearGameID() *ChallengeUpdateOne {
+	cuo.mutation.ClearGameID()
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *ChallengeUpdateOne) SetCreatedAt(t time.Time) *ChallengeUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *ChallengeUpdateOne) SetNillableCreatedAt(t *time.Time) *ChallengeUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetChallenger sets the "challenger" edge to the User entity.
+func (cuo *ChallengeUpdateOne) SetChallenger(u *User) *ChallengeUpdateOne {
+	return cuo.SetChallengerID(u.ID)
+}
+
+// SetDestUser sets the "dest_user" edge to the User entity.
+func (cuo *ChallengeUpdateOne) SetDestUser(u *User) *ChallengeUpdateOne {
+	return cuo.SetDestUserID(u.ID)
+}
+
+// Mutation returns the ChallengeMutation object of the builder.
+func (cuo *ChallengeUpdateOne) Mutation() *ChallengeMutation {
+	return cuo.mutation
+}
+
+// ClearChallenger clears the "challenger" edge to the User entity.
+func (cuo *ChallengeUpdateOne) ClearChallenger() *ChallengeUpdateOne {
+	cuo.mutation.ClearChallenger()
+	return cuo
+}
+
+// ClearDestUser clears the "dest_user" edge to the User entity.
+func (cuo *ChallengeUpdateOne) ClearDestUser() *ChallengeUpdateOne {
+	cuo.mutation.ClearDestUser()
+	return cuo
+}
+
+// Where appends a list predicates to the ChallengeUpdate builder.
+func (cuo *ChallengeUpdateOne) Where(ps ...predicate.Challenge) *ChallengeUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *ChallengeUpdateOne) Select(field string, fields ...string) *ChallengeUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Challenge entity.
+func (cuo *ChallengeUpdateOne) Save(ctx context.Context) (*Challenge, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ChallengeUpdateOne) SaveX(ctx context.Context) *Challenge {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ChallengeUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ChallengeUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *ChallengeUpdateOne) check() error {
+	if v, ok := cuo.mutation.Color(); ok {
+		if err := challenge.ColorValidator(v); err != nil {
+			return &ValidationError{Name: "color", err: fmt.Errorf(`ent: validator failed for field "Challenge.color": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := challenge.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Challenge.status": %w`, err)}
+		}
+	}
+	if cuo.mutation.ChallengerCleared() && len(cuo.mutation.ChallengerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Challenge.challenger"`)
+	}
+	if cuo.mutation.DestUserCleared() && len(cuo.mutation.DestUserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Challenge.dest_user"`)
+	}
+	return nil
+}
+
+func (cuo *ChallengeUpdateOne) sqlSave(ctx context.Context) (_node *Challenge, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(challenge.Table, challenge.Columns, sqlgraph.NewFieldSpec(challenge.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Challenge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, challenge.FieldID)
+		for _, f := range fields {
+			if !challenge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != challenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Color(); ok {
+		_spec.SetField(challenge.FieldColor, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(challenge.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.GameID(); ok {
+		_spec.SetField(challenge.FieldGameID, field.TypeUUID, value)
+	}
+	if cuo.mutation.GameIDCleared() {
+		_spec.ClearField(challenge.FieldGameID, field.TypeUUID)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(challenge.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ChallengerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.ChallengerTable,
+			Columns: []string{challenge.ChallengerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ChallengerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.ChallengerTable,
+			Columns: []string{challenge.ChallengerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.DestUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.DestUserTable,
+			Columns: []string{challenge.DestUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.DestUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   challenge.DestUserTable,
+			Columns: []string{challenge.DestUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Challenge{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{challenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
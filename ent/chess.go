@@ -27,6 +27,8 @@ type Chess struct {
 	Status chess.Status `json:"status,omitempty"`
 	// Result holds the value of the "result" field.
 	Result chess.Result `json:"result,omitempty"`
+	// Termination holds the value of the "termination" field.
+	Termination *chess.Termination `json:"termination,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChessQuery when eager-loading is set.
 	Edges         ChessEdges `json:"edges"`
@@ -84,7 +86,7 @@ func (*Chess) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case chess.FieldStatus, chess.FieldResult:
+		case chess.FieldStatus, chess.FieldResult, chess.FieldTermination:
 			values[i] = new(sql.NullString)
 		case chess.FieldCreatedAt, chess.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -139,6 +141,13 @@ func (c *Chess) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Result = chess.Result(value.String)
 			}
+		case chess.FieldTermination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field termination", values[i])
+			} else if value.Valid {
+				c.Termination = new(chess.Termination)
+				*c.Termination = chess.Termination(value.String)
+			}
 		case chess.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_white_id", values[i])
@@ -215,6 +224,11 @@ func (c *Chess) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("result=")
 	builder.WriteString(fmt.Sprintf("%v", c.Result))
+	builder.WriteString(", ")
+	if v := c.Termination; v != nil {
+		builder.WriteString("termination=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
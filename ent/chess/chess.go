@@ -24,6 +24,8 @@ const (
 	FieldStatus = "status"
 	// FieldResult holds the string denoting the result field in the database.
 	FieldResult = "result"
+	// FieldTermination holds the string denoting the termination field in the database.
+	FieldTermination = "termination"
 	// EdgeWhiteUser holds the string denoting the white_user edge name in mutations.
 	EdgeWhiteUser = "white_user"
 	// EdgeBlackUser holds the string denoting the black_user edge name in mutations.
@@ -62,6 +64,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldStatus,
 	FieldResult,
+	FieldTermination,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "chesses"
@@ -126,15 +129,15 @@ func StatusValidator(s Status) error {
 // Result defines the type for the "result" enum field.
 type Result string
 
-// Result00 is the default value of the Result enum.
-const DefaultResult = Result00
+// ResultInProgress is the default value of the Result enum.
+const DefaultResult = ResultInProgress
 
 // Result values.
 const (
-	Result10 Result = "1-0"
-	Result01 Result = "0-1"
-	Result11 Result = "1-1"
-	Result00 Result = "0-0"
+	ResultWhiteWon   Result = "1-0"
+	ResultBlackWon   Result = "0-1"
+	ResultDraw       Result = "1/2-1/2"
+	ResultInProgress Result = "*"
 )
 
 func (r Result) String() string {
@@ -144,13 +147,45 @@ func (r Result) String() string {
 // ResultValidator is a validator for the "result" field enum values. It is called by the builders before save.
 func ResultValidator(r Result) error {
 	switch r {
-	case Result10, Result01, Result11, Result00:
+	case ResultWhiteWon, ResultBlackWon, ResultDraw, ResultInProgress:
 		return nil
 	default:
 		return fmt.Errorf("chess: invalid enum value for result field: %q", r)
 	}
 }
 
+// Termination defines the type for the "termination" enum field.
+type Termination string
+
+// Termination values.
+const (
+	TerminationCheckmate            Termination = "checkmate"
+	TerminationResignation          Termination = "resignation"
+	TerminationTimeout              Termination = "timeout"
+	TerminationStalemate            Termination = "stalemate"
+	TerminationRepetition           Termination = "repetition"
+	TerminationFiftyMove            Termination = "fifty_move"
+	TerminationInsufficientMaterial Termination = "insufficient_material"
+	TerminationAgreement            Termination = "agreement"
+	TerminationAbandonment          Termination = "abandonment"
+	TerminationAborted              Termination = "aborted"
+	TerminationAdjudication         Termination = "adjudication"
+)
+
+func (t Termination) String() string {
+	return string(t)
+}
+
+// TerminationValidator is a validator for the "termination" field enum values. It is called by the builders before save.
+func TerminationValidator(t Termination) error {
+	switch t {
+	case TerminationCheckmate, TerminationResignation, TerminationTimeout, TerminationStalemate, TerminationRepetition, TerminationFiftyMove, TerminationInsufficientMaterial, TerminationAgreement, TerminationAbandonment, TerminationAborted, TerminationAdjudication:
+		return nil
+	default:
+		return fmt.Errorf("chess: invalid enum value for termination field: %q", t)
+	}
+}
+
 // OrderOption defines the ordering options for the Chess queries.
 type OrderOption func(*sql.Selector)
 
@@ -179,6 +214,11 @@ func ByResult(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResult, opts...).ToFunc()
 }
 
+// ByTermination orders the results by the termination field.
+func ByTermination(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTermination, opts...).ToFunc()
+}
+
 // ByWhiteUserField orders the results by white_user field.
 func ByWhiteUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -186,6 +186,36 @@ func ResultNotIn(vs ...Result) predicate.Chess {
 	return predicate.Chess(sql.FieldNotIn(FieldResult, vs...))
 }
 
+// TerminationEQ applies the EQ predicate on the "termination" field.
+func TerminationEQ(v Termination) predicate.Chess {
+	return predicate.Chess(sql.FieldEQ(FieldTermination, v))
+}
+
+// TerminationNEQ applies the NEQ predicate on the "termination" field.
+func TerminationNEQ(v Termination) predicate.Chess {
+	return predicate.Chess(sql.FieldNEQ(FieldTermination, v))
+}
+
+// TerminationIn applies the In predicate on the "termination" field.
+func TerminationIn(vs ...Termination) predicate.Chess {
+	return predicate.Chess(sql.FieldIn(FieldTermination, vs...))
+}
+
+// TerminationNotIn applies the NotIn predicate on the "termination" field.
+func TerminationNotIn(vs ...Termination) predicate.Chess {
+	return predicate.Chess(sql.FieldNotIn(FieldTermination, vs...))
+}
+
+// TerminationIsNil applies the IsNil predicate on the "termination" field.
+func TerminationIsNil() predicate.Chess {
+	return predicate.Chess(sql.FieldIsNull(FieldTermination))
+}
+
+// TerminationNotNil applies the NotNil predicate on the "termination" field.
+func TerminationNotNil() predicate.Chess {
+	return predicate.Chess(sql.FieldNotNull(FieldTermination))
+}
+
 // HasWhiteUser applies the HasEdge predicate on the "white_user" edge.
 func HasWhiteUser() predicate.Chess {
 	return predicate.Chess(func(s *sql.Selector) {
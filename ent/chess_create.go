@@ -11,6 +11,8 @@ import (
 	"GopherChessParty/ent/chess"
 	"GopherChessParty/ent/gamehistory"
 	"GopherChessParty/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type ChessCreate struct {
 	config
 	mutation *ChessMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -79,6 +82,20 @@ func (cc *ChessCreate) SetNillableResult(c *chess.Result) *ChessCreate {
 	return cc
 }
 
+// SetTermination sets the "termination" field.
+func (cc *ChessCreate) SetTermination(c chess.Termination) *ChessCreate {
+	cc.mutation.SetTermination(c)
+	return cc
+}
+
+// SetNillableTermination sets the "termination" field if the given value is not nil.
+func (cc *ChessCreate) SetNillableTermination(c *chess.Termination) *ChessCreate {
+	if c != nil {
+		cc.SetTermination(*c)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *ChessCreate) SetID(u uuid.UUID) *ChessCreate {
 	cc.mutation.SetID(u)
@@ -211,6 +228,11 @@ func (cc *ChessCreate) check() error {
 			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "Chess.result": %w`, err)}
 		}
 	}
+	if v, ok := cc.mutation.Termination(); ok {
+		if err := chess.TerminationValidator(v); err != nil {
+			return &ValidationError{Name: "termination", err: fmt.Errorf(`ent: validator failed for field "Chess.termination": %w`, err)}
+		}
+	}
 	if len(cc.mutation.WhiteUserIDs()) == 0 {
 		return &ValidationError{Name: "white_user", err: errors.New(`ent: missing required edge "Chess.white_user"`)}
 	}
@@ -248,6 +270,7 @@ func (cc *ChessCreate) createSpec() (*Chess, *sqlgraph.CreateSpec) {
 		_node = &Chess{config: cc.config}
 		_spec = sqlgraph.NewCreateSpec(chess.Table, sqlgraph.NewFieldSpec(chess.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = cc.conflict
 	if id, ok := cc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -268,6 +291,10 @@ func (cc *ChessCreate) createSpec() (*Chess, *sqlgraph.CreateSpec) {
 		_spec.SetField(chess.FieldResult, field.TypeEnum, value)
 		_node.Result = value
 	}
+	if value, ok := cc.mutation.Termination(); ok {
+		_spec.SetField(chess.FieldTermination, field.TypeEnum, value)
+		_node.Termination = &value
+	}
 	if nodes := cc.mutation.WhiteUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -321,11 +348,290 @@ func (cc *ChessCreate) createSpec() (*Chess, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Chess.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChessUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *ChessCreate) OnConflict(opts ...sql.ConflictOption) *ChessUpsertOne {
+	cc.conflict = opts
+	return &ChessUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Chess.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *ChessCreate) OnConflictColumns(columns ...string) *ChessUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &ChessUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// ChessUpsertOne is the builder for "upsert"-ing
+	//  one Chess node.
+	ChessUpsertOne struct {
+		create *ChessCreate
+	}
+
+	// ChessUpsert is the "OnConflict" setter.
+	ChessUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChessUpsert) SetCreatedAt(v time.Time) *ChessUpsert {
+	u.Set(chess.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChessUpsert) UpdateCreatedAt() *ChessUpsert {
+	u.SetExcluded(chess.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChessUpsert) SetUpdatedAt(v time.Time) *ChessUpsert {
+	u.Set(chess.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChessUpsert) UpdateUpdatedAt() *ChessUpsert {
+	u.SetExcluded(chess.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ChessUpsert) SetStatus(v chess.Status) *ChessUpsert {
+	u.Set(chess.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChessUpsert) UpdateStatus() *ChessUpsert {
+	u.SetExcluded(chess.FieldStatus)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *ChessUpsert) SetResult(v chess.Result) *ChessUpsert {
+	u.Set(chess.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *ChessUpsert) UpdateResult() *ChessUpsert {
+	u.SetExcluded(chess.FieldResult)
+	return u
+}
+
+// SetTermination sets the "termination" field.
+func (u *ChessUpsert) SetTermination(v chess.Termination) *ChessUpsert {
+	u.Set(chess.FieldTermination, v)
+	return u
+}
+
+// UpdateTermination sets the "termination" field to the value that was provided on create.
+func (u *ChessUpsert) UpdateTermination() *ChessUpsert {
+	u.SetExcluded(chess.FieldTermination)
+	return u
+}
+
+// ClearTermination clears the value of the "termination" field.
+func (u *ChessUpsert) ClearTermination() *ChessUpsert {
+	u.SetNull(chess.FieldTermination)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Chess.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chess.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChessUpsertOne) UpdateNewValues() *ChessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chess.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Chess.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChessUpsertOne) Ignore() *ChessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChessUpsertOne) DoNothing() *ChessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChessCreate.OnConflict
+// documentation for more info.
+func (u *ChessUpsertOne) Update(set func(*ChessUpsert)) *ChessUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChessUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChessUpsertOne) SetCreatedAt(v time.Time) *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChessUpsertOne) UpdateCreatedAt() *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChessUpsertOne) SetUpdatedAt(v time.Time) *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChessUpsertOne) UpdateUpdatedAt() *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChessUpsertOne) SetStatus(v chess.Status) *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChessUpsertOne) UpdateStatus() *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *ChessUpsertOne) SetResult(v chess.Result) *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *ChessUpsertOne) UpdateResult() *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetTermination sets the "termination" field.
+func (u *ChessUpsertOne) SetTermination(v chess.Termination) *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetTermination(v)
+	})
+}
+
+// UpdateTermination sets the "termination" field to the value that was provided on create.
+func (u *ChessUpsertOne) UpdateTermination() *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateTermination()
+	})
+}
+
+// ClearTermination clears the value of the "termination" field.
+func (u *ChessUpsertOne) ClearTermination() *ChessUpsertOne {
+	return u.Update(func(s *ChessUpsert) {
+		s.ClearTermination()
+	})
+}
+
+// Exec executes the query.
+func (u *ChessUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChessCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChessUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChessUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChessUpsertOne.ID is not supported by MySQL driver. Use ChessUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChessUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ChessCreateBulk is the builder for creating many Chess entities in bulk.
 type ChessCreateBulk struct {
 	config
 	err      error
 	builders []*ChessCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Chess entities in the database.
@@ -355,6 +661,7 @@ func (ccb *ChessCreateBulk) Save(ctx context.Context) ([]*Chess, error) {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -404,3 +711,194 @@ func (ccb *ChessCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Chess.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChessUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *ChessCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChessUpsertBulk {
+	ccb.conflict = opts
+	return &ChessUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Chess.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *ChessCreateBulk) OnConflictColumns(columns ...string) *ChessUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &ChessUpsertBulk{
+		create: ccb,
+	}
+}
+
+// ChessUpsertBulk is the builder for "upsert"-ing
+// a bulk of Chess nodes.
+type ChessUpsertBulk struct {
+	create *ChessCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Chess.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chess.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChessUpsertBulk) UpdateNewValues() *ChessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chess.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Chess.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChessUpsertBulk) Ignore() *ChessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChessUpsertBulk) DoNothing() *ChessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChessCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChessUpsertBulk) Update(set func(*ChessUpsert)) *ChessUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChessUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChessUpsertBulk) SetCreatedAt(v time.Time) *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChessUpsertBulk) UpdateCreatedAt() *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChessUpsertBulk) SetUpdatedAt(v time.Time) *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChessUpsertBulk) UpdateUpdatedAt() *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChessUpsertBulk) SetStatus(v chess.Status) *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChessUpsertBulk) UpdateStatus() *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *ChessUpsertBulk) SetResult(v chess.Result) *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *ChessUpsertBulk) UpdateResult() *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetTermination sets the "termination" field.
+func (u *ChessUpsertBulk) SetTermination(v chess.Termination) *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.SetTermination(v)
+	})
+}
+
+// UpdateTermination sets the "termination" field to the value that was provided on create.
+func (u *ChessUpsertBulk) UpdateTermination() *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.UpdateTermination()
+	})
+}
+
+// ClearTermination clears the value of the "termination" field.
+func (u *ChessUpsertBulk) ClearTermination() *ChessUpsertBulk {
+	return u.Update(func(s *ChessUpsert) {
+		s.ClearTermination()
+	})
+}
+
+// Exec executes the query.
+func (u *ChessUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChessCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChessCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChessUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -87,6 +87,26 @@ func (cu *ChessUpdate) SetNillableResult(c *chess.Result) *ChessUpdate {
 	return cu
 }
 
+// SetTermination sets the "termination" field.
+func (cu *ChessUpdate) SetTermination(c chess.Termination) *ChessUpdate {
+	cu.mutation.SetTermination(c)
+	return cu
+}
+
+// SetNillableTermination sets the "termination" field if the given value is not nil.
+func (cu *ChessUpdate) SetNillableTermination(c *chess.Termination) *ChessUpdate {
+	if c != nil {
+		cu.SetTermination(*c)
+	}
+	return cu
+}
+
+// ClearTermination clears the value of the "termination" field.
+func (cu *ChessUpdate) ClearTermination() *ChessUpdate {
+	cu.mutation.ClearTermination()
+	return cu
+}
+
 // SetWhiteUserID sets the "white_user" edge to the User entity by ID.
 func (cu *ChessUpdate) SetWhiteUserID(id uuid.UUID) *ChessUpdate {
 	cu.mutation.SetWhiteUserID(id)
@@ -201,6 +221,11 @@ func (cu *ChessUpdate) check() error {
 			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "Chess.result": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.Termination(); ok {
+		if err := chess.TerminationValidator(v); err != nil {
+			return &ValidationError{Name: "termination", err: fmt.Errorf(`ent: validator failed for field "Chess.termination": %w`, err)}
+		}
+	}
 	if cu.mutation.WhiteUserCleared() && len(cu.mutation.WhiteUserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Chess.white_user"`)
 	}
@@ -234,6 +259,12 @@ func (cu *ChessUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.Result(); ok {
 		_spec.SetField(chess.FieldResult, field.TypeEnum, value)
 	}
+	if value, ok := cu.mutation.Termination(); ok {
+		_spec.SetField(chess.FieldTermination, field.TypeEnum, value)
+	}
+	if cu.mutation.TerminationCleared() {
+		_spec.ClearField(chess.FieldTermination, field.TypeEnum)
+	}
 	if cu.mutation.WhiteUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -413,6 +444,26 @@ func (cuo *ChessUpdateOne) SetNillableResult(c *chess.Result) *ChessUpdateOne {
 	return cuo
 }
 
+// SetTermination sets the "termination" field.
+func (cuo *ChessUpdateOne) SetTermination(c chess.Termination) *ChessUpdateOne {
+	cuo.mutation.SetTermination(c)
+	return cuo
+}
+
+// SetNillableTermination sets the "termination" field if the given value is not nil.
+func (cuo *ChessUpdateOne) SetNillableTermination(c *chess.Termination) *ChessUpdateOne {
+	if c != nil {
+		cuo.SetTermination(*c)
+	}
+	return cuo
+}
+
+// ClearTermination clears the value of the "termination" field.
+func (cuo *ChessUpdateOne) ClearTermination() *ChessUpdateOne {
+	cuo.mutation.ClearTermination()
+	return cuo
+}
+
 // SetWhiteUserID sets the "white_user" edge to the User entity by ID.
 func (cuo *ChessUpdateOne) SetWhiteUserID(id uuid.UUID) *ChessUpdateOne {
 	cuo.mutation.SetWhiteUserID(id)
@@ -540,6 +591,11 @@ func (cuo *ChessUpdateOne) check() error {
 			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "Chess.result": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.Termination(); ok {
+		if err := chess.TerminationValidator(v); err != nil {
+			return &ValidationError{Name: "termination", err: fmt.Errorf(`ent: validator failed for field "Chess.termination": %w`, err)}
+		}
+	}
 	if cuo.mutation.WhiteUserCleared() && len(cuo.mutation.WhiteUserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Chess.white_user"`)
 	}
@@ -590,6 +646,12 @@ func (cuo *ChessUpdateOne) sqlSave(ctx context.Context) (_node *Chess, err error
 	if value, ok := cuo.mutation.Result(); ok {
 		_spec.SetField(chess.FieldResult, field.TypeEnum, value)
 	}
+	if value, ok := cuo.mutation.Termination(); ok {
+		_spec.SetField(chess.FieldTermination, field.TypeEnum, value)
+	}
+	if cuo.mutation.TerminationCleared() {
+		_spec.ClearField(chess.FieldTermination, field.TypeEnum)
+	}
 	if cuo.mutation.WhiteUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -9,9 +9,18 @@ import (
 	"reflect"
 	"sync"
 
+	"GopherChessParty/ent/challenge"
 	"GopherChessParty/ent/chess"
 	"GopherChessParty/ent/gamehistory"
+	"GopherChessParty/ent/gamelease"
+	"GopherChessParty/ent/identity"
+	"GopherChessParty/ent/loginfailure"
+	"GopherChessParty/ent/personaltoken"
+	"GopherChessParty/ent/queueentry"
+	"GopherChessParty/ent/recoverycode"
+	"GopherChessParty/ent/session"
 	"GopherChessParty/ent/user"
+	"GopherChessParty/ent/usertoken"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -75,9 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chess.Table:       chess.ValidColumn,
-			gamehistory.Table: gamehistory.ValidColumn,
-			user.Table:        user.ValidColumn,
+			challenge.Table:     challenge.ValidColumn,
+			chess.Table:         chess.ValidColumn,
+			gamehistory.Table:   gamehistory.ValidColumn,
+			gamelease.Table:     gamelease.ValidColumn,
+			identity.Table:      identity.ValidColumn,
+			loginfailure.Table:  loginfailure.ValidColumn,
+			personaltoken.Table: personaltoken.ValidColumn,
+			queueentry.Table:    queueentry.ValidColumn,
+			recoverycode.Table:  recoverycode.ValidColumn,
+			session.Table:       session.ValidColumn,
+			user.Table:          user.ValidColumn,
+			usertoken.Table:     usertoken.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
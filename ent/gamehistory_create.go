@@ -11,6 +11,8 @@ import (
 	"GopherChessParty/ent/chess"
 	"GopherChessParty/ent/gamehistory"
 	"GopherChessParty/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type GameHistoryCreate struct {
 	config
 	mutation *GameHistoryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -184,6 +187,7 @@ func (ghc *GameHistoryCreate) createSpec() (*GameHistory, *sqlgraph.CreateSpec)
 		_node = &GameHistory{config: ghc.config}
 		_spec = sqlgraph.NewCreateSpec(gamehistory.Table, sqlgraph.NewFieldSpec(gamehistory.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = ghc.conflict
 	if id, ok := ghc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -237,11 +241,290 @@ func (ghc *GameHistoryCreate) createSpec() (*GameHistory, *sqlgraph.CreateSpec)
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameHistory.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ghc *GameHistoryCreate) OnConflict(opts ...sql.ConflictOption) *GameHistoryUpsertOne {
+	ghc.conflict = opts
+	return &GameHistoryUpsertOne{
+		create: ghc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ghc *GameHistoryCreate) OnConflictColumns(columns ...string) *GameHistoryUpsertOne {
+	ghc.conflict = append(ghc.conflict, sql.ConflictColumns(columns...))
+	return &GameHistoryUpsertOne{
+		create: ghc,
+	}
+}
+
+type (
+	// GameHistoryUpsertOne is the builder for "upsert"-ing
+	//  one GameHistory node.
+	GameHistoryUpsertOne struct {
+		create *GameHistoryCreate
+	}
+
+	// GameHistoryUpsert is the "OnConflict" setter.
+	GameHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *GameHistoryUpsert) SetCreatedAt(v time.Time) *GameHistoryUpsert {
+	u.Set(gamehistory.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *GameHistoryUpsert) UpdateCreatedAt() *GameHistoryUpsert {
+	u.SetExcluded(gamehistory.FieldCreatedAt)
+	return u
+}
+
+// SetNum sets the "num" field.
+func (u *GameHistoryUpsert) SetNum(v int) *GameHistoryUpsert {
+	u.Set(gamehistory.FieldNum, v)
+	return u
+}
+
+// UpdateNum sets the "num" field to the value that was provided on create.
+func (u *GameHistoryUpsert) UpdateNum() *GameHistoryUpsert {
+	u.SetExcluded(gamehistory.FieldNum)
+	return u
+}
+
+// AddNum adds v to the "num" field.
+func (u *GameHistoryUpsert) AddNum(v int) *GameHistoryUpsert {
+	u.Add(gamehistory.FieldNum, v)
+	return u
+}
+
+// SetMove sets the "move" field.
+func (u *GameHistoryUpsert) SetMove(v string) *GameHistoryUpsert {
+	u.Set(gamehistory.FieldMove, v)
+	return u
+}
+
+// UpdateMove sets the "move" field to the value that was provided on create.
+func (u *GameHistoryUpsert) UpdateMove() *GameHistoryUpsert {
+	u.SetExcluded(gamehistory.FieldMove)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GameHistoryUpsert) SetUserID(v uuid.UUID) *GameHistoryUpsert {
+	u.Set(gamehistory.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GameHistoryUpsert) UpdateUserID() *GameHistoryUpsert {
+	u.SetExcluded(gamehistory.FieldUserID)
+	return u
+}
+
+// SetGameID sets the "game_id" field.
+func (u *GameHistoryUpsert) SetGameID(v uuid.UUID) *GameHistoryUpsert {
+	u.Set(gamehistory.FieldGameID, v)
+	return u
+}
+
+// UpdateGameID sets the "game_id" field to the value that was provided on create.
+func (u *GameHistoryUpsert) UpdateGameID() *GameHistoryUpsert {
+	u.SetExcluded(gamehistory.FieldGameID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GameHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gamehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GameHistoryUpsertOne) UpdateNewValues() *GameHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(gamehistory.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GameHistoryUpsertOne) Ignore() *GameHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameHistoryUpsertOne) DoNothing() *GameHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameHistoryCreate.OnConflict
+// documentation for more info.
+func (u *GameHistoryUpsertOne) Update(set func(*GameHistoryUpsert)) *GameHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *GameHistoryUpsertOne) SetCreatedAt(v time.Time) *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *GameHistoryUpsertOne) UpdateCreatedAt() *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetNum sets the "num" field.
+func (u *GameHistoryUpsertOne) SetNum(v int) *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetNum(v)
+	})
+}
+
+// AddNum adds v to the "num" field.
+func (u *GameHistoryUpsertOne) AddNum(v int) *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.AddNum(v)
+	})
+}
+
+// UpdateNum sets the "num" field to the value that was provided on create.
+func (u *GameHistoryUpsertOne) UpdateNum() *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateNum()
+	})
+}
+
+// SetMove sets the "move" field.
+func (u *GameHistoryUpsertOne) SetMove(v string) *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetMove(v)
+	})
+}
+
+// UpdateMove sets the "move" field to the value that was provided on create.
+func (u *GameHistoryUpsertOne) UpdateMove() *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateMove()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GameHistoryUpsertOne) SetUserID(v uuid.UUID) *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GameHistoryUpsertOne) UpdateUserID() *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetGameID sets the "game_id" field.
+func (u *GameHistoryUpsertOne) SetGameID(v uuid.UUID) *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetGameID(v)
+	})
+}
+
+// UpdateGameID sets the "game_id" field to the value that was provided on create.
+func (u *GameHistoryUpsertOne) UpdateGameID() *GameHistoryUpsertOne {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateGameID()
+	})
+}
+
+// Exec executes the query.
+func (u *GameHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GameHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GameHistoryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GameHistoryUpsertOne.ID is not supported by MySQL driver. Use GameHistoryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GameHistoryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // GameHistoryCreateBulk is the builder for creating many GameHistory entities in bulk.
 type GameHistoryCreateBulk struct {
 	config
 	err      error
 	builders []*GameHistoryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the GameHistory entities in the database.
@@ -271,6 +554,7 @@ func (ghcb *GameHistoryCreateBulk) Save(ctx context.Context) ([]*GameHistory, er
 					_, err = mutators[i+1].Mutate(root, ghcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ghcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ghcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -320,3 +604,194 @@ func (ghcb *GameHistoryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameHistoryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ghcb *GameHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *GameHistoryUpsertBulk {
+	ghcb.conflict = opts
+	return &GameHistoryUpsertBulk{
+		create: ghcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ghcb *GameHistoryCreateBulk) OnConflictColumns(columns ...string) *GameHistoryUpsertBulk {
+	ghcb.conflict = append(ghcb.conflict, sql.ConflictColumns(columns...))
+	return &GameHistoryUpsertBulk{
+		create: ghcb,
+	}
+}
+
+// GameHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of GameHistory nodes.
+type GameHistoryUpsertBulk struct {
+	create *GameHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GameHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gamehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GameHistoryUpsertBulk) UpdateNewValues() *GameHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(gamehistory.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GameHistoryUpsertBulk) Ignore() *GameHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameHistoryUpsertBulk) DoNothing() *GameHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *GameHistoryUpsertBulk) Update(set func(*GameHistoryUpsert)) *GameHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *GameHistoryUpsertBulk) SetCreatedAt(v time.Time) *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *GameHistoryUpsertBulk) UpdateCreatedAt() *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetNum sets the "num" field.
+func (u *GameHistoryUpsertBulk) SetNum(v int) *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetNum(v)
+	})
+}
+
+// AddNum adds v to the "num" field.
+func (u *GameHistoryUpsertBulk) AddNum(v int) *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.AddNum(v)
+	})
+}
+
+// UpdateNum sets the "num" field to the value that was provided on create.
+func (u *GameHistoryUpsertBulk) UpdateNum() *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateNum()
+	})
+}
+
+// SetMove sets the "move" field.
+func (u *GameHistoryUpsertBulk) SetMove(v string) *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetMove(v)
+	})
+}
+
+// UpdateMove sets the "move" field to the value that was provided on create.
+func (u *GameHistoryUpsertBulk) UpdateMove() *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateMove()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GameHistoryUpsertBulk) SetUserID(v uuid.UUID) *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GameHistoryUpsertBulk) UpdateUserID() *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetGameID sets the "game_id" field.
+func (u *GameHistoryUpsertBulk) SetGameID(v uuid.UUID) *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.SetGameID(v)
+	})
+}
+
+// UpdateGameID sets the "game_id" field to the value that was provided on create.
+func (u *GameHistoryUpsertBulk) UpdateGameID() *GameHistoryUpsertBulk {
+	return u.Update(func(s *GameHistoryUpsert) {
+		s.UpdateGameID()
+	})
+}
+
+// Exec executes the query.
+func (u *GameHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GameHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GameHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
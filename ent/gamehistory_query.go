@@ -12,6 +12,7 @@ import (
 	"GopherChessParty/ent/predicate"
 	"GopherChessParty/ent/user"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +28,7 @@ type GameHistoryQuery struct {
 	predicates []predicate.GameHistory
 	withUser   *UserQuery
 	withGame   *ChessQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -421,6 +423,9 @@ func (ghq *GameHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ghq.modifiers) > 0 {
+		_spec.Modifiers = ghq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -506,6 +511,9 @@ func (ghq *GameHistoryQuery) loadGame(ctx context.Context, query *ChessQuery, no
 
 func (ghq *GameHistoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ghq.querySpec()
+	if len(ghq.modifiers) > 0 {
+		_spec.Modifiers = ghq.modifiers
+	}
 	_spec.Node.Columns = ghq.ctx.Fields
 	if len(ghq.ctx.Fields) > 0 {
 		_spec.Unique = ghq.ctx.Unique != nil && *ghq.ctx.Unique
@@ -574,6 +582,9 @@ func (ghq *GameHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ghq.ctx.Unique != nil && *ghq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ghq.modifiers {
+		m(selector)
+	}
 	for _, p := range ghq.predicates {
 		p(selector)
 	}
@@ -591,6 +602,32 @@ func (ghq *GameHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ghq *GameHistoryQuery) ForUpdate(opts ...sql.LockOption) *GameHistoryQuery {
+	if ghq.driver.Dialect() == dialect.Postgres {
+		ghq.Unique(false)
+	}
+	ghq.modifiers = append(ghq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ghq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ghq *GameHistoryQuery) ForShare(opts ...sql.LockOption) *GameHistoryQuery {
+	if ghq.driver.Dialect() == dialect.Postgres {
+		ghq.Unique(false)
+	}
+	ghq.modifiers = append(ghq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ghq
+}
+
 // GameHistoryGroupBy is the group-by builder for GameHistory entities.
 type GameHistoryGroupBy struct {
 	selector
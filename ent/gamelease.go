@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GopherChessParty/ent/gamelease"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// GameLease is the model entity for the GameLease schema.
+type GameLease struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// NodeID holds the value of the "node_id" field.
+	NodeID string `json:"node_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GameLease) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gamelease.FieldNodeID:
+			values[i] = new(sql.NullString)
+		case gamelease.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case gamelease.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GameLease fields.
+func (gl *GameLease) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gamelease.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				gl.ID = *value
+			}
+		case gamelease.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				gl.NodeID = value.String
+			}
+		case gamelease.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				gl.ExpiresAt = value.Time
+			}
+		default:
+			gl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GameLease.
+// This includes values selected through modifiers, order, etc.
+func (gl *GameLease) Value(name string) (ent.Value, error) {
+	return gl.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GameLease.
+// Note that you need to call GameLease.Unwrap() before calling this method if this GameLease
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gl *GameLease) Update() *GameLeaseUpdateOne {
+	return NewGameLeaseClient(gl.config).UpdateOne(gl)
+}
+
+// Unwrap unwraps the GameLease entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gl *GameLease) Unwrap() *GameLease {
+	_tx, ok := gl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GameLease is not a transactional entity")
+	}
+	gl.config.driver = _tx.drv
+	return gl
+}
+
+// String implements the fmt.Stringer.
+func (gl *GameLease) String() string {
+	var builder strings.Builder
+	builder.WriteString("GameLease(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gl.ID))
+	builder.WriteString("node_id=")
+	builder.WriteString(gl.NodeID)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(gl.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GameLeases is a parsable slice of GameLease.
+type GameLeases []*GameLease
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/gamelease"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GameLeaseCreate is the builder for creating a GameLease entity.
+type GameLeaseCreate struct {
+	config
+	mutation *GameLeaseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetNodeID sets the "node_id" field.
+func (glc *GameLeaseCreate) SetNodeID(s string) *GameLeaseCreate {
+	glc.mutation.SetNodeID(s)
+	return glc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (glc *GameLeaseCreate) SetExpiresAt(t time.Time) *GameLeaseCreate {
+	glc.mutation.SetExpiresAt(t)
+	return glc
+}
+
+// SetID sets the "id" field.
+func (glc *GameLeaseCreate) SetID(u uuid.UUID) *GameLeaseCreate {
+	glc.mutation.SetID(u)
+	return glc
+}
+
+// Mutation returns the GameLeaseMutation object of the builder.
+func (glc *GameLeaseCreate) Mutation() *GameLeaseMutation {
+	return glc.mutation
+}
+
+// Save creates the GameLease in the database.
+func (glc *GameLeaseCreate) Save(ctx context.Context) (*GameLease, error) {
+	return withHooks(ctx, glc.sqlSave, glc.mutation, glc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (glc *GameLeaseCreate) SaveX(ctx context.Context) *GameLease {
+	v, err := glc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (glc *GameLeaseCreate) Exec(ctx context.Context) error {
+	_, err := glc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (glc *GameLeaseCreate) ExecX(ctx context.Context) {
+	if err := glc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (glc *GameLeaseCreate) check() error {
+	if _, ok := glc.mutation.NodeID(); !ok {
+		return &ValidationError{Name: "node_id", err: errors.New(`ent: missing required field "GameLease.node_id"`)}
+	}
+	if v, ok := glc.mutation.NodeID(); ok {
+		if err := gamelease.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "GameLease.node_id": %w`, err)}
+		}
+	}
+	if _, ok := glc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "GameLease.expires_at"`)}
+	}
+	return nil
+}
+
+func (glc *GameLeaseCreate) sqlSave(ctx context.Context) (*GameLease, error) {
+	if err := glc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := glc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, glc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	glc.mutation.id = &_node.ID
+	glc.mutation.done = true
+	return _node, nil
+}
+
+func (glc *GameLeaseCreate) createSpec() (*GameLease, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GameLease{config: glc.config}
+		_spec = sqlgraph.NewCreateSpec(gamelease.Table, sqlgraph.NewFieldSpec(gamelease.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = glc.conflict
+	if id, ok := glc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := glc.mutation.NodeID(); ok {
+		_spec.SetField(gamelease.FieldNodeID, field.TypeString, value)
+		_node.NodeID = value
+	}
+	if value, ok := glc.mutation.ExpiresAt(); ok {
+		_spec.SetField(gamelease.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameLease.Create().
+//		SetNodeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameLeaseUpsert) {
+//			SetNodeID(v+v).
+//		}).
+//		Exec(ctx)
+func (glc *GameLeaseCreate) OnConflict(opts ...sql.ConflictOption) *GameLeaseUpsertOne {
+	glc.conflict = opts
+	return &GameLeaseUpsertOne{
+		create: glc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameLease.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (glc *GameLeaseCreate) OnConflictColumns(columns ...string) *GameLeaseUpsertOne {
+	glc.conflict = append(glc.conflict, sql.ConflictColumns(columns...))
+	return &GameLeaseUpsertOne{
+		create: glc,
+	}
+}
+
+type (
+	// GameLeaseUpsertOne is the builder for "upsert"-ing
+	//  one GameLease node.
+	GameLeaseUpsertOne struct {
+		create *GameLeaseCreate
+	}
+
+	// GameLeaseUpsert is the "OnConflict" setter.
+	GameLeaseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNodeID sets the "node_id" field.
+func (u *GameLeaseUpsert) SetNodeID(v string) *GameLeaseUpsert {
+	u.Set(gamelease.FieldNodeID, v)
+	return u
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *GameLeaseUpsert) UpdateNodeID() *GameLeaseUpsert {
+	u.SetExcluded(gamelease.FieldNodeID)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *GameLeaseUpsert) SetExpiresAt(v time.Time) *GameLeaseUpsert {
+	u.Set(gamelease.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *GameLeaseUpsert) UpdateExpiresAt() *GameLeaseUpsert {
+	u.SetExcluded(gamelease.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GameLease.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gamelease.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GameLeaseUpsertOne) UpdateNewValues() *GameLeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(gamelease.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameLease.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GameLeaseUpsertOne) Ignore() *GameLeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameLeaseUpsertOne) DoNothing() *GameLeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameLeaseCreate.OnConflict
+// documentation for more info.
+func (u *GameLeaseUpsertOne) Update(set func(*GameLeaseUpsert)) *GameLeaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameLeaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *GameLeaseUpsertOne) SetNodeID(v string) *GameLeaseUpsertOne {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *GameLeaseUpsertOne) UpdateNodeID() *GameLeaseUpsertOne {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *GameLeaseUpsertOne) SetExpiresAt(v time.Time) *GameLeaseUpsertOne {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *GameLeaseUpsertOne) UpdateExpiresAt() *GameLeaseUpsertOne {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GameLeaseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GameLeaseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameLeaseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GameLeaseUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GameLeaseUpsertOne.ID is not supported by MySQL driver. Use GameLeaseUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GameLeaseUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GameLeaseCreateBulk is the builder for creating many GameLease entities in bulk.
+type GameLeaseCreateBulk struct {
+	config
+	err      error
+	builders []*GameLeaseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GameLease entities in the database.
+func (glcb *GameLeaseCreateBulk) Save(ctx context.Context) ([]*GameLease, error) {
+	if glcb.err != nil {
+		return nil, glcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(glcb.builders))
+	nodes := make([]*GameLease, len(glcb.builders))
+	mutators := make([]Mutator, len(glcb.builders))
+	for i := range glcb.builders {
+		func(i int, root context.Context) {
+			builder := glcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GameLeaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, glcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = glcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, glcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, glcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (glcb *GameLeaseCreateBulk) SaveX(ctx context.Context) []*GameLease {
+	v, err := glcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (glcb *GameLeaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := glcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (glcb *GameLeaseCreateBulk) ExecX(ctx context.Context) {
+	if err := glcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameLease.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameLeaseUpsert) {
+//			SetNodeID(v+v).
+//		}).
+//		Exec(ctx)
+func (glcb *GameLeaseCreateBulk) OnConflict(opts ...sql.ConflictOption) *GameLeaseUpsertBulk {
+	glcb.conflict = opts
+	return &GameLeaseUpsertBulk{
+		create: glcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameLease.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (glcb *GameLeaseCreateBulk) OnConflictColumns(columns ...string) *GameLeaseUpsertBulk {
+	glcb.conflict = append(glcb.conflict, sql.ConflictColumns(columns...))
+	return &GameLeaseUpsertBulk{
+		create: glcb,
+	}
+}
+
+// GameLeaseUpsertBulk is the builder for "upsert"-ing
+// a bulk of GameLease nodes.
+type GameLeaseUpsertBulk struct {
+	create *GameLeaseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GameLease.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gamelease.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GameLeaseUpsertBulk) UpdateNewValues() *GameLeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(gamelease.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameLease.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GameLeaseUpsertBulk) Ignore() *GameLeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameLeaseUpsertBulk) DoNothing() *GameLeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameLeaseCreateBulk.OnConflict
+// documentation for more info.
+func (u *GameLeaseUpsertBulk) Update(set func(*GameLeaseUpsert)) *GameLeaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameLeaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *GameLeaseUpsertBulk) SetNodeID(v string) *GameLeaseUpsertBulk {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *GameLeaseUpsertBulk) UpdateNodeID() *GameLeaseUpsertBulk {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *GameLeaseUpsertBulk) SetExpiresAt(v time.Time) *GameLeaseUpsertBulk {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *GameLeaseUpsertBulk) UpdateExpiresAt() *GameLeaseUpsertBulk {
+	return u.Update(func(s *GameLeaseUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GameLeaseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GameLeaseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GameLeaseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameLeaseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
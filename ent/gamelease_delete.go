@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"GopherChessParty/ent/gamelease"
+	"GopherChessParty/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameLeaseDelete is the builder for deleting a GameLease entity.
+type GameLeaseDelete struct {
+	config
+	hooks    []Hook
+	mutation *GameLeaseMutation
+}
+
+// Where appends a list predicates to the GameLeaseDelete builder.
+func (gld *GameLeaseDelete) Where(ps ...predicate.GameLease) *GameLeaseDelete {
+	gld.mutation.Where(ps...)
+	return gld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gld *GameLeaseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gld.sqlExec, gld.mutation, gld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gld *GameLeaseDelete) ExecX(ctx context.Context) int {
+	n, err := gld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gld *GameLeaseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gamelease.Table, sqlgraph.NewFieldSpec(gamelease.FieldID, field.TypeUUID))
+	if ps := gld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gld.mutation.done = true
+	return affected, err
+}
+
+// GameLeaseDeleteOne is the builder for deleting a single GameLease entity.
+type GameLeaseDeleteOne struct {
+	gld *GameLeaseDelete
+}
+
+// Where appends a list predicates to the GameLeaseDelete builder.
+func (gldo *GameLeaseDeleteOne) Where(ps ...predicate.GameLease) *GameLeaseDeleteOne {
+	gldo.gld.mutation.Where(ps...)
+	return gldo
+}
+
+// Exec executes the deletion query.
+func (gldo *GameLeaseDeleteOne) Exec(ctx context.Context) error {
+	n, err := gldo.gld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gamelease.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gldo *GameLeaseDeleteOne) ExecX(ctx context.Context) {
+	if err := gldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"GopherChessParty/ent/gamelease"
+	"GopherChessParty/ent/predicate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GameLeaseQuery is the builder for querying GameLease entities.
+type GameLeaseQuery struct {
+	config
+	ctx        *QueryContext
+	order      []gamelease.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GameLease
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GameLeaseQuery builder.
+func (glq *GameLeaseQuery) Where(ps ...predicate.GameLease) *GameLeaseQuery {
+	glq.predicates = append(glq.predicates, ps...)
+	return glq
+}
+
+// Limit the number of records to be returned by this query.
+func (glq *GameLeaseQuery) Limit(limit int) *GameLeaseQuery {
+	glq.ctx.Limit = &limit
+	return glq
+}
+
+// Offset to start from.
+func (glq *GameLeaseQuery) Offset(offset int) *GameLeaseQuery {
+	glq.ctx.Offset = &offset
+	return glq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (glq *GameLeaseQuery) Unique(unique bool) *GameLeaseQuery {
+	glq.ctx.Unique = &unique
+	return glq
+}
+
+// Order specifies how the records should be ordered.
+func (glq *GameLeaseQuery) Order(o ...gamelease.OrderOption) *GameLeaseQuery {
+	glq.order = append(glq.order, o...)
+	return glq
+}
+
+// First returns the first GameLease entity from the query.
+// Returns a *NotFoundError when no GameLease was found.
+func (glq *GameLeaseQuery) First(ctx context.Context) (*GameLease, error) {
+	nodes, err := glq.Limit(1).All(setContextOp(ctx, glq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{gamelease.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (glq *GameLeaseQuery) FirstX(ctx context.Context) *GameLease {
+	node, err := glq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GameLease ID from the query.
+// Returns a *NotFoundError when no GameLease ID was found.
+func (glq *GameLeaseQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = glq.Limit(1).IDs(setContextOp(ctx, glq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{gamelease.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (glq *GameLeaseQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := glq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GameLease entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GameLease entity is found.
+// Returns a *NotFoundError when no GameLease entities are found.
+func (glq *GameLeaseQuery) Only(ctx context.Context) (*GameLease, error) {
+	nodes, err := glq.Limit(2).All(setContextOp(ctx, glq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{gamelease.Label}
+	default:
+		return nil, &NotSingularError{gamelease.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (glq *GameLeaseQuery) OnlyX(ctx context.Context) *GameLease {
+	node, err := glq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GameLease ID in the query.
+// Returns a *NotSingularError when more than one GameLease ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (glq *GameLeaseQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = glq.Limit(2).IDs(setContextOp(ctx, glq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{gamelease.Label}
+	default:
+		err = &NotSingularError{gamelease.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (glq *GameLeaseQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := glq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GameLeases.
+func (glq *GameLeaseQuery) All(ctx context.Context) ([]*GameLease, error) {
+	ctx = setContextOp(ctx, glq.ctx, ent.OpQueryAll)
+	if err := glq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GameLease, *GameLeaseQuery]()
+	return withInterceptors[[]*GameLease](ctx, glq, qr, glq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (glq *GameLeaseQuery) AllX(ctx context.Context) []*GameLease {
+	nodes, err := glq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GameLease IDs.
+func (glq *GameLeaseQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if glq.ctx.Unique == nil && glq.path != nil {
+		glq.Unique(true)
+	}
+	ctx = setContextOp(ctx, glq.ctx, ent.OpQueryIDs)
+	if err = glq.Select(gamelease.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (glq *GameLeaseQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := glq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (glq *GameLeaseQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, glq.ctx, ent.OpQueryCount)
+	if err := glq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, glq, querierCount[*GameLeaseQuery](), glq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (glq *GameLeaseQuery) CountX(ctx context.Context) int {
+	count, err := glq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (glq *GameLeaseQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, glq.ctx, ent.OpQueryExist)
+	switch _, err := glq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (glq *GameLeaseQuery) ExistX(ctx context.Context) bool {
+	exist, err := glq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GameLeaseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (glq *GameLeaseQuery) Clone() *GameLeaseQuery {
+	if glq == nil {
+		return nil
+	}
+	return &GameLeaseQuery{
+		config:     glq.config,
+		ctx:        glq.ctx.Clone(),
+		order:      append([]gamelease.OrderOption{}, glq.order...),
+		inters:     append([]Interceptor{}, glq.inters...),
+		predicates: append([]predicate.GameLease{}, glq.predicates...),
+		// clone intermediate query.
+		sql:  glq.sql.Clone(),
+		path: glq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		NodeID string `json:"node_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GameLease.Query().
+//		GroupBy(gamelease.FieldNodeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (glq *GameLeaseQuery) GroupBy(field string, fields ...string) *GameLeaseGroupBy {
+	glq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GameLeaseGroupBy{build: glq}
+	grbuild.flds = &glq.ctx.Fields
+	grbuild.label = gamelease.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		NodeID string `json:"node_id,omitempty"`
+//	}
+//
+//	client.GameLease.Query().
+//		Select(gamelease.FieldNodeID).
+//		Scan(ctx, &v)
+func (glq *GameLeaseQuery) Select(fields ...string) *GameLeaseSelect {
+	glq.ctx.Fields = append(glq.ctx.Fields, fields...)
+	sbuild := &GameLeaseSelect{GameLeaseQuery: glq}
+	sbuild.label = gamelease.Label
+	sbuild.flds, sbuild.scan = &glq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GameLeaseSelect configured with the given aggregations.
+func (glq *GameLeaseQuery) Aggregate(fns ...AggregateFunc) *GameLeaseSelect {
+	return glq.Select().Aggregate(fns...)
+}
+
+func (glq *GameLeaseQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range glq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, glq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range glq.ctx.Fields {
+		if !gamelease.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if glq.path != nil {
+		prev, err := glq.path(ctx)
+		if err != nil {
+			return err
+		}
+		glq.sql = prev
+	}
+	return nil
+}
+
+func (glq *GameLeaseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GameLease, error) {
+	var (
+		nodes = []*GameLease{}
+		_spec = glq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GameLease).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GameLease{config: glq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(glq.modifiers) > 0 {
+		_spec.Modifiers = glq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, glq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (glq *GameLeaseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := glq.querySpec()
+	if len(glq.modifiers) > 0 {
+		_spec.Modifiers = glq.modifiers
+	}
+	_spec.Node.Columns = glq.ctx.Fields
+	if len(glq.ctx.Fields) > 0 {
+		_spec.Unique = glq.ctx.Unique != nil && *glq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, glq.driver, _spec)
+}
+
+func (glq *GameLeaseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(gamelease.Table, gamelease.Columns, sqlgraph.NewFieldSpec(gamelease.FieldID, field.TypeUUID))
+	_spec.From = glq.sql
+	if unique := glq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if glq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := glq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gamelease.FieldID)
+		for i := range fields {
+			if fields[i] != gamelease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := glq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := glq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := glq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := glq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (glq *GameLeaseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(glq.driver.Dialect())
+	t1 := builder.Table(gamelease.Table)
+	columns := glq.ctx.Fields
+	if len(columns) == 0 {
+		columns = gamelease.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if glq.sql != nil {
+		selector = glq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if glq.ctx.Unique != nil && *glq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range glq.modifiers {
+		m(selector)
+	}
+	for _, p := range glq.predicates {
+		p(selector)
+	}
+	for _, p := range glq.order {
+		p(selector)
+	}
+	if offset := glq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := glq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (glq *GameLeaseQuery) ForUpdate(opts ...sql.LockOption) *GameLeaseQuery {
+	if glq.driver.Dialect() == dialect.Postgres {
+		glq.Unique(false)
+	}
+	glq.modifiers = append(glq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return glq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (glq *GameLeaseQuery) ForShare(opts ...sql.LockOption) *GameLeaseQuery {
+	if glq.driver.Dialect() == dialect.Postgres {
+		glq.Unique(false)
+	}
+	glq.modifiers = append(glq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return glq
+}
+
+// GameLeaseGroupBy is the group-by builder for GameLease entities.
+type GameLeaseGroupBy struct {
+	selector
+	build *GameLeaseQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (glgb *GameLeaseGroupBy) Aggregate(fns ...AggregateFunc) *GameLeaseGroupBy {
+	glgb.fns = append(glgb.fns, fns...)
+	return glgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (glgb *GameLeaseGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, glgb.build.ctx, ent.OpQueryGroupBy)
+	if err := glgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GameLeaseQuery, *GameLeaseGroupBy](ctx, glgb.build, glgb, glgb.build.inters, v)
+}
+
+func (glgb *GameLeaseGroupBy) sqlScan(ctx context.Context, root *GameLeaseQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(glgb.fns))
+	for _, fn := range glgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*glgb.flds)+len(glgb.fns))
+		for _, f := range *glgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*glgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := glgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GameLeaseSelect is the builder for selecting fields of GameLease entities.
+type GameLeaseSelect struct {
+	*GameLeaseQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gls *GameLeaseSelect) Aggregate(fns ...AggregateFunc) *GameLeaseSelect {
+	gls.fns = append(gls.fns, fns...)
+	return gls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gls *GameLeaseSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gls.ctx, ent.OpQuerySelect)
+	if err := gls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GameLeaseQuery, *GameLeaseSelect](ctx, gls.GameLeaseQuery, gls, gls.inters, v)
+}
+
+func (gls *GameLeaseSelect) sqlScan(ctx context.Context, root *GameLeaseQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gls.fns))
+	for _, fn := range gls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/gamelease"
+	"GopherChessParty/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameLeaseUpdate is the builder for updating GameLease entities.
+type GameLeaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GameLeaseMutation
+}
+
+// Where appends a list predicates to the GameLeaseUpdate builder.
+func (glu *GameLeaseUpdate) Where(ps ...predicate.GameLease) *GameLeaseUpdate {
+	glu.mutation.Where(ps...)
+	return glu
+}
+
+// SetNodeID sets the "node_id" field.
+func (glu *GameLeaseUpdate) SetNodeID(s string) *GameLeaseUpdate {
+	glu.mutation.SetNodeID(s)
+	return glu
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (glu *GameLeaseUpdate) SetNillableNodeID(s *string) *GameLeaseUpdate {
+	if s != nil {
+		glu.SetNodeID(*s)
+	}
+	return glu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (glu *GameLeaseUpdate) SetExpiresAt(t time.Time) *GameLeaseUpdate {
+	glu.mutation.SetExpiresAt(t)
+	return glu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (glu *GameLeaseUpdate) SetNillableExpiresAt(t *time.Time) *GameLeaseUpdate {
+	if t != nil {
+		glu.SetExpiresAt(*t)
+	}
+	return glu
+}
+
+// Mutation returns the GameLeaseMutation object of the builder.
+func (glu *GameLeaseUpdate) Mutation() *GameLeaseMutation {
+	return glu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (glu *GameLeaseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, glu.sqlSave, glu.mutation, glu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (glu *GameLeaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := glu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (glu *GameLeaseUpdate) Exec(ctx context.Context) error {
+	_, err := glu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (glu *GameLeaseUpdate) ExecX(ctx context.Context) {
+	if err := glu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (glu *GameLeaseUpdate) check() error {
+	if v, ok := glu.mutation.NodeID(); ok {
+		if err := gamelease.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "GameLease.node_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (glu *GameLeaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := glu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamelease.Table, gamelease.Columns, sqlgraph.NewFieldSpec(gamelease.FieldID, field.TypeUUID))
+	if ps := glu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := glu.mutation.NodeID(); ok {
+		_spec.SetField(gamelease.FieldNodeID, field.TypeString, value)
+	}
+	if value, ok := glu.mutation.ExpiresAt(); ok {
+		_spec.SetField(gamelease.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, glu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamelease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	glu.mutation.done = true
+	return n, nil
+}
+
+// GameLeaseUpdateOne is the builder for updating a single GameLease entity.
+type GameLeaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GameLeaseMutation
+}
+
+// SetNodeID sets the "node_id" field.
+func (gluo *GameLeaseUpdateOne) SetNodeID(s string) *GameLeaseUpdateOne {
+	gluo.mutation.SetNodeID(s)
+	return gluo
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (gluo *GameLeaseUpdateOne) SetNillableNodeID(s *string) *GameLeaseUpdateOne {
+	if s != nil {
+		gluo.SetNodeID(*s)
+	}
+	return gluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (gluo *GameLeaseUpdateOne) SetExpiresAt(t time.Time) *GameLeaseUpdateOne {
+	gluo.mutation.SetExpiresAt(t)
+	return gluo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (gluo *GameLeaseUpdateOne) SetNillableExpiresAt(t *time.Time) *GameLeaseUpdateOne {
+	if t != nil {
+		gluo.SetExpiresAt(*t)
+	}
+	return gluo
+}
+
+// Mutation returns the GameLeaseMutation object of the builder.
+func (gluo *GameLeaseUpdateOne) Mutation() *GameLeaseMutation {
+	return gluo.mutation
+}
+
+// Where appends a list predicates to the GameLeaseUpdate builder.
+func (gluo *GameLeaseUpdateOne) Where(ps ...predicate.GameLease) *GameLeaseUpdateOne {
+	gluo.mutation.Where(ps...)
+	return gluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gluo *GameLeaseUpdateOne) Select(field string, fields ...string) *GameLeaseUpdateOne {
+	gluo.fields = append([]string{field}, fields...)
+	return gluo
+}
+
+// Save executes the query and returns the updated GameLease entity.
+func (gluo *GameLeaseUpdateOne) Save(ctx context.Context) (*GameLease, error) {
+	return withHooks(ctx, gluo.sqlSave, gluo.mutation, gluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gluo *GameLeaseUpdateOne) SaveX(ctx context.Context) *GameLease {
+	node, err := gluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gluo *GameLeaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := gluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gluo *GameLeaseUpdateOne) ExecX(ctx context.Context) {
+	if err := gluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gluo *GameLeaseUpdateOne) check() error {
+	if v, ok := gluo.mutation.NodeID(); ok {
+		if err := gamelease.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "GameLease.node_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gluo *GameLeaseUpdateOne) sqlSave(ctx context.Context) (_node *GameLease, err error) {
+	if err := gluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamelease.Table, gamelease.Columns, sqlgraph.NewFieldSpec(gamelease.FieldID, field.TypeUUID))
+	id, ok := gluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GameLease.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gamelease.FieldID)
+		for _, f := range fields {
+			if !gamelease.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gamelease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gluo.mutation.NodeID(); ok {
+		_spec.SetField(gamelease.FieldNodeID, field.TypeString, value)
+	}
+	if value, ok := gluo.mutation.ExpiresAt(); ok {
+		_spec.SetField(gamelease.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &GameLease{config: gluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamelease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gluo.mutation.done = true
+	return _node, nil
+}
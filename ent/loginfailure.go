@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GopherChessParty/ent/loginfailure"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LoginFailure is the model entity for the LoginFailure schema.
+type LoginFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int `json:"count,omitempty"`
+	// LastFailedAt holds the value of the "last_failed_at" field.
+	LastFailedAt time.Time `json:"last_failed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginfailure.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case loginfailure.FieldID:
+			values[i] = new(sql.NullString)
+		case loginfailure.FieldLastFailedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginFailure fields.
+func (lf *LoginFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginfailure.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				lf.ID = value.String
+			}
+		case loginfailure.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				lf.Count = int(value.Int64)
+			}
+		case loginfailure.FieldLastFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_at", values[i])
+			} else if value.Valid {
+				lf.LastFailedAt = value.Time
+			}
+		default:
+			lf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginFailure.
+// This includes values selected through modifiers, order, etc.
+func (lf *LoginFailure) Value(name string) (ent.Value, error) {
+	return lf.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginFailure.
+// Note that you need to call LoginFailure.Unwrap() before calling this method if this LoginFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lf *LoginFailure) Update() *LoginFailureUpdateOne {
+	return NewLoginFailureClient(lf.config).UpdateOne(lf)
+}
+
+// Unwrap unwraps the LoginFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lf *LoginFailure) Unwrap() *LoginFailure {
+	_tx, ok := lf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginFailure is not a transactional entity")
+	}
+	lf.config.driver = _tx.drv
+	return lf
+}
+
+// String implements the fmt.Stringer.
+func (lf *LoginFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lf.ID))
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", lf.Count))
+	builder.WriteString(", ")
+	builder.WriteString("last_failed_at=")
+	builder.WriteString(lf.LastFailedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginFailures is a parsable slice of LoginFailure.
+type LoginFailures []*LoginFailure
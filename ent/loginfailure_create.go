@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/loginfailure"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginFailureCreate is the builder for creating a LoginFailure entity.
+type LoginFailureCreate struct {
+	config
+	mutation *LoginFailureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCount sets the "count" field.
+func (lfc *LoginFailureCreate) SetCount(i int) *LoginFailureCreate {
+	lfc.mutation.SetCount(i)
+	return lfc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (lfc *LoginFailureCreate) SetNillableCount(i *int) *LoginFailureCreate {
+	if i != nil {
+		lfc.SetCount(*i)
+	}
+	return lfc
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (lfc *LoginFailureCreate) SetLastFailedAt(t time.Time) *LoginFailureCreate {
+	lfc.mutation.SetLastFailedAt(t)
+	return lfc
+}
+
+// SetID sets the "id" field.
+func (lfc *LoginFailureCreate) SetID(s string) *LoginFailureCreate {
+	lfc.mutation.SetID(s)
+	return lfc
+}
+
+// Mutation returns the LoginFailureMutation object of the builder.
+func (lfc *LoginFailureCreate) Mutation() *LoginFailureMutation {
+	return lfc.mutation
+}
+
+// Save creates the LoginFailure in the database.
+func (lfc *LoginFailureCreate) Save(ctx context.Context) (*LoginFailure, error) {
+	lfc.defaults()
+	return withHooks(ctx, lfc.sqlSave, lfc.mutation, lfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lfc *LoginFailureCreate) SaveX(ctx context.Context) *LoginFailure {
+	v, err := lfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lfc *LoginFailureCreate) Exec(ctx context.Context) error {
+	_, err := lfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfc *LoginFailureCreate) ExecX(ctx context.Context) {
+	if err := lfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lfc *LoginFailureCreate) defaults() {
+	if _, ok := lfc.mutation.Count(); !ok {
+		v := loginfailure.DefaultCount
+		lfc.mutation.SetCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lfc *LoginFailureCreate) check() error {
+	if _, ok := lfc.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "LoginFailure.count"`)}
+	}
+	if _, ok := lfc.mutation.LastFailedAt(); !ok {
+		return &ValidationError{Name: "last_failed_at", err: errors.New(`ent: missing required field "LoginFailure.last_failed_at"`)}
+	}
+	if v, ok := lfc.mutation.ID(); ok {
+		if err := loginfailure.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LoginFailure.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lfc *LoginFailureCreate) sqlSave(ctx context.Context) (*LoginFailure, error) {
+	if err := lfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LoginFailure.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lfc.mutation.id = &_node.ID
+	lfc.mutation.done = true
+	return _node, nil
+}
+
+func (lfc *LoginFailureCreate) createSpec() (*LoginFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginFailure{config: lfc.config}
+		_spec = sqlgraph.NewCreateSpec(loginfailure.Table, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = lfc.conflict
+	if id, ok := lfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lfc.mutation.Count(); ok {
+		_spec.SetField(loginfailure.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := lfc.mutation.LastFailedAt(); ok {
+		_spec.SetField(loginfailure.FieldLastFailedAt, field.TypeTime, value)
+		_node.LastFailedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginFailure.Create().
+//		SetCount(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginFailureUpsert) {
+//			SetCount(v+v).
+//		}).
+//		Exec(ctx)
+func (lfc *LoginFailureCreate) OnConflict(opts ...sql.ConflictOption) *LoginFailureUpsertOne {
+	lfc.conflict = opts
+	return &LoginFailureUpsertOne{
+		create: lfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lfc *LoginFailureCreate) OnConflictColumns(columns ...string) *LoginFailureUpsertOne {
+	lfc.conflict = append(lfc.conflict, sql.ConflictColumns(columns...))
+	return &LoginFailureUpsertOne{
+		create: lfc,
+	}
+}
+
+type (
+	// LoginFailureUpsertOne is the builder for "upsert"-ing
+	//  one LoginFailure node.
+	LoginFailureUpsertOne struct {
+		create *LoginFailureCreate
+	}
+
+	// LoginFailureUpsert is the "OnConflict" setter.
+	LoginFailureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCount sets the "count" field.
+func (u *LoginFailureUpsert) SetCount(v int) *LoginFailureUpsert {
+	u.Set(loginfailure.FieldCount, v)
+	return u
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *LoginFailureUpsert) UpdateCount() *LoginFailureUpsert {
+	u.SetExcluded(loginfailure.FieldCount)
+	return u
+}
+
+// AddCount adds v to the "count" field.
+func (u *LoginFailureUpsert) AddCount(v int) *LoginFailureUpsert {
+	u.Add(loginfailure.FieldCount, v)
+	return u
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (u *LoginFailureUpsert) SetLastFailedAt(v time.Time) *LoginFailureUpsert {
+	u.Set(loginfailure.FieldLastFailedAt, v)
+	return u
+}
+
+// UpdateLastFailedAt sets the "last_failed_at" field to the value that was provided on create.
+func (u *LoginFailureUpsert) UpdateLastFailedAt() *LoginFailureUpsert {
+	u.SetExcluded(loginfailure.FieldLastFailedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loginfailure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoginFailureUpsertOne) UpdateNewValues() *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(loginfailure.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoginFailureUpsertOne) Ignore() *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginFailureUpsertOne) DoNothing() *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginFailureCreate.OnConflict
+// documentation for more info.
+func (u *LoginFailureUpsertOne) Update(set func(*LoginFailureUpsert)) *LoginFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCount sets the "count" field.
+func (u *LoginFailureUpsertOne) SetCount(v int) *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *LoginFailureUpsertOne) AddCount(v int) *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *LoginFailureUpsertOne) UpdateCount() *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (u *LoginFailureUpsertOne) SetLastFailedAt(v time.Time) *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetLastFailedAt(v)
+	})
+}
+
+// UpdateLastFailedAt sets the "last_failed_at" field to the value that was provided on create.
+func (u *LoginFailureUpsertOne) UpdateLastFailedAt() *LoginFailureUpsertOne {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateLastFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginFailureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginFailureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginFailureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoginFailureUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LoginFailureUpsertOne.ID is not supported by MySQL driver. Use LoginFailureUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoginFailureUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoginFailureCreateBulk is the builder for creating many LoginFailure entities in bulk.
+type LoginFailureCreateBulk struct {
+	config
+	err      error
+	builders []*LoginFailureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoginFailure entities in the database.
+func (lfcb *LoginFailureCreateBulk) Save(ctx context.Context) ([]*LoginFailure, error) {
+	if lfcb.err != nil {
+		return nil, lfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lfcb.builders))
+	nodes := make([]*LoginFailure, len(lfcb.builders))
+	mutators := make([]Mutator, len(lfcb.builders))
+	for i := range lfcb.builders {
+		func(i int, root context.Context) {
+			builder := lfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lfcb *LoginFailureCreateBulk) SaveX(ctx context.Context) []*LoginFailure {
+	v, err := lfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lfcb *LoginFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := lfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfcb *LoginFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := lfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginFailure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginFailureUpsert) {
+//			SetCount(v+v).
+//		}).
+//		Exec(ctx)
+func (lfcb *LoginFailureCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoginFailureUpsertBulk {
+	lfcb.conflict = opts
+	return &LoginFailureUpsertBulk{
+		create: lfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lfcb *LoginFailureCreateBulk) OnConflictColumns(columns ...string) *LoginFailureUpsertBulk {
+	lfcb.conflict = append(lfcb.conflict, sql.ConflictColumns(columns...))
+	return &LoginFailureUpsertBulk{
+		create: lfcb,
+	}
+}
+
+// LoginFailureUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoginFailure nodes.
+type LoginFailureUpsertBulk struct {
+	create *LoginFailureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loginfailure.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoginFailureUpsertBulk) UpdateNewValues() *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(loginfailure.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginFailure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoginFailureUpsertBulk) Ignore() *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginFailureUpsertBulk) DoNothing() *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginFailureCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoginFailureUpsertBulk) Update(set func(*LoginFailureUpsert)) *LoginFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCount sets the "count" field.
+func (u *LoginFailureUpsertBulk) SetCount(v int) *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *LoginFailureUpsertBulk) AddCount(v int) *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *LoginFailureUpsertBulk) UpdateCount() *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (u *LoginFailureUpsertBulk) SetLastFailedAt(v time.Time) *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.SetLastFailedAt(v)
+	})
+}
+
+// UpdateLastFailedAt sets the "last_failed_at" field to the value that was provided on create.
+func (u *LoginFailureUpsertBulk) UpdateLastFailedAt() *LoginFailureUpsertBulk {
+	return u.Update(func(s *LoginFailureUpsert) {
+		s.UpdateLastFailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginFailureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoginFailureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginFailureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginFailureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
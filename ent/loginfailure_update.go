@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/loginfailure"
+	"GopherChessParty/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginFailureUpdate is the builder for updating LoginFailure entities.
+type LoginFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginFailureMutation
+}
+
+// Where appends a list predicates to the LoginFailureUpdate builder.
+func (lfu *LoginFailureUpdate) Where(ps ...predicate.LoginFailure) *LoginFailureUpdate {
+	lfu.mutation.Where(ps...)
+	return lfu
+}
+
+// SetCount sets the "count" field.
+func (lfu *LoginFailureUpdate) SetCount(i int) *LoginFailureUpdate {
+	lfu.mutation.ResetCount()
+	lfu.mutation.SetCount(i)
+	return lfu
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (lfu *LoginFailureUpdate) SetNillableCount(i *int) *LoginFailureUpdate {
+	if i != nil {
+		lfu.SetCount(*i)
+	}
+	return lfu
+}
+
+// AddCount adds i to the "count" field.
+func (lfu *LoginFailureUpdate) AddCount(i int) *LoginFailureUpdate {
+	lfu.mutation.AddCount(i)
+	return lfu
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (lfu *LoginFailureUpdate) SetLastFailedAt(t time.Time) *LoginFailureUpdate {
+	lfu.mutation.SetLastFailedAt(t)
+	return lfu
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (lfu *LoginFailureUpdate) SetNillableLastFailedAt(t *time.Time) *LoginFailureUpdate {
+	if t != nil {
+		lfu.SetLastFailedAt(*t)
+	}
+	return lfu
+}
+
+// Mutation returns the LoginFailureMutation object of the builder.
+func (lfu *LoginFailureUpdate) Mutation() *LoginFailureMutation {
+	return lfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lfu *LoginFailureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lfu.sqlSave, lfu.mutation, lfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lfu *LoginFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := lfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lfu *LoginFailureUpdate) Exec(ctx context.Context) error {
+	_, err := lfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfu *LoginFailureUpdate) ExecX(ctx context.Context) {
+	if err := lfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lfu *LoginFailureUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginfailure.Table, loginfailure.Columns, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeString))
+	if ps := lfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lfu.mutation.Count(); ok {
+		_spec.SetField(loginfailure.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := lfu.mutation.AddedCount(); ok {
+		_spec.AddField(loginfailure.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := lfu.mutation.LastFailedAt(); ok {
+		_spec.SetField(loginfailure.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lfu.mutation.done = true
+	return n, nil
+}
+
+// LoginFailureUpdateOne is the builder for updating a single LoginFailure entity.
+type LoginFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginFailureMutation
+}
+
+// SetCount sets the "count" field.
+func (lfuo *LoginFailureUpdateOne) SetCount(i int) *LoginFailureUpdateOne {
+	lfuo.mutation.ResetCount()
+	lfuo.mutation.SetCount(i)
+	return lfuo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (lfuo *LoginFailureUpdateOne) SetNillableCount(i *int) *LoginFailureUpdateOne {
+	if i != nil {
+		lfuo.SetCount(*i)
+	}
+	return lfuo
+}
+
+// AddCount adds i to the "count" field.
+func (lfuo *LoginFailureUpdateOne) AddCount(i int) *LoginFailureUpdateOne {
+	lfuo.mutation.AddCount(i)
+	return lfuo
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (lfuo *LoginFailureUpdateOne) SetLastFailedAt(t time.Time) *LoginFailureUpdateOne {
+	lfuo.mutation.SetLastFailedAt(t)
+	return lfuo
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (lfuo *LoginFailureUpdateOne) SetNillableLastFailedAt(t *time.Time) *LoginFailureUpdateOne {
+	if t != nil {
+		lfuo.SetLastFailedAt(*t)
+	}
+	return lfuo
+}
+
+// Mutation returns the LoginFailureMutation object of the builder.
+func (lfuo *LoginFailureUpdateOne) Mutation() *LoginFailureMutation {
+	return lfuo.mutation
+}
+
+// Where appends a list predicates to the LoginFailureUpdate builder.
+func (lfuo *LoginFailureUpdateOne) Where(ps ...predicate.LoginFailure) *LoginFailureUpdateOne {
+	lfuo.mutation.Where(ps...)
+	return lfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lfuo *LoginFailureUpdateOne) Select(field string, fields ...string) *LoginFailureUpdateOne {
+	lfuo.fields = append([]string{field}, fields...)
+	return lfuo
+}
+
+// Save executes the query and returns the updated LoginFailure entity.
+func (lfuo *LoginFailureUpdateOne) Save(ctx context.Context) (*LoginFailure, error) {
+	return withHooks(ctx, lfuo.sqlSave, lfuo.mutation, lfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lfuo *LoginFailureUpdateOne) SaveX(ctx context.Context) *LoginFailure {
+	node, err := lfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lfuo *LoginFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := lfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lfuo *LoginFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := lfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lfuo *LoginFailureUpdateOne) sqlSave(ctx context.Context) (_node *LoginFailure, err error) {
+	_spec := sqlgraph.NewUpdateSpec(loginfailure.Table, loginfailure.Columns, sqlgraph.NewFieldSpec(loginfailure.FieldID, field.TypeString))
+	id, ok := lfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginfailure.FieldID)
+		for _, f := range fields {
+			if !loginfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lfuo.mutation.Count(); ok {
+		_spec.SetField(loginfailure.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := lfuo.mutation.AddedCount(); ok {
+		_spec.AddField(loginfailure.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := lfuo.mutation.LastFailedAt(); ok {
+		_spec.SetField(loginfailure.FieldLastFailedAt, field.TypeTime, value)
+	}
+	_node = &LoginFailure{config: lfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lfuo.mutation.done = true
+	return _node, nil
+}
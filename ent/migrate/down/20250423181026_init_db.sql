@@ -0,0 +1,4 @@
+-- Drop "chesses" table
+DROP TABLE "chesses";
+-- Drop "users" table
+DROP TABLE "users";
@@ -0,0 +1,8 @@
+-- Modify "chesses" table
+ALTER TABLE "chesses" DROP CONSTRAINT "chesses_users_black_id", DROP CONSTRAINT "chesses_users_white_id", DROP COLUMN "result", ALTER COLUMN "status" SET DEFAULT 'in_progress';
+-- Rename a column from "user_white_id" to "user_player_first_id"
+ALTER TABLE "chesses" RENAME COLUMN "user_white_id" TO "user_player_first_id";
+-- Rename a column from "user_black_id" to "user_player_second_id"
+ALTER TABLE "chesses" RENAME COLUMN "user_black_id" TO "user_player_second_id";
+-- Restore foreign keys of players
+ALTER TABLE "chesses" ADD CONSTRAINT "chesses_users_player_first_id" FOREIGN KEY ("user_player_first_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, ADD CONSTRAINT "chesses_users_player_second_id" FOREIGN KEY ("user_player_second_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
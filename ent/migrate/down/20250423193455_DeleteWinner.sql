@@ -0,0 +1,2 @@
+-- Modify "chesses" table
+ALTER TABLE "public"."chesses" ADD COLUMN "user_winner_id" uuid NULL, ADD CONSTRAINT "chesses_users_winner_id" FOREIGN KEY ("user_winner_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Drop "game_histories" table
+DROP TABLE "public"."game_histories";
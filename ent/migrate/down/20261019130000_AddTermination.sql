@@ -0,0 +1,5 @@
+-- Convert results back from PGN notation
+UPDATE "public"."chesses" SET "result" = '1-1' WHERE "result" = '1/2-1/2';
+UPDATE "public"."chesses" SET "result" = '0-0' WHERE "result" = '*';
+-- Modify "chesses" table
+ALTER TABLE "public"."chesses" ALTER COLUMN "result" SET DEFAULT '0-0', DROP COLUMN "termination";
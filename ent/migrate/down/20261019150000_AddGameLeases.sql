@@ -0,0 +1,2 @@
+-- Drop "game_leases" table
+DROP TABLE "public"."game_leases";
@@ -0,0 +1,2 @@
+-- Drop "queue_entries" table
+DROP TABLE "public"."queue_entries";
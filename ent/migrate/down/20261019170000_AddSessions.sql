@@ -0,0 +1,2 @@
+-- Drop "sessions" table
+DROP TABLE "public"."sessions";
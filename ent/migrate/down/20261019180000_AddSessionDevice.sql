@@ -0,0 +1,4 @@
+-- Drop index "session_revoked_at" from table: "sessions"
+DROP INDEX "public"."session_revoked_at";
+-- Modify "sessions" table
+ALTER TABLE "public"."sessions" DROP COLUMN "ip", DROP COLUMN "user_agent";
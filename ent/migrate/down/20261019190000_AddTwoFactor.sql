@@ -0,0 +1,4 @@
+-- Drop "recovery_codes" table
+DROP TABLE "public"."recovery_codes";
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "totp_last_step", DROP COLUMN "totp_enabled", DROP COLUMN "totp_secret";
@@ -0,0 +1,4 @@
+-- Drop "user_tokens" table
+DROP TABLE "public"."user_tokens";
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "email_verified";
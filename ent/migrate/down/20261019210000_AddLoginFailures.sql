@@ -0,0 +1,2 @@
+-- Drop "login_failures" table
+DROP TABLE "public"."login_failures";
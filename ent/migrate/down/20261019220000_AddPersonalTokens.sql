@@ -0,0 +1,2 @@
+-- Drop "personal_tokens" table
+DROP TABLE "public"."personal_tokens";
@@ -0,0 +1,4 @@
+-- Drop "challenges" table
+DROP TABLE "public"."challenges";
+-- Modify "users" table
+ALTER TABLE "public"."users" DROP COLUMN "bot";
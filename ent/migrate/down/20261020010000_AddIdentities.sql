@@ -0,0 +1,2 @@
+-- Drop "identities" table
+DROP TABLE "public"."identities";
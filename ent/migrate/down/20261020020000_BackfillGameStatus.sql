@@ -0,0 +1 @@
+-- Backfilled games cannot be told apart from new ones, nothing to revert
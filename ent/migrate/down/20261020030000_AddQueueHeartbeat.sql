@@ -0,0 +1,2 @@
+-- Modify "queue_entries" table
+ALTER TABLE "public"."queue_entries" DROP COLUMN "heartbeat_at";
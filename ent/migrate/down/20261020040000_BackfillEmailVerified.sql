@@ -0,0 +1 @@
+-- Backfilled accounts cannot be told apart from verified ones, nothing to revert
@@ -0,0 +1,13 @@
+package migrate
+
+import "embed"
+
+// Migrations версионированные миграции PostgreSQL в формате Atlas вместе с atlas.sum.
+//
+//go:embed migrations
+var Migrations embed.FS
+
+// Rollbacks откаты миграций: файл с тем же именем, что и миграция, отменяет её изменения.
+//
+//go:embed down
+var Rollbacks embed.FS
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "auto_queen" boolean NOT NULL DEFAULT true;
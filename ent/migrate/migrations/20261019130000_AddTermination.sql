@@ -0,0 +1,7 @@
+-- Modify "chesses" table
+ALTER TABLE "public"."chesses" ALTER COLUMN "result" SET DEFAULT '*', ADD COLUMN "termination" character varying NULL;
+-- Convert results to PGN notation
+UPDATE "public"."chesses" SET "result" = '1/2-1/2' WHERE "result" = '1-1';
+UPDATE "public"."chesses" SET "result" = '*' WHERE "result" = '0-0';
+-- Backfill termination of aborted games
+UPDATE "public"."chesses" SET "termination" = 'aborted' WHERE "status" = 'aborted';
@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "abort_count" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Create "game_leases" table
+CREATE TABLE "public"."game_leases" ("id" uuid NOT NULL, "node_id" character varying NOT NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
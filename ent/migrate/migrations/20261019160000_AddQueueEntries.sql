@@ -0,0 +1,4 @@
+-- Create "queue_entries" table
+CREATE TABLE "public"."queue_entries" ("id" uuid NOT NULL, "node_id" character varying NOT NULL, "abort_count" bigint NOT NULL DEFAULT 0, "joined_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "queueentry_abort_count_joined_at" to table: "queue_entries"
+CREATE INDEX "queueentry_abort_count_joined_at" ON "public"."queue_entries" ("abort_count", "joined_at");
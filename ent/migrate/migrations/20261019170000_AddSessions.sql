@@ -0,0 +1,4 @@
+-- Create "sessions" table
+CREATE TABLE "public"."sessions" ("id" uuid NOT NULL, "refresh_jti" uuid NOT NULL, "created_at" timestamptz NOT NULL, "last_used_at" timestamptz NOT NULL, "expires_at" timestamptz NOT NULL, "revoked_at" timestamptz NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "sessions_users_sessions" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "session_user_id" to table: "sessions"
+CREATE INDEX "session_user_id" ON "public"."sessions" ("user_id");
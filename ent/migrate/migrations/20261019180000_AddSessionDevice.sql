@@ -0,0 +1,4 @@
+-- Modify "sessions" table
+ALTER TABLE "public"."sessions" ADD COLUMN "user_agent" character varying(512) NOT NULL DEFAULT '', ADD COLUMN "ip" character varying(64) NOT NULL DEFAULT '';
+-- Create index "session_revoked_at" to table: "sessions"
+CREATE INDEX "session_revoked_at" ON "public"."sessions" ("revoked_at");
@@ -0,0 +1,6 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "totp_secret" character varying(64) NULL, ADD COLUMN "totp_enabled" boolean NOT NULL DEFAULT false, ADD COLUMN "totp_last_step" bigint NOT NULL DEFAULT 0;
+-- Create "recovery_codes" table
+CREATE TABLE "public"."recovery_codes" ("id" uuid NOT NULL, "code_hash" character varying(64) NOT NULL, "used_at" timestamptz NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "recovery_codes_users_recovery_codes" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "recoverycode_user_id" to table: "recovery_codes"
+CREATE INDEX "recoverycode_user_id" ON "public"."recovery_codes" ("user_id");
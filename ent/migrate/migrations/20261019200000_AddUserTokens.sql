@@ -0,0 +1,8 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "email_verified" boolean NOT NULL DEFAULT false;
+-- Create "user_tokens" table
+CREATE TABLE "public"."user_tokens" ("id" uuid NOT NULL, "purpose" character varying NOT NULL, "token_hash" character varying(64) NOT NULL, "created_at" timestamptz NOT NULL, "expires_at" timestamptz NOT NULL, "used_at" timestamptz NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "user_tokens_users_tokens" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "user_tokens_token_hash_key" to table: "user_tokens"
+CREATE UNIQUE INDEX "user_tokens_token_hash_key" ON "public"."user_tokens" ("token_hash");
+-- Create index "usertoken_user_id_purpose" to table: "user_tokens"
+CREATE INDEX "usertoken_user_id_purpose" ON "public"."user_tokens" ("user_id", "purpose");
@@ -0,0 +1,4 @@
+-- Create "login_failures" table
+CREATE TABLE "public"."login_failures" ("id" character varying(320) NOT NULL, "count" bigint NOT NULL DEFAULT 0, "last_failed_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "loginfailure_last_failed_at" to table: "login_failures"
+CREATE INDEX "loginfailure_last_failed_at" ON "public"."login_failures" ("last_failed_at");
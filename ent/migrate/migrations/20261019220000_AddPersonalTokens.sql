@@ -0,0 +1,6 @@
+-- Create "personal_tokens" table
+CREATE TABLE "public"."personal_tokens" ("id" uuid NOT NULL, "name" character varying(100) NOT NULL, "token_hash" character varying(64) NOT NULL, "scopes" jsonb NOT NULL, "created_at" timestamptz NOT NULL, "last_used_at" timestamptz NULL, "expires_at" timestamptz NULL, "revoked_at" timestamptz NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "personal_tokens_users_personal_tokens" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "personal_tokens_token_hash_key" to table: "personal_tokens"
+CREATE UNIQUE INDEX "personal_tokens_token_hash_key" ON "public"."personal_tokens" ("token_hash");
+-- Create index "personaltoken_user_id" to table: "personal_tokens"
+CREATE INDEX "personaltoken_user_id" ON "public"."personal_tokens" ("user_id");
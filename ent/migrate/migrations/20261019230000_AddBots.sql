@@ -0,0 +1,8 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ADD COLUMN "bot" boolean NOT NULL DEFAULT false;
+-- Create "challenges" table
+CREATE TABLE "public"."challenges" ("id" uuid NOT NULL, "color" character varying NOT NULL DEFAULT 'random', "status" character varying NOT NULL DEFAULT 'created', "game_id" uuid NULL, "created_at" timestamptz NOT NULL, "challenger_id" uuid NOT NULL, "dest_user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "challenges_users_challenges_received" FOREIGN KEY ("dest_user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "challenges_users_challenges_sent" FOREIGN KEY ("challenger_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "challenge_challenger_id_status" to table: "challenges"
+CREATE INDEX "challenge_challenger_id_status" ON "public"."challenges" ("challenger_id", "status");
+-- Create index "challenge_dest_user_id_status" to table: "challenges"
+CREATE INDEX "challenge_dest_user_id_status" ON "public"."challenges" ("dest_user_id", "status");
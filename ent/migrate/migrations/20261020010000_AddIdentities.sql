@@ -0,0 +1,6 @@
+-- Create "identities" table
+CREATE TABLE "public"."identities" ("id" uuid NOT NULL, "provider" character varying(50) NOT NULL, "subject" character varying(255) NOT NULL, "email" character varying(100) NOT NULL, "created_at" timestamptz NOT NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "identities_users_identities" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "identity_provider_subject" to table: "identities"
+CREATE UNIQUE INDEX "identity_provider_subject" ON "public"."identities" ("provider", "subject");
+-- Create index "identity_user_id" to table: "identities"
+CREATE INDEX "identity_user_id" ON "public"."identities" ("user_id");
@@ -0,0 +1,2 @@
+-- Mark unfinished games created with the old default status as in progress
+UPDATE "public"."chesses" SET "status" = 'in_progress' WHERE "status" = 'waiting' AND "result" = '*';
@@ -0,0 +1,3 @@
+-- Modify "queue_entries" table
+ALTER TABLE "public"."queue_entries" ADD COLUMN "heartbeat_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "public"."queue_entries" ALTER COLUMN "heartbeat_at" DROP DEFAULT;
@@ -0,0 +1,2 @@
+-- Accounts registered before email verification existed keep access to search as verified
+UPDATE "public"."users" SET "email_verified" = true WHERE "email_verified" = false AND "created_at" < (SELECT "executed_at" FROM "public"."atlas_schema_revisions" WHERE "version" = '20261019200000');
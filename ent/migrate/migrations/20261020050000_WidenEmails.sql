@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "public"."users" ALTER COLUMN "email" TYPE character varying(254);
+-- Modify "identities" table
+ALTER TABLE "public"."identities" ALTER COLUMN "email" TYPE character varying(254);
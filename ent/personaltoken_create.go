@@ -0,0 +1,999 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/personaltoken"
+	"GopherChessParty/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PersonalTokenCreate is the builder for creating a PersonalToken entity.
+type PersonalTokenCreate struct {
+	config
+	mutation *PersonalTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (ptc *PersonalTokenCreate) SetUserID(u uuid.UUID) *PersonalTokenCreate {
+	ptc.mutation.SetUserID(u)
+	return ptc
+}
+
+// SetName sets the "name" field.
+func (ptc *PersonalTokenCreate) SetName(s string) *PersonalTokenCreate {
+	ptc.mutation.SetName(s)
+	return ptc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (ptc *PersonalTokenCreate) SetTokenHash(s string) *PersonalTokenCreate {
+	ptc.mutation.SetTokenHash(s)
+	return ptc
+}
+
+// SetScopes sets the "scopes" field.
+func (ptc *PersonalTokenCreate) SetScopes(s []string) *PersonalTokenCreate {
+	ptc.mutation.SetScopes(s)
+	return ptc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptc *PersonalTokenCreate) SetCreatedAt(t time.Time) *PersonalTokenCreate {
+	ptc.mutation.SetCreatedAt(t)
+	return ptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptc *PersonalTokenCreate) SetNillableCreatedAt(t *time.Time) *PersonalTokenCreate {
+	if t != nil {
+		ptc.SetCreatedAt(*t)
+	}
+	return ptc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (ptc *PersonalTokenCreate) SetLastUsedAt(t time.Time) *PersonalTokenCreate {
+	ptc.mutation.SetLastUsedAt(t)
+	return ptc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (ptc *PersonalTokenCreate) SetNillableLastUsedAt(t *time.Time) *PersonalTokenCreate {
+	if t != nil {
+		ptc.SetLastUsedAt(*t)
+	}
+	return ptc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ptc *PersonalTokenCreate) SetExpiresAt(t time.Time) *PersonalTokenCreate {
+	ptc.mutation.SetExpiresAt(t)
+	return ptc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ptc *PersonalTokenCreate) SetNillableExpiresAt(t *time.Time) *PersonalTokenCreate {
+	if t != nil {
+		ptc.SetExpiresAt(*t)
+	}
+	return ptc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (ptc *PersonalTokenCreate) SetRevokedAt(t time.Time) *PersonalTokenCreate {
+	ptc.mutation.SetRevokedAt(t)
+	return ptc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (ptc *PersonalTokenCreate) SetNillableRevokedAt(t *time.Time) *PersonalTokenCreate {
+	if t != nil {
+		ptc.SetRevokedAt(*t)
+	}
+	return ptc
+}
+
+// SetID sets the "id" field.
+func (ptc *PersonalTokenCreate) SetID(u uuid.UUID) *PersonalTokenCreate {
+	ptc.mutation.SetID(u)
+	return ptc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ptc *PersonalTokenCreate) SetNillableID(u *uuid.UUID) *PersonalTokenCreate {
+	if u != nil {
+		ptc.SetID(*u)
+	}
+	return ptc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ptc *PersonalTokenCreate) SetUser(u *User) *PersonalTokenCreate {
+	return ptc.SetUserID(u.ID)
+}
+
+// Mutation returns the PersonalTokenMutation object of the builder.
+func (ptc *PersonalTokenCreate) Mutation() *PersonalTokenMutation {
+	return ptc.mutation
+}
+
+// Save creates the PersonalToken in the database.
+func (ptc *PersonalTokenCreate) Save(ctx context.Context) (*PersonalToken, error) {
+	ptc.defaults()
+	return withHooks(ctx, ptc.sqlSave, ptc.mutation, ptc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ptc *PersonalTokenCreate) SaveX(ctx context.Context) *PersonalToken {
+	v, err := ptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptc *PersonalTokenCreate) Exec(ctx context.Context) error {
+	_, err := ptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptc *PersonalTokenCreate) ExecX(ctx context.Context) {
+	if err := ptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptc *PersonalTokenCreate) defaults() {
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		v := personaltoken.DefaultCreatedAt()
+		ptc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ptc.mutation.ID(); !ok {
+		v := personaltoken.DefaultID()
+		ptc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptc *PersonalTokenCreate) check() error {
+	if _, ok := ptc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PersonalToken.user_id"`)}
+	}
+	if _, ok := ptc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PersonalToken.name"`)}
+	}
+	if v, ok := ptc.mutation.Name(); ok {
+		if err := personaltoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalToken.name": %w`, err)}
+		}
+	}
+	if _, ok := ptc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "PersonalToken.token_hash"`)}
+	}
+	if v, ok := ptc.mutation.TokenHash(); ok {
+		if err := personaltoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := ptc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "PersonalToken.scopes"`)}
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PersonalToken.created_at"`)}
+	}
+	if len(ptc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PersonalToken.user"`)}
+	}
+	return nil
+}
+
+func (ptc *PersonalTokenCreate) sqlSave(ctx context.Context) (*PersonalToken, error) {
+	if err := ptc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ptc.mutation.id = &_node.ID
+	ptc.mutation.done = true
+	return _node, nil
+}
+
+func (ptc *PersonalTokenCreate) createSpec() (*PersonalToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PersonalToken{config: ptc.config}
+		_spec = sqlgraph.NewCreateSpec(personaltoken.Table, sqlgraph.NewFieldSpec(personaltoken.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ptc.conflict
+	if id, ok := ptc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ptc.mutation.Name(); ok {
+		_spec.SetField(personaltoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ptc.mutation.TokenHash(); ok {
+		_spec.SetField(personaltoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := ptc.mutation.Scopes(); ok {
+		_spec.SetField(personaltoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := ptc.mutation.CreatedAt(); ok {
+		_spec.SetField(personaltoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ptc.mutation.LastUsedAt(); ok {
+		_spec.SetField(personaltoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := ptc.mutation.ExpiresAt(); ok {
+		_spec.SetField(personaltoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := ptc.mutation.RevokedAt(); ok {
+		_spec.SetField(personaltoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := ptc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personaltoken.UserTable,
+			Columns: []string{personaltoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PersonalToken.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonalTokenUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ptc *PersonalTokenCreate) OnConflict(opts ...sql.ConflictOption) *PersonalTokenUpsertOne {
+	ptc.conflict = opts
+	return &PersonalTokenUpsertOne{
+		create: ptc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PersonalToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptc *PersonalTokenCreate) OnConflictColumns(columns ...string) *PersonalTokenUpsertOne {
+	ptc.conflict = append(ptc.conflict, sql.ConflictColumns(columns...))
+	return &PersonalTokenUpsertOne{
+		create: ptc,
+	}
+}
+
+type (
+	// PersonalTokenUpsertOne is the builder for "upsert"-ing
+	//  one PersonalToken node.
+	PersonalTokenUpsertOne struct {
+		create *PersonalTokenCreate
+	}
+
+	// PersonalTokenUpsert is the "OnConflict" setter.
+	PersonalTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *PersonalTokenUpsert) SetUserID(v uuid.UUID) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateUserID() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PersonalTokenUpsert) SetName(v string) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateName() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldName)
+	return u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *PersonalTokenUpsert) SetTokenHash(v string) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldTokenHash, v)
+	return u
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateTokenHash() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldTokenHash)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *PersonalTokenUpsert) SetScopes(v []string) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateScopes() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldScopes)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonalTokenUpsert) SetCreatedAt(v time.Time) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateCreatedAt() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldCreatedAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PersonalTokenUpsert) SetLastUsedAt(v time.Time) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateLastUsedAt() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PersonalTokenUpsert) ClearLastUsedAt() *PersonalTokenUpsert {
+	u.SetNull(personaltoken.FieldLastUsedAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PersonalTokenUpsert) SetExpiresAt(v time.Time) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateExpiresAt() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PersonalTokenUpsert) ClearExpiresAt() *PersonalTokenUpsert {
+	u.SetNull(personaltoken.FieldExpiresAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PersonalTokenUpsert) SetRevokedAt(v time.Time) *PersonalTokenUpsert {
+	u.Set(personaltoken.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsert) UpdateRevokedAt() *PersonalTokenUpsert {
+	u.SetExcluded(personaltoken.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PersonalTokenUpsert) ClearRevokedAt() *PersonalTokenUpsert {
+	u.SetNull(personaltoken.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PersonalToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(personaltoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonalTokenUpsertOne) UpdateNewValues() *PersonalTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(personaltoken.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PersonalToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PersonalTokenUpsertOne) Ignore() *PersonalTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonalTokenUpsertOne) DoNothing() *PersonalTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonalTokenCreate.OnConflict
+// documentation for more info.
+func (u *PersonalTokenUpsertOne) Update(set func(*PersonalTokenUpsert)) *PersonalTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonalTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PersonalTokenUpsertOne) SetUserID(v uuid.UUID) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateUserID() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PersonalTokenUpsertOne) SetName(v string) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateName() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *PersonalTokenUpsertOne) SetTokenHash(v string) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateTokenHash() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *PersonalTokenUpsertOne) SetScopes(v []string) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateScopes() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonalTokenUpsertOne) SetCreatedAt(v time.Time) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateCreatedAt() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PersonalTokenUpsertOne) SetLastUsedAt(v time.Time) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateLastUsedAt() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PersonalTokenUpsertOne) ClearLastUsedAt() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PersonalTokenUpsertOne) SetExpiresAt(v time.Time) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateExpiresAt() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PersonalTokenUpsertOne) ClearExpiresAt() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PersonalTokenUpsertOne) SetRevokedAt(v time.Time) *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertOne) UpdateRevokedAt() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PersonalTokenUpsertOne) ClearRevokedAt() *PersonalTokenUpsertOne {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonalTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonalTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonalTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PersonalTokenUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PersonalTokenUpsertOne.ID is not supported by MySQL driver. Use PersonalTokenUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PersonalTokenUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PersonalTokenCreateBulk is the builder for creating many PersonalToken entities in bulk.
+type PersonalTokenCreateBulk struct {
+	config
+	err      error
+	builders []*PersonalTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PersonalToken entities in the database.
+func (ptcb *PersonalTokenCreateBulk) Save(ctx context.Context) ([]*PersonalToken, error) {
+	if ptcb.err != nil {
+		return nil, ptcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ptcb.builders))
+	nodes := make([]*PersonalToken, len(ptcb.builders))
+	mutators := make([]Mutator, len(ptcb.builders))
+	for i := range ptcb.builders {
+		func(i int, root context.Context) {
+			builder := ptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersonalTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ptcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptcb *PersonalTokenCreateBulk) SaveX(ctx context.Context) []*PersonalToken {
+	v, err := ptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptcb *PersonalTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := ptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptcb *PersonalTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := ptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PersonalToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonalTokenUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ptcb *PersonalTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *PersonalTokenUpsertBulk {
+	ptcb.conflict = opts
+	return &PersonalTokenUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PersonalToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptcb *PersonalTokenCreateBulk) OnConflictColumns(columns ...string) *PersonalTokenUpsertBulk {
+	ptcb.conflict = append(ptcb.conflict, sql.ConflictColumns(columns...))
+	return &PersonalTokenUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// PersonalTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of PersonalToken nodes.
+type PersonalTokenUpsertBulk struct {
+	create *PersonalTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PersonalToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(personaltoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonalTokenUpsertBulk) UpdateNewValues() *PersonalTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(personaltoken.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PersonalToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PersonalTokenUpsertBulk) Ignore() *PersonalTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonalTokenUpsertBulk) DoNothing() *PersonalTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonalTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *PersonalTokenUpsertBulk) Update(set func(*PersonalTokenUpsert)) *PersonalTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonalTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PersonalTokenUpsertBulk) SetUserID(v uuid.UUID) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateUserID() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PersonalTokenUpsertBulk) SetName(v string) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateName() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *PersonalTokenUpsertBulk) SetTokenHash(v string) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateTokenHash() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *PersonalTokenUpsertBulk) SetScopes(v []string) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateScopes() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonalTokenUpsertBulk) SetCreatedAt(v time.Time) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateCreatedAt() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PersonalTokenUpsertBulk) SetLastUsedAt(v time.Time) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateLastUsedAt() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PersonalTokenUpsertBulk) ClearLastUsedAt() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PersonalTokenUpsertBulk) SetExpiresAt(v time.Time) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateExpiresAt() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PersonalTokenUpsertBulk) ClearExpiresAt() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PersonalTokenUpsertBulk) SetRevokedAt(v time.Time) *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PersonalTokenUpsertBulk) UpdateRevokedAt() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PersonalTokenUpsertBulk) ClearRevokedAt() *PersonalTokenUpsertBulk {
+	return u.Update(func(s *PersonalTokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonalTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PersonalTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonalTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonalTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
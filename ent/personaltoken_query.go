@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"GopherChessParty/ent/personaltoken"
+	"GopherChessParty/ent/predicate"
+	"GopherChessParty/ent/user"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PersonalTokenQuery is the builder for querying PersonalToken entities.
+type PersonalTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []personaltoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PersonalToken
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PersonalTokenQuery builder.
+func (ptq *PersonalTokenQuery) Where(ps ...predicate.PersonalToken) *PersonalTokenQuery {
+	ptq.predicates = append(ptq.predicates, ps...)
+	return ptq
+}
+
+// Limit the number of records to be returned by this query.
+func (ptq *PersonalTokenQuery) Limit(limit int) *PersonalTokenQuery {
+	ptq.ctx.Limit = &limit
+	return ptq
+}
+
+// Offset to start from.
+func (ptq *PersonalTokenQuery) Offset(offset int) *PersonalTokenQuery {
+	ptq.ctx.Offset = &offset
+	return ptq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ptq *PersonalTokenQuery) Unique(unique bool) *PersonalTokenQuery {
+	ptq.ctx.Unique = &unique
+	return ptq
+}
+
+// Order specifies how the records should be ordered.
+func (ptq *PersonalTokenQuery) Order(o ...personaltoken.OrderOption) *PersonalTokenQuery {
+	ptq.order = append(ptq.order, o...)
+	return ptq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ptq *PersonalTokenQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ptq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ptq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ptq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(personaltoken.Table, personaltoken.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, personaltoken.UserTable, personaltoken.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ptq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PersonalToken entity from the query.
+// Returns a *NotFoundError when no PersonalToken was found.
+func (ptq *PersonalTokenQuery) First(ctx context.Context) (*PersonalToken, error) {
+	nodes, err := ptq.Limit(1).All(setContextOp(ctx, ptq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{personaltoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) FirstX(ctx context.Context) *PersonalToken {
+	node, err := ptq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PersonalToken ID from the query.
+// Returns a *NotFoundError when no PersonalToken ID was found.
+func (ptq *PersonalTokenQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ptq.Limit(1).IDs(setContextOp(ctx, ptq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{personaltoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ptq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PersonalToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PersonalToken entity is found.
+// Returns a *NotFoundError when no PersonalToken entities are found.
+func (ptq *PersonalTokenQuery) Only(ctx context.Context) (*PersonalToken, error) {
+	nodes, err := ptq.Limit(2).All(setContextOp(ctx, ptq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{personaltoken.Label}
+	default:
+		return nil, &NotSingularError{personaltoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) OnlyX(ctx context.Context) *PersonalToken {
+	node, err := ptq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PersonalToken ID in the query.
+// Returns a *NotSingularError when more than one PersonalToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ptq *PersonalTokenQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ptq.Limit(2).IDs(setContextOp(ctx, ptq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{personaltoken.Label}
+	default:
+		err = &NotSingularError{personaltoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ptq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PersonalTokens.
+func (ptq *PersonalTokenQuery) All(ctx context.Context) ([]*PersonalToken, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryAll)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PersonalToken, *PersonalTokenQuery]()
+	return withInterceptors[[]*PersonalToken](ctx, ptq, qr, ptq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) AllX(ctx context.Context) []*PersonalToken {
+	nodes, err := ptq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PersonalToken IDs.
+func (ptq *PersonalTokenQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ptq.ctx.Unique == nil && ptq.path != nil {
+		ptq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryIDs)
+	if err = ptq.Select(personaltoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ptq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ptq *PersonalTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryCount)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ptq, querierCount[*PersonalTokenQuery](), ptq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) CountX(ctx context.Context) int {
+	count, err := ptq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ptq *PersonalTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryExist)
+	switch _, err := ptq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ptq *PersonalTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := ptq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PersonalTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ptq *PersonalTokenQuery) Clone() *PersonalTokenQuery {
+	if ptq == nil {
+		return nil
+	}
+	return &PersonalTokenQuery{
+		config:     ptq.config,
+		ctx:        ptq.ctx.Clone(),
+		order:      append([]personaltoken.OrderOption{}, ptq.order...),
+		inters:     append([]Interceptor{}, ptq.inters...),
+		predicates: append([]predicate.PersonalToken{}, ptq.predicates...),
+		withUser:   ptq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  ptq.sql.Clone(),
+		path: ptq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ptq *PersonalTokenQuery) WithUser(opts ...func(*UserQuery)) *PersonalTokenQuery {
+	query := (&UserClient{config: ptq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ptq.withUser = query
+	return ptq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PersonalToken.Query().
+//		GroupBy(personaltoken.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ptq *PersonalTokenQuery) GroupBy(field string, fields ...string) *PersonalTokenGroupBy {
+	ptq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PersonalTokenGroupBy{build: ptq}
+	grbuild.flds = &ptq.ctx.Fields
+	grbuild.label = personaltoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.PersonalToken.Query().
+//		Select(personaltoken.FieldUserID).
+//		Scan(ctx, &v)
+func (ptq *PersonalTokenQuery) Select(fields ...string) *PersonalTokenSelect {
+	ptq.ctx.Fields = append(ptq.ctx.Fields, fields...)
+	sbuild := &PersonalTokenSelect{PersonalTokenQuery: ptq}
+	sbuild.label = personaltoken.Label
+	sbuild.flds, sbuild.scan = &ptq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PersonalTokenSelect configured with the given aggregations.
+func (ptq *PersonalTokenQuery) Aggregate(fns ...AggregateFunc) *PersonalTokenSelect {
+	return ptq.Select().Aggregate(fns...)
+}
+
+func (ptq *PersonalTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ptq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ptq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ptq.ctx.Fields {
+		if !personaltoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ptq.path != nil {
+		prev, err := ptq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ptq.sql = prev
+	}
+	return nil
+}
+
+func (ptq *PersonalTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PersonalToken, error) {
+	var (
+		nodes       = []*PersonalToken{}
+		_spec       = ptq.querySpec()
+		loadedTypes = [1]bool{
+			ptq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PersonalToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PersonalToken{config: ptq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ptq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ptq.withUser; query != nil {
+		if err := ptq.loadUser(ctx, query, nodes, nil,
+			func(n *PersonalToken, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ptq *PersonalTokenQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*PersonalToken, init func(*PersonalToken), assign func(*PersonalToken, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PersonalToken)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ptq *PersonalTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ptq.querySpec()
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
+	_spec.Node.Columns = ptq.ctx.Fields
+	if len(ptq.ctx.Fields) > 0 {
+		_spec.Unique = ptq.ctx.Unique != nil && *ptq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ptq.driver, _spec)
+}
+
+func (ptq *PersonalTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(personaltoken.Table, personaltoken.Columns, sqlgraph.NewFieldSpec(personaltoken.FieldID, field.TypeUUID))
+	_spec.From = ptq.sql
+	if unique := ptq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ptq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ptq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, personaltoken.FieldID)
+		for i := range fields {
+			if fields[i] != personaltoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ptq.withUser != nil {
+			_spec.Node.AddColumnOnce(personaltoken.FieldUserID)
+		}
+	}
+	if ps := ptq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ptq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ptq *PersonalTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ptq.driver.Dialect())
+	t1 := builder.Table(personaltoken.Table)
+	columns := ptq.ctx.Fields
+	if len(columns) == 0 {
+		columns = personaltoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ptq.sql != nil {
+		selector = ptq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ptq.modifiers {
+		m(selector)
+	}
+	for _, p := range ptq.predicates {
+		p(selector)
+	}
+	for _, p := range ptq.order {
+		p(selector)
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ptq *PersonalTokenQuery) ForUpdate(opts ...sql.LockOption) *PersonalTokenQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ptq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ptq *PersonalTokenQuery) ForShare(opts ...sql.LockOption) *PersonalTokenQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ptq
+}
+
+// PersonalTokenGroupBy is the group-by builder for PersonalToken entities.
+type PersonalTokenGroupBy struct {
+	selector
+	build *PersonalTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ptgb *PersonalTokenGroupBy) Aggregate(fns ...AggregateFunc) *PersonalTokenGroupBy {
+	ptgb.fns = append(ptgb.fns, fns...)
+	return ptgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ptgb *PersonalTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ptgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ptgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PersonalTokenQuery, *PersonalTokenGroupBy](ctx, ptgb.build, ptgb, ptgb.build.inters, v)
+}
+
+func (ptgb *PersonalTokenGroupBy) sqlScan(ctx context.Context, root *PersonalTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ptgb.fns))
+	for _, fn := range ptgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ptgb.flds)+len(ptgb.fns))
+		for _, f := range *ptgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ptgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ptgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PersonalTokenSelect is the builder for selecting fields of PersonalToken entities.
+type PersonalTokenSelect struct {
+	*PersonalTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pts *PersonalTokenSelect) Aggregate(fns ...AggregateFunc) *PersonalTokenSelect {
+	pts.fns = append(pts.fns, fns...)
+	return pts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pts *PersonalTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pts.ctx, ent.OpQuerySelect)
+	if err := pts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PersonalTokenQuery, *PersonalTokenSelect](ctx, pts.PersonalTokenQuery, pts, pts.inters, v)
+}
+
+func (pts *PersonalTokenSelect) sqlScan(ctx context.Context, root *PersonalTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pts.fns))
+	for _, fn := range pts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/personaltoken"
+	"GopherChessParty/ent/predicate"
+	"GopherChessParty/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PersonalTokenUpdate is the builder for updating PersonalToken entities.
+type PersonalTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PersonalTokenMutation
+}
+
+// Where appends a list predicates to the PersonalTokenUpdate builder.
+func (ptu *PersonalTokenUpdate) Where(ps ...predicate.PersonalToken) *PersonalTokenUpdate {
+	ptu.mutation.Where(ps...)
+	return ptu
+}
+
+// SetUserID sets the "user_id" field.
+func (ptu *PersonalTokenUpdate) SetUserID(u uuid.UUID) *PersonalTokenUpdate {
+	ptu.mutation.SetUserID(u)
+	return ptu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ptu *PersonalTokenUpdate) SetNillableUserID(u *uuid.UUID) *PersonalTokenUpdate {
+	if u != nil {
+		ptu.SetUserID(*u)
+	}
+	return ptu
+}
+
+// SetName sets the "name" field.
+func (ptu *PersonalTokenUpdate) SetName(s string) *PersonalTokenUpdate {
+	ptu.mutation.SetName(s)
+	return ptu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ptu *PersonalTokenUpdate) SetNillableName(s *string) *PersonalTokenUpdate {
+	if s != nil {
+		ptu.SetName(*s)
+	}
+	return ptu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (ptu *PersonalTokenUpdate) SetTokenHash(s string) *PersonalTokenUpdate {
+	ptu.mutation.SetTokenHash(s)
+	return ptu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (ptu *PersonalTokenUpdate) SetNillableTokenHash(s *string) *PersonalTokenUpdate {
+	if s != nil {
+		ptu.SetTokenHash(*s)
+	}
+	return ptu
+}
+
+// SetScopes sets the "scopes" field.
+func (ptu *PersonalTokenUpdate) SetScopes(s []string) *PersonalTokenUpdate {
+	ptu.mutation.SetScopes(s)
+	return ptu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ptu *PersonalTokenUpdate) AppendScopes(s []string) *PersonalTokenUpdate {
+	ptu.mutation.AppendScopes(s)
+	return ptu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptu *PersonalTokenUpdate) SetCreatedAt(t time.Time) *PersonalTokenUpdate {
+	ptu.mutation.SetCreatedAt(t)
+	return ptu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptu *PersonalTokenUpdate) SetNillableCreatedAt(t *time.Time) *PersonalTokenUpdate {
+	if t != nil {
+		ptu.SetCreatedAt(*t)
+	}
+	return ptu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (ptu *PersonalTokenUpdate) SetLastUsedAt(t time.Time) *PersonalTokenUpdate {
+	ptu.mutation.SetLastUsedAt(t)
+	return ptu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (ptu *PersonalTokenUpdate) SetNillableLastUsedAt(t *time.Time) *PersonalTokenUpdate {
+	if t != nil {
+		ptu.SetLastUsedAt(*t)
+	}
+	return ptu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (ptu *PersonalTokenUpdate) ClearLastUsedAt() *PersonalTokenUpdate {
+	ptu.mutation.ClearLastUsedAt()
+	return ptu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ptu *PersonalTokenUpdate) SetExpiresAt(t time.Time) *PersonalTokenUpdate {
+	ptu.mutation.SetExpiresAt(t)
+	return ptu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ptu *PersonalTokenUpdate) SetNillableExpiresAt(t *time.Time) *PersonalTokenUpdate {
+	if t != nil {
+		ptu.SetExpiresAt(*t)
+	}
+	return ptu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (ptu *PersonalTokenUpdate) ClearExpiresAt() *PersonalTokenUpdate {
+	ptu.mutation.ClearExpiresAt()
+	return ptu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (ptu *PersonalTokenUpdate) SetRevokedAt(t time.Time) *PersonalTokenUpdate {
+	ptu.mutation.SetRevokedAt(t)
+	return ptu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (ptu *PersonalTokenUpdate) SetNillableRevokedAt(t *time.Time) *PersonalTokenUpdate {
+	if t != nil {
+		ptu.SetRevokedAt(*t)
+	}
+	return ptu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (ptu *PersonalTokenUpdate) ClearRevokedAt() *PersonalTokenUpdate {
+	ptu.mutation.ClearRevokedAt()
+	return ptu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ptu *PersonalTokenUpdate) SetUser(u *User) *PersonalTokenUpdate {
+	return ptu.SetUserID(u.ID)
+}
+
+// Mutation returns the PersonalTokenMutation object of the builder.
+func (ptu *PersonalTokenUpdate) Mutation() *PersonalTokenMutation {
+	return ptu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ptu *PersonalTokenUpdate) ClearUser() *PersonalTokenUpdate {
+	ptu.mutation.ClearUser()
+	return ptu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ptu *PersonalTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptu *PersonalTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := ptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ptu *PersonalTokenUpdate) Exec(ctx context.Context) error {
+	_, err := ptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptu *PersonalTokenUpdate) ExecX(ctx context.Context) {
+	if err := ptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptu *PersonalTokenUpdate) check() error {
+	if v, ok := ptu.mutation.Name(); ok {
+		if err := personaltoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalToken.name": %w`, err)}
+		}
+	}
+	if v, ok := ptu.mutation.TokenHash(); ok {
+		if err := personaltoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalToken.token_hash": %w`, err)}
+		}
+	}
+	if ptu.mutation.UserCleared() && len(ptu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PersonalToken.user"`)
+	}
+	return nil
+}
+
+func (ptu *PersonalTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ptu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personaltoken.Table, personaltoken.Columns, sqlgraph.NewFieldSpec(personaltoken.FieldID, field.TypeUUID))
+	if ps := ptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptu.mutation.Name(); ok {
+		_spec.SetField(personaltoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := ptu.mutation.TokenHash(); ok {
+		_spec.SetField(personaltoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := ptu.mutation.Scopes(); ok {
+		_spec.SetField(personaltoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ptu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personaltoken.FieldScopes, value)
+		})
+	}
+	if value, ok := ptu.mutation.CreatedAt(); ok {
+		_spec.SetField(personaltoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ptu.mutation.LastUsedAt(); ok {
+		_spec.SetField(personaltoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if ptu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personaltoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := ptu.mutation.ExpiresAt(); ok {
+		_spec.SetField(personaltoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if ptu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(personaltoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := ptu.mutation.RevokedAt(); ok {
+		_spec.SetField(personaltoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if ptu.mutation.RevokedAtCleared() {
+		_spec.ClearField(personaltoken.FieldRevokedAt, field.TypeTime)
+	}
+	if ptu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personaltoken.UserTable,
+			Columns: []string{personaltoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personaltoken.UserTable,
+			Columns: []string{personaltoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personaltoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ptu.mutation.done = true
+	return n, nil
+}
+
+// PersonalTokenUpdateOne is the builder for updating a single PersonalToken entity.
+type PersonalTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PersonalTokenMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (ptuo *PersonalTokenUpdateOne) SetUserID(u uuid.UUID) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetUserID(u)
+	return ptuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ptuo *PersonalTokenUpdateOne) SetNillableUserID(u *uuid.UUID) *PersonalTokenUpdateOne {
+	if u != nil {
+		ptuo.SetUserID(*u)
+	}
+	return ptuo
+}
+
+// SetName sets the "name" field.
+func (ptuo *PersonalTokenUpdateOne) SetName(s string) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetName(s)
+	return ptuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ptuo *PersonalTokenUpdateOne) SetNillableName(s *string) *PersonalTokenUpdateOne {
+	if s != nil {
+		ptuo.SetName(*s)
+	}
+	return ptuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (ptuo *PersonalTokenUpdateOne) SetTokenHash(s string) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetTokenHash(s)
+	return ptuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (ptuo *PersonalTokenUpdateOne) SetNillableTokenHash(s *string) *PersonalTokenUpdateOne {
+	if s != nil {
+		ptuo.SetTokenHash(*s)
+	}
+	return ptuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ptuo *PersonalTokenUpdateOne) SetScopes(s []string) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetScopes(s)
+	return ptuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ptuo *PersonalTokenUpdateOne) AppendScopes(s []string) *PersonalTokenUpdateOne {
+	ptuo.mutation.AppendScopes(s)
+	return ptuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptuo *PersonalTokenUpdateOne) SetCreatedAt(t time.Time) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetCreatedAt(t)
+	return ptuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptuo *PersonalTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *PersonalTokenUpdateOne {
+	if t != nil {
+		ptuo.SetCreatedAt(*t)
+	}
+	return ptuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (ptuo *PersonalTokenUpdateOne) SetLastUsedAt(t time.Time) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetLastUsedAt(t)
+	return ptuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (ptuo *PersonalTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *PersonalTokenUpdateOne {
+	if t != nil {
+		ptuo.SetLastUsedAt(*t)
+	}
+	return ptuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (ptuo *PersonalTokenUpdateOne) ClearLastUsedAt() *PersonalTokenUpdateOne {
+	ptuo.mutation.ClearLastUsedAt()
+	return ptuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ptuo *PersonalTokenUpdateOne) SetExpiresAt(t time.Time) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetExpiresAt(t)
+	return ptuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ptuo *PersonalTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *PersonalTokenUpdateOne {
+	if t != nil {
+		ptuo.SetExpiresAt(*t)
+	}
+	return ptuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (ptuo *PersonalTokenUpdateOne) ClearExpiresAt() *PersonalTokenUpdateOne {
+	ptuo.mutation.ClearExpiresAt()
+	return ptuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (ptuo *PersonalTokenUpdateOne) SetRevokedAt(t time.Time) *PersonalTokenUpdateOne {
+	ptuo.mutation.SetRevokedAt(t)
+	return ptuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (ptuo *PersonalTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *PersonalTokenUpdateOne {
+	if t != nil {
+		ptuo.SetRevokedAt(*t)
+	}
+	return ptuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (ptuo *PersonalTokenUpdateOne) ClearRevokedAt() *PersonalTokenUpdateOne {
+	ptuo.mutation.ClearRevokedAt()
+	return ptuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ptuo *PersonalTokenUpdateOne) SetUser(u *User) *PersonalTokenUpdateOne {
+	return ptuo.SetUserID(u.ID)
+}
+
+// Mutation returns the PersonalTokenMutation object of the builder.
+func (ptuo *PersonalTokenUpdateOne) Mutation() *PersonalTokenMutation {
+	return ptuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ptuo *PersonalTokenUpdateOne) ClearUser() *PersonalTokenUpdateOne {
+	ptuo.mutation.ClearUser()
+	return ptuo
+}
+
+// Where appends a list predicates to the PersonalTokenUpdate builder.
+func (ptuo *PersonalTokenUpdateOne) Where(ps ...predicate.PersonalToken) *PersonalTokenUpdateOne {
+	ptuo.mutation.Where(ps...)
+	return ptuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ptuo *PersonalTokenUpdateOne) Select(field string, fields ...string) *PersonalTokenUpdateOne {
+	ptuo.fields = append([]string{field}, fields...)
+	return ptuo
+}
+
+// Save executes the query and returns the updated PersonalToken entity.
+func (ptuo *PersonalTokenUpdateOne) Save(ctx context.Context) (*PersonalToken, error) {
+	return withHooks(ctx, ptuo.sqlSave, ptuo.mutation, ptuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptuo *PersonalTokenUpdateOne) SaveX(ctx context.Context) *PersonalToken {
+	node, err := ptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ptuo *PersonalTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := ptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptuo *PersonalTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := ptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptuo *PersonalTokenUpdateOne) check() error {
+	if v, ok := ptuo.mutation.Name(); ok {
+		if err := personaltoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalToken.name": %w`, err)}
+		}
+	}
+	if v, ok := ptuo.mutation.TokenHash(); ok {
+		if err := personaltoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalToken.token_hash": %w`, err)}
+		}
+	}
+	if ptuo.mutation.UserCleared() && len(ptuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PersonalToken.user"`)
+	}
+	return nil
+}
+
+func (ptuo *PersonalTokenUpdateOne) sqlSave(ctx context.Context) (_node *PersonalToken, err error) {
+	if err := ptuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(personaltoken.Table, personaltoken.Columns, sqlgraph.NewFieldSpec(personaltoken.FieldID, field.TypeUUID))
+	id, ok := ptuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PersonalToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, personaltoken.FieldID)
+		for _, f := range fields {
+			if !personaltoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != personaltoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptuo.mutation.Name(); ok {
+		_spec.SetField(personaltoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := ptuo.mutation.TokenHash(); ok {
+		_spec.SetField(personaltoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := ptuo.mutation.Scopes(); ok {
+		_spec.SetField(personaltoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ptuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, personaltoken.FieldScopes, value)
+		})
+	}
+	if value, ok := ptuo.mutation.CreatedAt(); ok {
+		_spec.SetField(personaltoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ptuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(personaltoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if ptuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(personaltoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := ptuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(personaltoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if ptuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(personaltoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := ptuo.mutation.RevokedAt(); ok {
+		_spec.SetField(personaltoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if ptuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(personaltoken.FieldRevokedAt, field.TypeTime)
+	}
+	if ptuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personaltoken.UserTable,
+			Columns: []string{personaltoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personaltoken.UserTable,
+			Columns: []string{personaltoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PersonalToken{config: ptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{personaltoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ptuo.mutation.done = true
+	return _node, nil
+}
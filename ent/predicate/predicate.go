@@ -6,11 +6,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Challenge is the predicate function for challenge builders.
+type Challenge func(*sql.Selector)
+
 // Chess is the predicate function for chess builders.
 type Chess func(*sql.Selector)
 
 // GameHistory is the predicate function for gamehistory builders.
 type GameHistory func(*sql.Selector)
 
+// GameLease is the predicate function for gamelease builders.
+type GameLease func(*sql.Selector)
+
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
+// LoginFailure is the predicate function for loginfailure builders.
+type LoginFailure func(*sql.Selector)
+
+// PersonalToken is the predicate function for personaltoken builders.
+type PersonalToken func(*sql.Selector)
+
+// QueueEntry is the predicate function for queueentry builders.
+type QueueEntry func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserToken is the predicate function for usertoken builders.
+type UserToken func(*sql.Selector)
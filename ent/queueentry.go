@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"GopherChessParty/ent/queueentry"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QueueEntry is the model entity for the QueueEntry schema.
+type QueueEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// NodeID holds the value of the "node_id" field.
+	NodeID string `json:"node_id,omitempty"`
+	// AbortCount holds the value of the "abort_count" field.
+	AbortCount int `json:"abort_count,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// HeartbeatAt holds the value of the "heartbeat_at" field.
+	HeartbeatAt  time.Time `json:"heartbeat_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QueueEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case queueentry.FieldAbortCount:
+			values[i] = new(sql.NullInt64)
+		case queueentry.FieldNodeID:
+			values[i] = new(sql.NullString)
+		case queueentry.FieldJoinedAt, queueentry.FieldHeartbeatAt:
+			values[i] = new(sql.NullTime)
+		case queueentry.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QueueEntry fields.
+func (qe *QueueEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case queueentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				qe.ID = *value
+			}
+		case queueentry.FieldNodeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				qe.NodeID = value.String
+			}
+		case queueentry.FieldAbortCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field abort_count", values[i])
+			} else if value.Valid {
+				qe.AbortCount = int(value.Int64)
+			}
+		case queueentry.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				qe.JoinedAt = value.Time
+			}
+		case queueentry.FieldHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_at", values[i])
+			} else if value.Valid {
+				qe.HeartbeatAt = value.Time
+			}
+		default:
+			qe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QueueEntry.
+// This includes values selected through modifiers, order, etc.
+func (qe *QueueEntry) Value(name string) (ent.Value, error) {
+	return qe.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QueueEntry.
+// Note that you need to call QueueEntry.Unwrap() before calling this method if this QueueEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qe *QueueEntry) Update() *QueueEntryUpdateOne {
+	return NewQueueEntryClient(qe.config).UpdateOne(qe)
+}
+
+// Unwrap unwraps the QueueEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qe *QueueEntry) Unwrap() *QueueEntry {
+	_tx, ok := qe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QueueEntry is not a transactional entity")
+	}
+	qe.config.driver = _tx.drv
+	return qe
+}
+
+// String implements the fmt.Stringer.
+func (qe *QueueEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("QueueEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qe.ID))
+	builder.WriteString("node_id=")
+	builder.WriteString(qe.NodeID)
+	builder.WriteString(", ")
+	builder.WriteString("abort_count=")
+	builder.WriteString(fmt.Sprintf("%v", qe.AbortCount))
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(qe.JoinedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("heartbeat_at=")
+	builder.WriteString(qe.HeartbeatAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QueueEntries is a parsable slice of QueueEntry.
+type QueueEntries []*QueueEntry
@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queueentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the queueentry type in the database.
+	Label = "queue_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNodeID holds the string denoting the node_id field in the database.
+	FieldNodeID = "node_id"
+	// FieldAbortCount holds the string denoting the abort_count field in the database.
+	FieldAbortCount = "abort_count"
+	// FieldJoinedAt holds the string denoting the joined_at field in the database.
+	FieldJoinedAt = "joined_at"
+	// FieldHeartbeatAt holds the string denoting the heartbeat_at field in the database.
+	FieldHeartbeatAt = "heartbeat_at"
+	// Table holds the table name of the queueentry in the database.
+	Table = "queue_entries"
+)
+
+// Columns holds all SQL columns for queueentry fields.
+var Columns = []string{
+	FieldID,
+	FieldNodeID,
+	FieldAbortCount,
+	FieldJoinedAt,
+	FieldHeartbeatAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NodeIDValidator is a validator for the "node_id" field. It is called by the builders before save.
+	NodeIDValidator func(string) error
+	// DefaultAbortCount holds the default value on creation for the "abort_count" field.
+	DefaultAbortCount int
+	// DefaultJoinedAt holds the default value on creation for the "joined_at" field.
+	DefaultJoinedAt func() time.Time
+	// DefaultHeartbeatAt holds the default value on creation for the "heartbeat_at" field.
+	DefaultHeartbeatAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the QueueEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNodeID orders the results by the node_id field.
+func ByNodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeID, opts...).ToFunc()
+}
+
+// ByAbortCount orders the results by the abort_count field.
+func ByAbortCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbortCount, opts...).ToFunc()
+}
+
+// ByJoinedAt orders the results by the joined_at field.
+func ByJoinedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinedAt, opts...).ToFunc()
+}
+
+// ByHeartbeatAt orders the results by the heartbeat_at field.
+func ByHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeartbeatAt, opts...).ToFunc()
+}
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queueentry
+
+import (
+	"time"
+
+	"GopherChessParty/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLTE(FieldID, id))
+}
+
+// NodeID applies equality check predicate on the "node_id" field. It's identical to NodeIDEQ.
+func NodeID(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldNodeID, v))
+}
+
+// AbortCount applies equality check predicate on the "abort_count" field. It's identical to AbortCountEQ.
+func AbortCount(v int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldAbortCount, v))
+}
+
+// JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
+func JoinedAt(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// HeartbeatAt applies equality check predicate on the "heartbeat_at" field. It's identical to HeartbeatAtEQ.
+func HeartbeatAt(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// NodeIDEQ applies the EQ predicate on the "node_id" field.
+func NodeIDEQ(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldNodeID, v))
+}
+
+// NodeIDNEQ applies the NEQ predicate on the "node_id" field.
+func NodeIDNEQ(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNEQ(FieldNodeID, v))
+}
+
+// NodeIDIn applies the In predicate on the "node_id" field.
+func NodeIDIn(vs ...string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldIn(FieldNodeID, vs...))
+}
+
+// NodeIDNotIn applies the NotIn predicate on the "node_id" field.
+func NodeIDNotIn(vs ...string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNotIn(FieldNodeID, vs...))
+}
+
+// NodeIDGT applies the GT predicate on the "node_id" field.
+func NodeIDGT(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGT(FieldNodeID, v))
+}
+
+// NodeIDGTE applies the GTE predicate on the "node_id" field.
+func NodeIDGTE(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGTE(FieldNodeID, v))
+}
+
+// NodeIDLT applies the LT predicate on the "node_id" field.
+func NodeIDLT(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLT(FieldNodeID, v))
+}
+
+// NodeIDLTE applies the LTE predicate on the "node_id" field.
+func NodeIDLTE(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLTE(FieldNodeID, v))
+}
+
+// NodeIDContains applies the Contains predicate on the "node_id" field.
+func NodeIDContains(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldContains(FieldNodeID, v))
+}
+
+// NodeIDHasPrefix applies the HasPrefix predicate on the "node_id" field.
+func NodeIDHasPrefix(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldHasPrefix(FieldNodeID, v))
+}
+
+// NodeIDHasSuffix applies the HasSuffix predicate on the "node_id" field.
+func NodeIDHasSuffix(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldHasSuffix(FieldNodeID, v))
+}
+
+// NodeIDEqualFold applies the EqualFold predicate on the "node_id" field.
+func NodeIDEqualFold(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEqualFold(FieldNodeID, v))
+}
+
+// NodeIDContainsFold applies the ContainsFold predicate on the "node_id" field.
+func NodeIDContainsFold(v string) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldContainsFold(FieldNodeID, v))
+}
+
+// AbortCountEQ applies the EQ predicate on the "abort_count" field.
+func AbortCountEQ(v int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldAbortCount, v))
+}
+
+// AbortCountNEQ applies the NEQ predicate on the "abort_count" field.
+func AbortCountNEQ(v int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNEQ(FieldAbortCount, v))
+}
+
+// AbortCountIn applies the In predicate on the "abort_count" field.
+func AbortCountIn(vs ...int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldIn(FieldAbortCount, vs...))
+}
+
+// AbortCountNotIn applies the NotIn predicate on the "abort_count" field.
+func AbortCountNotIn(vs ...int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNotIn(FieldAbortCount, vs...))
+}
+
+// AbortCountGT applies the GT predicate on the "abort_count" field.
+func AbortCountGT(v int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGT(FieldAbortCount, v))
+}
+
+// AbortCountGTE applies the GTE predicate on the "abort_count" field.
+func AbortCountGTE(v int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGTE(FieldAbortCount, v))
+}
+
+// AbortCountLT applies the LT predicate on the "abort_count" field.
+func AbortCountLT(v int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLT(FieldAbortCount, v))
+}
+
+// AbortCountLTE applies the LTE predicate on the "abort_count" field.
+func AbortCountLTE(v int) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLTE(FieldAbortCount, v))
+}
+
+// JoinedAtEQ applies the EQ predicate on the "joined_at" field.
+func JoinedAtEQ(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtNEQ applies the NEQ predicate on the "joined_at" field.
+func JoinedAtNEQ(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtIn applies the In predicate on the "joined_at" field.
+func JoinedAtIn(vs ...time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtNotIn applies the NotIn predicate on the "joined_at" field.
+func JoinedAtNotIn(vs ...time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNotIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtGT applies the GT predicate on the "joined_at" field.
+func JoinedAtGT(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGT(FieldJoinedAt, v))
+}
+
+// JoinedAtGTE applies the GTE predicate on the "joined_at" field.
+func JoinedAtGTE(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGTE(FieldJoinedAt, v))
+}
+
+// JoinedAtLT applies the LT predicate on the "joined_at" field.
+func JoinedAtLT(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLT(FieldJoinedAt, v))
+}
+
+// JoinedAtLTE applies the LTE predicate on the "joined_at" field.
+func JoinedAtLTE(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLTE(FieldJoinedAt, v))
+}
+
+// HeartbeatAtEQ applies the EQ predicate on the "heartbeat_at" field.
+func HeartbeatAtEQ(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtNEQ applies the NEQ predicate on the "heartbeat_at" field.
+func HeartbeatAtNEQ(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIn applies the In predicate on the "heartbeat_at" field.
+func HeartbeatAtIn(vs ...time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtNotIn applies the NotIn predicate on the "heartbeat_at" field.
+func HeartbeatAtNotIn(vs ...time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldNotIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtGT applies the GT predicate on the "heartbeat_at" field.
+func HeartbeatAtGT(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtGTE applies the GTE predicate on the "heartbeat_at" field.
+func HeartbeatAtGTE(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldGTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLT applies the LT predicate on the "heartbeat_at" field.
+func HeartbeatAtLT(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLTE applies the LTE predicate on the "heartbeat_at" field.
+func HeartbeatAtLTE(v time.Time) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.FieldLTE(FieldHeartbeatAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QueueEntry) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QueueEntry) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QueueEntry) predicate.QueueEntry {
+	return predicate.QueueEntry(sql.NotPredicates(p))
+}
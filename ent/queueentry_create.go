@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/queueentry"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QueueEntryCreate is the builder for creating a QueueEntry entity.
+type QueueEntryCreate struct {
+	config
+	mutation *QueueEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetNodeID sets the "node_id" field.
+func (qec *QueueEntryCreate) SetNodeID(s string) *QueueEntryCreate {
+	qec.mutation.SetNodeID(s)
+	return qec
+}
+
+// SetAbortCount sets the "abort_count" field.
+func (qec *QueueEntryCreate) SetAbortCount(i int) *QueueEntryCreate {
+	qec.mutation.SetAbortCount(i)
+	return qec
+}
+
+// SetNillableAbortCount sets the "abort_count" field if the given value is not nil.
+func (qec *QueueEntryCreate) SetNillableAbortCount(i *int) *QueueEntryCreate {
+	if i != nil {
+		qec.SetAbortCount(*i)
+	}
+	return qec
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (qec *QueueEntryCreate) SetJoinedAt(t time.Time) *QueueEntryCreate {
+	qec.mutation.SetJoinedAt(t)
+	return qec
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (qec *QueueEntryCreate) SetNillableJoinedAt(t *time.Time) *QueueEntryCreate {
+	if t != nil {
+		qec.SetJoinedAt(*t)
+	}
+	return qec
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (qec *QueueEntryCreate) SetHeartbeatAt(t time.Time) *QueueEntryCreate {
+	qec.mutation.SetHeartbeatAt(t)
+	return qec
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (qec *QueueEntryCreate) SetNillableHeartbeatAt(t *time.Time) *QueueEntryCreate {
+	if t != nil {
+		qec.SetHeartbeatAt(*t)
+	}
+	return qec
+}
+
+// SetID sets the "id" field.
+func (qec *QueueEntryCreate) SetID(u uuid.UUID) *QueueEntryCreate {
+	qec.mutation.SetID(u)
+	return qec
+}
+
+// Mutation returns the QueueEntryMutation object of the builder.
+func (qec *QueueEntryCreate) Mutation() *QueueEntryMutation {
+	return qec.mutation
+}
+
+// Save creates the QueueEntry in the database.
+func (qec *QueueEntryCreate) Save(ctx context.Context) (*QueueEntry, error) {
+	qec.defaults()
+	return withHooks(ctx, qec.sqlSave, qec.mutation, qec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qec *QueueEntryCreate) SaveX(ctx context.Context) *QueueEntry {
+	v, err := qec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qec *QueueEntryCreate) Exec(ctx context.Context) error {
+	_, err := qec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qec *QueueEntryCreate) ExecX(ctx context.Context) {
+	if err := qec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qec *QueueEntryCreate) defaults() {
+	if _, ok := qec.mutation.AbortCount(); !ok {
+		v := queueentry.DefaultAbortCount
+		qec.mutation.SetAbortCount(v)
+	}
+	if _, ok := qec.mutation.JoinedAt(); !ok {
+		v := queueentry.DefaultJoinedAt()
+		qec.mutation.SetJoinedAt(v)
+	}
+	if _, ok := qec.mutation.HeartbeatAt(); !ok {
+		v := queueentry.DefaultHeartbeatAt()
+		qec.mutation.SetHeartbeatAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qec *QueueEntryCreate) check() error {
+	if _, ok := qec.mutation.NodeID(); !ok {
+		return &ValidationError{Name: "node_id", err: errors.New(`ent: missing required field "QueueEntry.node_id"`)}
+	}
+	if v, ok := qec.mutation.NodeID(); ok {
+		if err := queueentry.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "QueueEntry.node_id": %w`, err)}
+		}
+	}
+	if _, ok := qec.mutation.AbortCount(); !ok {
+		return &ValidationError{Name: "abort_count", err: errors.New(`ent: missing required field "QueueEntry.abort_count"`)}
+	}
+	if _, ok := qec.mutation.JoinedAt(); !ok {
+		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "QueueEntry.joined_at"`)}
+	}
+	if _, ok := qec.mutation.HeartbeatAt(); !ok {
+		return &ValidationError{Name: "heartbeat_at", err: errors.New(`ent: missing required field "QueueEntry.heartbeat_at"`)}
+	}
+	return nil
+}
+
+func (qec *QueueEntryCreate) sqlSave(ctx context.Context) (*QueueEntry, error) {
+	if err := qec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	qec.mutation.id = &_node.ID
+	qec.mutation.done = true
+	return _node, nil
+}
+
+func (qec *QueueEntryCreate) createSpec() (*QueueEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueueEntry{config: qec.config}
+		_spec = sqlgraph.NewCreateSpec(queueentry.Table, sqlgraph.NewFieldSpec(queueentry.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = qec.conflict
+	if id, ok := qec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := qec.mutation.NodeID(); ok {
+		_spec.SetField(queueentry.FieldNodeID, field.TypeString, value)
+		_node.NodeID = value
+	}
+	if value, ok := qec.mutation.AbortCount(); ok {
+		_spec.SetField(queueentry.FieldAbortCount, field.TypeInt, value)
+		_node.AbortCount = value
+	}
+	if value, ok := qec.mutation.JoinedAt(); ok {
+		_spec.SetField(queueentry.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if value, ok := qec.mutation.HeartbeatAt(); ok {
+		_spec.SetField(queueentry.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QueueEntry.Create().
+//		SetNodeID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QueueEntryUpsert) {
+//			SetNodeID(v+v).
+//		}).
+//		Exec(ctx)
+func (qec *QueueEntryCreate) OnConflict(opts ...sql.ConflictOption) *QueueEntryUpsertOne {
+	qec.conflict = opts
+	return &QueueEntryUpsertOne{
+		create: qec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QueueEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qec *QueueEntryCreate) OnConflictColumns(columns ...string) *QueueEntryUpsertOne {
+	qec.conflict = append(qec.conflict, sql.ConflictColumns(columns...))
+	return &QueueEntryUpsertOne{
+		create: qec,
+	}
+}
+
+type (
+	// QueueEntryUpsertOne is the builder for "upsert"-ing
+	//  one QueueEntry node.
+	QueueEntryUpsertOne struct {
+		create *QueueEntryCreate
+	}
+
+	// QueueEntryUpsert is the "OnConflict" setter.
+	QueueEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNodeID sets the "node_id" field.
+func (u *QueueEntryUpsert) SetNodeID(v string) *QueueEntryUpsert {
+	u.Set(queueentry.FieldNodeID, v)
+	return u
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *QueueEntryUpsert) UpdateNodeID() *QueueEntryUpsert {
+	u.SetExcluded(queueentry.FieldNodeID)
+	return u
+}
+
+// SetAbortCount sets the "abort_count" field.
+func (u *QueueEntryUpsert) SetAbortCount(v int) *QueueEntryUpsert {
+	u.Set(queueentry.FieldAbortCount, v)
+	return u
+}
+
+// UpdateAbortCount sets the "abort_count" field to the value that was provided on create.
+func (u *QueueEntryUpsert) UpdateAbortCount() *QueueEntryUpsert {
+	u.SetExcluded(queueentry.FieldAbortCount)
+	return u
+}
+
+// AddAbortCount adds v to the "abort_count" field.
+func (u *QueueEntryUpsert) AddAbortCount(v int) *QueueEntryUpsert {
+	u.Add(queueentry.FieldAbortCount, v)
+	return u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *QueueEntryUpsert) SetJoinedAt(v time.Time) *QueueEntryUpsert {
+	u.Set(queueentry.FieldJoinedAt, v)
+	return u
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *QueueEntryUpsert) UpdateJoinedAt() *QueueEntryUpsert {
+	u.SetExcluded(queueentry.FieldJoinedAt)
+	return u
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *QueueEntryUpsert) SetHeartbeatAt(v time.Time) *QueueEntryUpsert {
+	u.Set(queueentry.FieldHeartbeatAt, v)
+	return u
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *QueueEntryUpsert) UpdateHeartbeatAt() *QueueEntryUpsert {
+	u.SetExcluded(queueentry.FieldHeartbeatAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.QueueEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(queueentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QueueEntryUpsertOne) UpdateNewValues() *QueueEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(queueentry.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QueueEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QueueEntryUpsertOne) Ignore() *QueueEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QueueEntryUpsertOne) DoNothing() *QueueEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QueueEntryCreate.OnConflict
+// documentation for more info.
+func (u *QueueEntryUpsertOne) Update(set func(*QueueEntryUpsert)) *QueueEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QueueEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *QueueEntryUpsertOne) SetNodeID(v string) *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *QueueEntryUpsertOne) UpdateNodeID() *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// SetAbortCount sets the "abort_count" field.
+func (u *QueueEntryUpsertOne) SetAbortCount(v int) *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetAbortCount(v)
+	})
+}
+
+// AddAbortCount adds v to the "abort_count" field.
+func (u *QueueEntryUpsertOne) AddAbortCount(v int) *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.AddAbortCount(v)
+	})
+}
+
+// UpdateAbortCount sets the "abort_count" field to the value that was provided on create.
+func (u *QueueEntryUpsertOne) UpdateAbortCount() *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateAbortCount()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *QueueEntryUpsertOne) SetJoinedAt(v time.Time) *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *QueueEntryUpsertOne) UpdateJoinedAt() *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *QueueEntryUpsertOne) SetHeartbeatAt(v time.Time) *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *QueueEntryUpsertOne) UpdateHeartbeatAt() *QueueEntryUpsertOne {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QueueEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QueueEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QueueEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QueueEntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QueueEntryUpsertOne.ID is not supported by MySQL driver. Use QueueEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QueueEntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QueueEntryCreateBulk is the builder for creating many QueueEntry entities in bulk.
+type QueueEntryCreateBulk struct {
+	config
+	err      error
+	builders []*QueueEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the QueueEntry entities in the database.
+func (qecb *QueueEntryCreateBulk) Save(ctx context.Context) ([]*QueueEntry, error) {
+	if qecb.err != nil {
+		return nil, qecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qecb.builders))
+	nodes := make([]*QueueEntry, len(qecb.builders))
+	mutators := make([]Mutator, len(qecb.builders))
+	for i := range qecb.builders {
+		func(i int, root context.Context) {
+			builder := qecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueueEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qecb *QueueEntryCreateBulk) SaveX(ctx context.Context) []*QueueEntry {
+	v, err := qecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qecb *QueueEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := qecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qecb *QueueEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := qecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QueueEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QueueEntryUpsert) {
+//			SetNodeID(v+v).
+//		}).
+//		Exec(ctx)
+func (qecb *QueueEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *QueueEntryUpsertBulk {
+	qecb.conflict = opts
+	return &QueueEntryUpsertBulk{
+		create: qecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QueueEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qecb *QueueEntryCreateBulk) OnConflictColumns(columns ...string) *QueueEntryUpsertBulk {
+	qecb.conflict = append(qecb.conflict, sql.ConflictColumns(columns...))
+	return &QueueEntryUpsertBulk{
+		create: qecb,
+	}
+}
+
+// QueueEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of QueueEntry nodes.
+type QueueEntryUpsertBulk struct {
+	create *QueueEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QueueEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(queueentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QueueEntryUpsertBulk) UpdateNewValues() *QueueEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(queueentry.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QueueEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QueueEntryUpsertBulk) Ignore() *QueueEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QueueEntryUpsertBulk) DoNothing() *QueueEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QueueEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *QueueEntryUpsertBulk) Update(set func(*QueueEntryUpsert)) *QueueEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QueueEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNodeID sets the "node_id" field.
+func (u *QueueEntryUpsertBulk) SetNodeID(v string) *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetNodeID(v)
+	})
+}
+
+// UpdateNodeID sets the "node_id" field to the value that was provided on create.
+func (u *QueueEntryUpsertBulk) UpdateNodeID() *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateNodeID()
+	})
+}
+
+// SetAbortCount sets the "abort_count" field.
+func (u *QueueEntryUpsertBulk) SetAbortCount(v int) *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetAbortCount(v)
+	})
+}
+
+// AddAbortCount adds v to the "abort_count" field.
+func (u *QueueEntryUpsertBulk) AddAbortCount(v int) *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.AddAbortCount(v)
+	})
+}
+
+// UpdateAbortCount sets the "abort_count" field to the value that was provided on create.
+func (u *QueueEntryUpsertBulk) UpdateAbortCount() *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateAbortCount()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *QueueEntryUpsertBulk) SetJoinedAt(v time.Time) *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *QueueEntryUpsertBulk) UpdateJoinedAt() *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *QueueEntryUpsertBulk) SetHeartbeatAt(v time.Time) *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *QueueEntryUpsertBulk) UpdateHeartbeatAt() *QueueEntryUpsertBulk {
+	return u.Update(func(s *QueueEntryUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QueueEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QueueEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QueueEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QueueEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
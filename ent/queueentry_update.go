@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"GopherChessParty/ent/predicate"
+	"GopherChessParty/ent/queueentry"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QueueEntryUpdate is the builder for updating QueueEntry entities.
+type QueueEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QueueEntryMutation
+}
+
+// Where appends a list predicates to the QueueEntryUpdate builder.
+func (qeu *QueueEntryUpdate) Where(ps ...predicate.QueueEntry) *QueueEntryUpdate {
+	qeu.mutation.Where(ps...)
+	return qeu
+}
+
+// SetNodeID sets the "node_id" field.
+func (qeu *QueueEntryUpdate) SetNodeID(s string) *QueueEntryUpdate {
+	qeu.mutation.SetNodeID(s)
+	return qeu
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (qeu *QueueEntryUpdate) SetNillableNodeID(s *string) *QueueEntryUpdate {
+	if s != nil {
+		qeu.SetNodeID(*s)
+	}
+	return qeu
+}
+
+// SetAbortCount sets the "abort_count" field.
+func (qeu *QueueEntryUpdate) SetAbortCount(i int) *QueueEntryUpdate {
+	qeu.mutation.ResetAbortCount()
+	qeu.mutation.SetAbortCount(i)
+	return qeu
+}
+
+// SetNillableAbortCount sets the "abort_count" field if the given value is not nil.
+func (qeu *QueueEntryUpdate) SetNillableAbortCount(i *int) *QueueEntryUpdate {
+	if i != nil {
+		qeu.SetAbortCount(*i)
+	}
+	return qeu
+}
+
+// AddAbortCount adds i to the "abort_count" field.
+func (qeu *QueueEntryUpdate) AddAbortCount(i int) *QueueEntryUpdate {
+	qeu.mutation.AddAbortCount(i)
+	return qeu
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (qeu *QueueEntryUpdate) SetJoinedAt(t time.Time) *QueueEntryUpdate {
+	qeu.mutation.SetJoinedAt(t)
+	return qeu
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (qeu *QueueEntryUpdate) SetNillableJoinedAt(t *time.Time) *QueueEntryUpdate {
+	if t != nil {
+		qeu.SetJoinedAt(*t)
+	}
+	return qeu
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (qeu *QueueEntryUpdate) SetHeartbeatAt(t time.Time) *QueueEntryUpdate {
+	qeu.mutation.SetHeartbeatAt(t)
+	return qeu
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (qeu *QueueEntryUpdate) SetNillableHeartbeatAt(t *time.Time) *QueueEntryUpdate {
+	if t != nil {
+		qeu.SetHeartbeatAt(*t)
+	}
+	return qeu
+}
+
+// Mutation returns the QueueEntryMutation object of the builder.
+func (qeu *QueueEntryUpdate) Mutation() *QueueEntryMutation {
+	return qeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qeu *QueueEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qeu.sqlSave, qeu.mutation, qeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qeu *QueueEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := qeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qeu *QueueEntryUpdate) Exec(ctx context.Context) error {
+	_, err := qeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qeu *QueueEntryUpdate) ExecX(ctx context.Context) {
+	if err := qeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qeu *QueueEntryUpdate) check() error {
+	if v, ok := qeu.mutation.NodeID(); ok {
+		if err := queueentry.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "QueueEntry.node_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qeu *QueueEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(queueentry.Table, queueentry.Columns, sqlgraph.NewFieldSpec(queueentry.FieldID, field.TypeUUID))
+	if ps := qeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qeu.mutation.NodeID(); ok {
+		_spec.SetField(queueentry.FieldNodeID, field.TypeString, value)
+	}
+	if value, ok := qeu.mutation.AbortCount(); ok {
+		_spec.SetField(queueentry.FieldAbortCount, field.TypeInt, value)
+	}
+	if value, ok := qeu.mutation.AddedAbortCount(); ok {
+		_spec.AddField(queueentry.FieldAbortCount, field.TypeInt, value)
+	}
+	if value, ok := qeu.mutation.JoinedAt(); ok {
+		_spec.SetField(queueentry.FieldJoinedAt, field.TypeTime, value)
+	}
+	if value, ok := qeu.mutation.HeartbeatAt(); ok {
+		_spec.SetField(queueentry.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queueentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qeu.mutation.done = true
+	return n, nil
+}
+
+// QueueEntryUpdateOne is the builder for updating a single QueueEntry entity.
+type QueueEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QueueEntryMutation
+}
+
+// SetNodeID sets the "node_id" field.
+func (qeuo *QueueEntryUpdateOne) SetNodeID(s string) *QueueEntryUpdateOne {
+	qeuo.mutation.SetNodeID(s)
+	return qeuo
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (qeuo *QueueEntryUpdateOne) SetNillableNodeID(s *string) *QueueEntryUpdateOne {
+	if s != nil {
+		qeuo.SetNodeID(*s)
+	}
+	return qeuo
+}
+
+// SetAbortCount sets the "abort_count" field.
+func (qeuo *QueueEntryUpdateOne) SetAbortCount(i int) *QueueEntryUpdateOne {
+	qeuo.mutation.ResetAbortCount()
+	qeuo.mutation.SetAbortCount(i)
+	return qeuo
+}
+
+// SetNillableAbortCount sets the "abort_count" field if the given value is not nil.
+func (qeuo *QueueEntryUpdateOne) SetNillableAbortCount(i *int) *QueueEntryUpdateOne {
+	if i != nil {
+		qeuo.SetAbortCount(*i)
+	}
+	return qeuo
+}
+
+// AddAbortCount adds i to the "abort_count" field.
+func (qeuo *QueueEntryUpdateOne) AddAbortCount(i int) *QueueEntryUpdateOne {
+	qeuo.mutation.AddAbortCount(i)
+	return qeuo
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (qeuo *QueueEntryUpdateOne) SetJoinedAt(t time.Time) *QueueEntryUpdateOne {
+	qeuo.mutation.SetJoinedAt(t)
+	return qeuo
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (qeuo *QueueEntryUpdateOne) SetNillableJoinedAt(t *time.Time) *QueueEntryUpdateOne {
+	if t != nil {
+		qeuo.SetJoinedAt(*t)
+	}
+	return qeuo
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (qeuo *QueueEntryUpdateOne) SetHeartbeatAt(t time.Time) *QueueEntryUpdateOne {
+	qeuo.mutation.SetHeartbeatAt(t)
+	return qeuo
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (qeuo *QueueEntryUpdateOne) SetNillableHeartbeatAt(t *time.Time) *QueueEntryUpdateOne {
+	if t != nil {
+		qeuo.SetHeartbeatAt(*t)
+	}
+	return qeuo
+}
+
+// Mutation returns the QueueEntryMutation object of the builder.
+func (qeuo *QueueEntryUpdateOne) Mutation() *QueueEntryMutation {
+	return qeuo.mutation
+}
+
+// Where appends a list predicates to the QueueEntryUpdate builder.
+func (qeuo *QueueEntryUpdateOne) Where(ps ...predicate.QueueEntry) *QueueEntryUpdateOne {
+	qeuo.mutation.Where(ps...)
+	return qeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qeuo *QueueEntryUpdateOne) Select(field string, fields ...string) *QueueEntryUpdateOne {
+	qeuo.fields = append([]string{field}, fields...)
+	return qeuo
+}
+
+// Save executes the query and returns the updated QueueEntry entity.
+func (qeuo *QueueEntryUpdateOne) Save(ctx context.Context) (*QueueEntry, error) {
+	return withHooks(ctx, qeuo.sqlSave, qeuo.mutation, qeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qeuo *QueueEntryUpdateOne) SaveX(ctx context.Context) *QueueEntry {
+	node, err := qeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qeuo *QueueEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := qeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qeuo *QueueEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := qeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qeuo *QueueEntryUpdateOne) check() error {
+	if v, ok := qeuo.mutation.NodeID(); ok {
+		if err := queueentry.NodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "node_id", err: fmt.Errorf(`ent: validator failed for field "QueueEntry.node_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (qeuo *QueueEntryUpdateOne) sqlSave(ctx context.Context) (_node *QueueEntry, err error) {
+	if err := qeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(queueentry.Table, queueentry.Columns, sqlgraph.NewFieldSpec(queueentry.FieldID, field.TypeUUID))
+	id, ok := qeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QueueEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queueentry.FieldID)
+		for _, f := range fields {
+			if !queueentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != queueentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qeuo.mutation.NodeID(); ok {
+		_spec.SetField(queueentry.FieldNodeID, field.TypeString, value)
+	}
+	if value, ok := qeuo.mutation.AbortCount(); ok {
+		_spec.SetField(queueentry.FieldAbortCount, field.TypeInt, value)
+	}
+	if value, ok := qeuo.mutation.AddedAbortCount(); ok {
+		_spec.AddField(queueentry.FieldAbortCount, field.TypeInt, value)
+	}
+	if value, ok := qeuo.mutation.JoinedAt(); ok {
+		_spec.SetField(queueentry.FieldJoinedAt, field.TypeTime, value)
+	}
+	if value, ok := qeuo.mutation.HeartbeatAt(); ok {
+		_spec.SetField(queueentry.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	_node = &QueueEntry{config: qeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queueentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qeuo.mutation.done = true
+	return _node, nil
+}
@@ -5,10 +5,19 @@ package ent
 import (
 	"time"
 
+	"GopherChessParty/ent/challenge"
 	"GopherChessParty/ent/chess"
 	"GopherChessParty/ent/gamehistory"
+	"GopherChessParty/ent/gamelease"
+	"GopherChessParty/ent/identity"
+	"GopherChessParty/ent/loginfailure"
+	"GopherChessParty/ent/personaltoken"
+	"GopherChessParty/ent/queueentry"
+	"GopherChessParty/ent/recoverycode"
 	"GopherChessParty/ent/schema"
+	"GopherChessParty/ent/session"
 	"GopherChessParty/ent/user"
+	"GopherChessParty/ent/usertoken"
 	"github.com/google/uuid"
 )
 
@@ -16,6 +25,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	challengeFields := schema.Challenge{}.Fields()
+	_ = challengeFields
+	// challengeDescCreatedAt is the schema descriptor for created_at field.
+	challengeDescCreatedAt := challengeFields[6].Descriptor()
+	// challenge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	challenge.DefaultCreatedAt = challengeDescCreatedAt.Default.(func() time.Time)
+	// challengeDescID is the schema descriptor for id field.
+	challengeDescID := challengeFields[0].Descriptor()
+	// challenge.DefaultID holds the default value on creation for the id field.
+	challenge.DefaultID = challengeDescID.Default.(func() uuid.UUID)
 	chessFields := schema.Chess{}.Fields()
 	_ = chessFields
 	// chessDescCreatedAt is the schema descriptor for created_at field.
@@ -40,6 +59,130 @@ func init() {
 	gamehistoryDescID := gamehistoryFields[0].Descriptor()
 	// gamehistory.DefaultID holds the default value on creation for the id field.
 	gamehistory.DefaultID = gamehistoryDescID.Default.(func() uuid.UUID)
+	gameleaseFields := schema.GameLease{}.Fields()
+	_ = gameleaseFields
+	// gameleaseDescNodeID is the schema descriptor for node_id field.
+	gameleaseDescNodeID := gameleaseFields[1].Descriptor()
+	// gamelease.NodeIDValidator is a validator for the "node_id" field. It is called by the builders before save.
+	gamelease.NodeIDValidator = gameleaseDescNodeID.Validators[0].(func(string) error)
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescProvider is the schema descriptor for provider field.
+	identityDescProvider := identityFields[2].Descriptor()
+	// identity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	identity.ProviderValidator = identityDescProvider.Validators[0].(func(string) error)
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[3].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = identityDescSubject.Validators[0].(func(string) error)
+	// identityDescEmail is the schema descriptor for email field.
+	identityDescEmail := identityFields[4].Descriptor()
+	// identity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	identity.EmailValidator = identityDescEmail.Validators[0].(func(string) error)
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityFields[5].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescID is the schema descriptor for id field.
+	identityDescID := identityFields[0].Descriptor()
+	// identity.DefaultID holds the default value on creation for the id field.
+	identity.DefaultID = identityDescID.Default.(func() uuid.UUID)
+	loginfailureFields := schema.LoginFailure{}.Fields()
+	_ = loginfailureFields
+	// loginfailureDescCount is the schema descriptor for count field.
+	loginfailureDescCount := loginfailureFields[1].Descriptor()
+	// loginfailure.DefaultCount holds the default value on creation for the count field.
+	loginfailure.DefaultCount = loginfailureDescCount.Default.(int)
+	// loginfailureDescID is the schema descriptor for id field.
+	loginfailureDescID := loginfailureFields[0].Descriptor()
+	// loginfailure.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	loginfailure.IDValidator = func() func(string) error {
+		validators := loginfailureDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	personaltokenFields := schema.PersonalToken{}.Fields()
+	_ = personaltokenFields
+	// personaltokenDescName is the schema descriptor for name field.
+	personaltokenDescName := personaltokenFields[2].Descriptor()
+	// personaltoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	personaltoken.NameValidator = personaltokenDescName.Validators[0].(func(string) error)
+	// personaltokenDescTokenHash is the schema descriptor for token_hash field.
+	personaltokenDescTokenHash := personaltokenFields[3].Descriptor()
+	// personaltoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personaltoken.TokenHashValidator = personaltokenDescTokenHash.Validators[0].(func(string) error)
+	// personaltokenDescCreatedAt is the schema descriptor for created_at field.
+	personaltokenDescCreatedAt := personaltokenFields[5].Descriptor()
+	// personaltoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personaltoken.DefaultCreatedAt = personaltokenDescCreatedAt.Default.(func() time.Time)
+	// personaltokenDescID is the schema descriptor for id field.
+	personaltokenDescID := personaltokenFields[0].Descriptor()
+	// personaltoken.DefaultID holds the default value on creation for the id field.
+	personaltoken.DefaultID = personaltokenDescID.Default.(func() uuid.UUID)
+	queueentryFields := schema.QueueEntry{}.Fields()
+	_ = queueentryFields
+	// queueentryDescNodeID is the schema descriptor for node_id field.
+	queueentryDescNodeID := queueentryFields[1].Descriptor()
+	// queueentry.NodeIDValidator is a validator for the "node_id" field. It is called by the builders before save.
+	queueentry.NodeIDValidator = queueentryDescNodeID.Validators[0].(func(string) error)
+	// queueentryDescAbortCount is the schema descriptor for abort_count field.
+	queueentryDescAbortCount := queueentryFields[2].Descriptor()
+	// queueentry.DefaultAbortCount holds the default value on creation for the abort_count field.
+	queueentry.DefaultAbortCount = queueentryDescAbortCount.Default.(int)
+	// queueentryDescJoinedAt is the schema descriptor for joined_at field.
+	queueentryDescJoinedAt := queueentryFields[3].Descriptor()
+	// queueentry.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	queueentry.DefaultJoinedAt = queueentryDescJoinedAt.Default.(func() time.Time)
+	// queueentryDescHeartbeatAt is the schema descriptor for heartbeat_at field.
+	queueentryDescHeartbeatAt := queueentryFields[4].Descriptor()
+	// queueentry.DefaultHeartbeatAt holds the default value on creation for the heartbeat_at field.
+	queueentry.DefaultHeartbeatAt = queueentryDescHeartbeatAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[2].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescID is the schema descriptor for id field.
+	recoverycodeDescID := recoverycodeFields[0].Descriptor()
+	// recoverycode.DefaultID holds the default value on creation for the id field.
+	recoverycode.DefaultID = recoverycodeDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[3].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	sessionDescLastUsedAt := sessionFields[4].Descriptor()
+	// session.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	session.DefaultLastUsedAt = sessionDescLastUsedAt.Default.(func() time.Time)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[7].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescIP is the schema descriptor for ip field.
+	sessionDescIP := sessionFields[8].Descriptor()
+	// session.DefaultIP holds the default value on creation for the ip field.
+	session.DefaultIP = sessionDescIP.Default.(string)
+	// session.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	session.IPValidator = sessionDescIP.Validators[0].(func(string) error)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -62,8 +205,50 @@ func init() {
 	userDescPassword := userFields[5].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescAutoQueen is the schema descriptor for auto_queen field.
+	userDescAutoQueen := userFields[6].Descriptor()
+	// user.DefaultAutoQueen holds the default value on creation for the auto_queen field.
+	user.DefaultAutoQueen = userDescAutoQueen.Default.(bool)
+	// userDescAbortCount is the schema descriptor for abort_count field.
+	userDescAbortCount := userFields[7].Descriptor()
+	// user.DefaultAbortCount holds the default value on creation for the abort_count field.
+	user.DefaultAbortCount = userDescAbortCount.Default.(int)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[8].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[9].Descriptor()
+	// user.TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	user.TotpSecretValidator = userDescTotpSecret.Validators[0].(func(string) error)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[10].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[11].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescBot is the schema descriptor for bot field.
+	userDescBot := userFields[12].Descriptor()
+	// user.DefaultBot holds the default value on creation for the bot field.
+	user.DefaultBot = userDescBot.Default.(bool)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	usertokenFields := schema.UserToken{}.Fields()
+	_ = usertokenFields
+	// usertokenDescTokenHash is the schema descriptor for token_hash field.
+	usertokenDescTokenHash := usertokenFields[3].Descriptor()
+	// usertoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	usertoken.TokenHashValidator = usertokenDescTokenHash.Validators[0].(func(string) error)
+	// usertokenDescCreatedAt is the schema descriptor for created_at field.
+	usertokenDescCreatedAt := usertokenFields[4].Descriptor()
+	// usertoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertoken.DefaultCreatedAt = usertokenDescCreatedAt.Default.(func() time.Time)
+	// usertokenDescID is the schema descriptor for id field.
+	usertokenDescID := usertokenFields[0].Descriptor()
+	// usertoken.DefaultID holds the default value on creation for the id field.
+	usertoken.DefaultID = usertokenDescID.Default.(func() uuid.UUID)
 }
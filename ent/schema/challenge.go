@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Challenge holds the schema definition for the Challenge entity.
+type Challenge struct {
+	ent.Schema
+}
+
+// Fields of the Challenge.
+func (Challenge) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("challenger_id", uuid.UUID{}),
+		field.UUID("dest_user_id", uuid.UUID{}),
+		// Цвет, которым играет вызывающий
+		field.Enum("color").Values("white", "black", "random").Default("random"),
+		field.Enum("status").Values("created", "accepted", "declined", "canceled").Default("created"),
+		// Партия, созданная после принятия вызова
+		field.UUID("game_id", uuid.UUID{}).Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Challenge.
+func (Challenge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("challenger", User.Type).
+			Ref("challenges_sent").
+			Field("challenger_id").
+			Unique().
+			Required(),
+		edge.From("dest_user", User.Type).
+			Ref("challenges_received").
+			Field("dest_user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Challenge.
+func (Challenge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("challenger_id", "status"),
+		index.Fields("dest_user_id", "status"),
+	}
+}
@@ -21,11 +21,26 @@ const (
 	aborted    = "aborted"
 )
 
+// Результаты партии в нотации PGN
 const (
 	winWhite   = "1-0"
 	winBlack   = "0-1"
-	draw       = "1-1"
-	processing = "0-0"
+	draw       = "1/2-1/2"
+	processing = "*"
+)
+
+// Причины завершения партии
+const (
+	checkmate            = "checkmate"
+	resignation          = "resignation"
+	timeout              = "timeout"
+	stalemate            = "stalemate"
+	repetition           = "repetition"
+	fiftyMove            = "fifty_move"
+	insufficientMaterial = "insufficient_material"
+	agreement            = "agreement"
+	abandonment          = "abandonment"
+	adjudication         = "adjudication"
 )
 
 // Fields of the Chess.
@@ -35,7 +50,30 @@ func (Chess) Fields() []ent.Field {
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now),
 		field.Enum("status").Values(waiting, inProgress, finished, aborted).Default(waiting),
-		field.Enum("result").Values(winWhite, winBlack, draw, processing).Default(processing),
+		field.Enum("result").
+			NamedValues(
+				"WhiteWon", winWhite,
+				"BlackWon", winBlack,
+				"Draw", draw,
+				"InProgress", processing,
+			).
+			Default(processing),
+		field.Enum("termination").
+			Values(
+				checkmate,
+				resignation,
+				timeout,
+				stalemate,
+				repetition,
+				fiftyMove,
+				insufficientMaterial,
+				agreement,
+				abandonment,
+				aborted,
+				adjudication,
+			).
+			Optional().
+			Nillable(),
 	}
 }
 
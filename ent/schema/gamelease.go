@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GameLease holds the schema definition for the GameLease entity.
+type GameLease struct {
+	ent.Schema
+}
+
+// Fields of the GameLease.
+func (GameLease) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique(),
+		field.String("node_id").MaxLen(255),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the GameLease.
+func (GameLease) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginFailure holds the schema definition for the LoginFailure entity.
+type LoginFailure struct {
+	ent.Schema
+}
+
+// Fields of the LoginFailure.
+func (LoginFailure) Fields() []ent.Field {
+	return []ent.Field{
+		// Ключ счётчика: email:<почта> или ip:<адрес>
+		field.String("id").MaxLen(320).NotEmpty().Unique().Immutable(),
+		field.Int("count").Default(0),
+		field.Time("last_failed_at"),
+	}
+}
+
+// Edges of the LoginFailure.
+func (LoginFailure) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LoginFailure.
+func (LoginFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_failed_at"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PersonalToken holds the schema definition for the PersonalToken entity.
+type PersonalToken struct {
+	ent.Schema
+}
+
+// Fields of the PersonalToken.
+func (PersonalToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("name").MaxLen(100),
+		// SHA-256 токена в hex, сам токен показывается только при создании
+		field.String("token_hash").MaxLen(64).Unique(),
+		field.Strings("scopes"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PersonalToken.
+func (PersonalToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("personal_tokens").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PersonalToken.
+func (PersonalToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
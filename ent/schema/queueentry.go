@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QueueEntry holds the schema definition for the QueueEntry entity.
+type QueueEntry struct {
+	ent.Schema
+}
+
+// Fields of the QueueEntry.
+func (QueueEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Unique(),
+		field.String("node_id").MaxLen(255),
+		field.Int("abort_count").Default(0),
+		field.Time("joined_at").Default(time.Now),
+		// Время, когда узел игрока последний раз подтвердил, что поиск продолжается
+		field.Time("heartbeat_at").Default(time.Now),
+	}
+}
+
+// Edges of the QueueEntry.
+func (QueueEntry) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the QueueEntry.
+func (QueueEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("abort_count", "joined_at"),
+	}
+}
@@ -18,11 +18,24 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
-		field.String("email").MaxLen(100).Unique(),
+		// Длина адреса ограничена RFC 5321
+		field.String("email").MaxLen(254).Unique(),
 		field.String("name").MaxLen(255),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now),
 		field.String("password").MaxLen(255),
+		field.Bool("auto_queen").Default(true),
+		field.Int("abort_count").Default(0),
+		field.Bool("email_verified").Default(false),
+		// Секрет TOTP в base32. Пока totp_enabled ложно, подключение 2FA не подтверждено.
+		field.String("totp_secret").MaxLen(64).Optional().Nillable(),
+		field.Bool("totp_enabled").Default(false),
+		// Последний принятый шаг TOTP: код одного шага не принимается дважды
+		field.Int64("totp_last_step").Default(0),
+		// Бот играет через API и не допускается в очередь поиска людей
+		field.Bool("bot").Default(false),
+		// Роль определяет права на закрытые маршруты и попадает в claims access-токена
+		field.Enum("role").Values("user", "moderator", "admin").Default("user"),
 	}
 }
 
@@ -32,5 +45,12 @@ func (User) Edges() []ent.Edge {
 		edge.To("white_id", Chess.Type),
 		edge.To("black_id", Chess.Type),
 		edge.To("moves", GameHistory.Type),
+		edge.To("sessions", Session.Type),
+		edge.To("recovery_codes", RecoveryCode.Type),
+		edge.To("tokens", UserToken.Type),
+		edge.To("personal_tokens", PersonalToken.Type),
+		edge.To("challenges_sent", Challenge.Type),
+		edge.To("challenges_received", Challenge.Type),
+		edge.To("identities", Identity.Type),
 	}
 }
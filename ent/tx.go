@@ -12,12 +12,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Challenge is the client for interacting with the Challenge builders.
+	Challenge *ChallengeClient
 	// Chess is the client for interacting with the Chess builders.
 	Chess *ChessClient
 	// GameHistory is the client for interacting with the GameHistory builders.
 	GameHistory *GameHistoryClient
+	// GameLease is the client for interacting with the GameLease builders.
+	GameLease *GameLeaseClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// LoginFailure is the client for interacting with the LoginFailure builders.
+	LoginFailure *LoginFailureClient
+	// PersonalToken is the client for interacting with the PersonalToken builders.
+	PersonalToken *PersonalTokenClient
+	// QueueEntry is the client for interacting with the QueueEntry builders.
+	QueueEntry *QueueEntryClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserToken is the client for interacting with the UserToken builders.
+	UserToken *UserTokenClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +167,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Challenge = NewChallengeClient(tx.config)
 	tx.Chess = NewChessClient(tx.config)
 	tx.GameHistory = NewGameHistoryClient(tx.config)
+	tx.GameLease = NewGameLeaseClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
+	tx.LoginFailure = NewLoginFailureClient(tx.config)
+	tx.PersonalToken = NewPersonalTokenClient(tx.config)
+	tx.QueueEntry = NewQueueEntryClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserToken = NewUserTokenClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Chess.QueryXXX(), the query will be executed
+// applies a query, for example: Challenge.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -28,6 +28,22 @@ type User struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"password,omitempty"`
+	// AutoQueen holds the value of the "auto_queen" field.
+	AutoQueen bool `json:"auto_queen,omitempty"`
+	// AbortCount holds the value of the "abort_count" field.
+	AbortCount int `json:"abort_count,omitempty"`
+	// EmailVerified holds the value of the "email_verified" field.
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"totp_secret,omitempty"`
+	// TotpEnabled holds the value of the "totp_enabled" field.
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// Bot holds the value of the "bot" field.
+	Bot bool `json:"bot,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -42,9 +58,23 @@ type UserEdges struct {
 	BlackID []*Chess `json:"black_id,omitempty"`
 	// Moves holds the value of the moves edge.
 	Moves []*GameHistory `json:"moves,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// Tokens holds the value of the tokens edge.
+	Tokens []*UserToken `json:"tokens,omitempty"`
+	// PersonalTokens holds the value of the personal_tokens edge.
+	PersonalTokens []*PersonalToken `json:"personal_tokens,omitempty"`
+	// ChallengesSent holds the value of the challenges_sent edge.
+	ChallengesSent []*Challenge `json:"challenges_sent,omitempty"`
+	// ChallengesReceived holds the value of the challenges_received edge.
+	ChallengesReceived []*Challenge `json:"challenges_received,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*Identity `json:"identities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [10]bool
 }
 
 // WhiteIDOrErr returns the WhiteID value or an error if the edge
@@ -74,12 +104,79 @@ func (e UserEdges) MovesOrErr() ([]*GameHistory, error) {
 	return nil, &NotLoadedError{edge: "moves"}
 }
 
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[3] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[4] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// TokensOrErr returns the Tokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TokensOrErr() ([]*UserToken, error) {
+	if e.loadedTypes[5] {
+		return e.Tokens, nil
+	}
+	return nil, &NotLoadedError{edge: "tokens"}
+}
+
+// PersonalTokensOrErr returns the PersonalTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PersonalTokensOrErr() ([]*PersonalToken, error) {
+	if e.loadedTypes[6] {
+		return e.PersonalTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "personal_tokens"}
+}
+
+// ChallengesSentOrErr returns the ChallengesSent value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChallengesSentOrErr() ([]*Challenge, error) {
+	if e.loadedTypes[7] {
+		return e.ChallengesSent, nil
+	}
+	return nil, &NotLoadedError{edge: "challenges_sent"}
+}
+
+// ChallengesReceivedOrErr returns the ChallengesReceived value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChallengesReceivedOrErr() ([]*Challenge, error) {
+	if e.loadedTypes[8] {
+		return e.ChallengesReceived, nil
+	}
+	return nil, &NotLoadedError{edge: "challenges_received"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[9] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmail, user.FieldName, user.FieldPassword:
+		case user.FieldAutoQueen, user.FieldEmailVerified, user.FieldTotpEnabled, user.FieldBot:
+			values[i] = new(sql.NullBool)
+		case user.FieldAbortCount, user.FieldTotpLastStep:
+			values[i] = new(sql.NullInt64)
+		case user.FieldEmail, user.FieldName, user.FieldPassword, user.FieldTotpSecret, user.FieldRole:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -136,6 +233,55 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Password = value.String
 			}
+		case user.FieldAutoQueen:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_queen", values[i])
+			} else if value.Valid {
+				u.AutoQueen = value.Bool
+			}
+		case user.FieldAbortCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field abort_count", values[i])
+			} else if value.Valid {
+				u.AbortCount = int(value.Int64)
+			}
+		case user.FieldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
+			} else if value.Valid {
+				u.EmailVerified = value.Bool
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = new(string)
+				*u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				u.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = value.Int64
+			}
+		case user.FieldBot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bot", values[i])
+			} else if value.Valid {
+				u.Bot = value.Bool
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -164,6 +310,41 @@ func (u *User) QueryMoves() *GameHistoryQuery {
 	return NewUserClient(u.config).QueryMoves(u)
 }
 
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (u *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(u.config).QueryRecoveryCodes(u)
+}
+
+// QueryTokens queries the "tokens" edge of the User entity.
+func (u *User) QueryTokens() *UserTokenQuery {
+	return NewUserClient(u.config).QueryTokens(u)
+}
+
+// QueryPersonalTokens queries the "personal_tokens" edge of the User entity.
+func (u *User) QueryPersonalTokens() *PersonalTokenQuery {
+	return NewUserClient(u.config).QueryPersonalTokens(u)
+}
+
+// QueryChallengesSent queries the "challenges_sent" edge of the User entity.
+func (u *User) QueryChallengesSent() *ChallengeQuery {
+	return NewUserClient(u.config).QueryChallengesSent(u)
+}
+
+// QueryChallengesReceived queries the "challenges_received" edge of the User entity.
+func (u *User) QueryChallengesReceived() *ChallengeQuery {
+	return NewUserClient(u.config).QueryChallengesReceived(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *IdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -201,6 +382,32 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password=")
 	builder.WriteString(u.Password)
+	builder.WriteString(", ")
+	builder.WriteString("auto_queen=")
+	builder.WriteString(fmt.Sprintf("%v", u.AutoQueen))
+	builder.WriteString(", ")
+	builder.WriteString("abort_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.AbortCount))
+	builder.WriteString(", ")
+	builder.WriteString("email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", u.EmailVerified))
+	builder.WriteString(", ")
+	if v := u.TotpSecret; v != nil {
+		builder.WriteString("totp_secret=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("bot=")
+	builder.WriteString(fmt.Sprintf("%v", u.Bot))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -289,7 +289,7 @@ const getResultText = (result: string) => {
             return 'Победа белых!';
         case '0-1':
             return 'Победа черных!';
-        case '1-1':
+        case '1/2-1/2':
             return 'Ничья!';
         default:
             return 'Игра в процессе';
@@ -302,7 +302,7 @@ const getResultEmoji = (result: string) => {
             return '🏆';
         case '0-1':
             return '🏆';
-        case '1-1':
+        case '1/2-1/2':
             return '🤝';
         default:
             return '⚔️';
@@ -807,7 +807,7 @@ export const ChessBoard: React.FC = () => {
                                 setGameInfo({
                                     ...currentGameInfo,
                                     Status: 'finished',
-                                    Result: data.result || '1-1'
+                                    Result: data.result || '1/2-1/2'
                                 });
                             }
                         } else {
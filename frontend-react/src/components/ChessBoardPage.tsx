@@ -1120,7 +1120,7 @@ const ChessBoardPage: React.FC = () => {
                   customLightSquareStyle={{ backgroundColor: '#ebecd0' }}
                   showBoardNotation={true}
                 />
-                {gameResult && gameResult !== "0-0" && (
+                {gameResult && gameResult !== "*" && (
                   <NewGameButton 
                     onClick={startNewGame}
                     disabled={isSearching}
@@ -1151,7 +1151,7 @@ const ChessBoardPage: React.FC = () => {
                 </BlackPlayerInfo>
               )}
             </ChessBoardContainer>
-            {showResult && gameResult && gameResult !== "0-0" && (
+            {showResult && gameResult && gameResult !== "*" && (
               <div 
                 style={{ position: 'fixed', top: 40, left: '50%', transform: 'translateX(-50%)', zIndex: 2000, cursor: 'pointer' }}
                 onClick={handleResultClick}
@@ -12,6 +12,10 @@ type Config struct {
 	Database    dto.Database
 	Auth        dto.AuthConfig
 	Application dto.Application
+	Game        dto.GameConfig
+	Cluster     dto.Cluster
+	Mailer      dto.Mailer
+	OIDC        dto.OIDC
 }
 
 func MustLoad() *Config {
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"GopherChessParty/ent/usertoken"
+	"github.com/google/uuid"
+)
+
+// UserToken одноразовый токен из письма. Хранится только хэш, сам токен знает лишь получатель.
+type UserToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Purpose   usertoken.Purpose
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type EmailToken struct {
+	Token string `binding:"required" json:"token"`
+}
+
+type PasswordResetRequest struct {
+	Email string `binding:"required,email" json:"email"`
+}
+
+type PasswordReset struct {
+	Token    string `binding:"required"       json:"token"`
+	Password string `binding:"required,min=8" json:"password"`
+}
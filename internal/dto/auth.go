@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -12,3 +14,32 @@ type TokenPair struct {
 	AccessToken  string
 	RefreshToken string
 }
+
+// Session сессия входа. Refresh-токены сессии ротируются, действителен только последний из них.
+type Session struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"user_id"`
+	RefreshJTI uuid.UUID  `json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	UserAgent  string     `json:"user_agent"`
+	IP         string     `json:"ip"`
+	// Current отмечает сессию, которой выдан токен запроса
+	Current bool `json:"current"`
+}
+
+// Device устройство, с которого открыта или обновлена сессия
+type Device struct {
+	UserAgent string
+	IP        string
+}
+
+// LoginFailures неудачные попытки входа по почте или адресу. Счётчик сбрасывается, если с последней
+// неудачи прошло больше окна учёта.
+type LoginFailures struct {
+	Key          string
+	Count        int
+	LastFailedAt time.Time
+}
@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"github.com/google/uuid"
+)
+
+// Challenge вызов на партию конкретному игроку или боту
+type Challenge struct {
+	ID           uuid.UUID        `json:"id"`
+	ChallengerID uuid.UUID        `json:"challenger_id"`
+	DestUserID   uuid.UUID        `json:"dest_user_id"`
+	Color        challenge.Color  `json:"color"` // Цвет вызывающего
+	Status       challenge.Status `json:"status"`
+	GameID       *uuid.UUID       `json:"game_id"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+type CreateChallenge struct {
+	DestUserID uuid.UUID       `binding:"required"                           json:"dest_user_id"`
+	Color      challenge.Color `binding:"omitempty,oneof=white black random" json:"color"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+// Типы событий, которыми обмениваются узлы
+const (
+	EventConnect = "connect" // Игрок открыл сокет партии на узле, который ей не владеет
+	EventMessage = "message" // Сообщение из сокета партии на узле, который ей не владеет
+	EventDeliver = "deliver" // Сообщение для сокета игрока, открытого на другом узле
+	EventMatched = "matched" // Итог поиска соперника для сокета поиска, открытого на другом узле
+	EventAction  = "action"  // Действие бота в партии, пришедшее по REST на узел, который ей не владеет
+	EventUser    = "user"    // Событие для потоков событий пользователя на всех узлах
+)
+
+// Event событие шины между узлами
+type Event struct {
+	Type      string    `json:"type"`
+	Node      string    `json:"node"`
+	GameID    uuid.UUID `json:"game_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	AutoQueen bool      `json:"auto_queen,omitempty"`
+	Payload   []byte    `json:"payload,omitempty"`
+}
+
+// RemoteSocket сокет игрока, открытый на другом узле
+type RemoteSocket struct {
+	Node   string
+	GameID uuid.UUID
+}
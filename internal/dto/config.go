@@ -5,23 +5,62 @@ import (
 	"time"
 )
 
+// AuthConfig настройки токенов. Если задан KeysDir, токены подписываются ключами Ed25519 или RSA
+// из этого каталога и секреты не используются. Аккаунты с почтами из Admins получают роль
+// администратора при запуске.
 type AuthConfig struct {
 	SecretAccess  string        `yaml:"SecretAccess"  env:"SECRET_ACCESS"`
 	ExpAccess     time.Duration `yaml:"ExpAccess"     env:"EXP_ACCESS"     env-default:"1h"`
 	SecretRefresh string        `yaml:"SecretRefresh" env:"SECRET_REFRESH"`
 	ExpRefresh    time.Duration `yaml:"ExpRefresh"    env:"EXP_REFRESH"    env-default:"24h"`
+	KeysDir       string        `yaml:"KeysDir"       env:"AUTH_KEYS_DIR"`
+	Admins        []string      `yaml:"Admins"        env:"AUTH_ADMINS"                      env-separator:","`
 }
 
+// OIDCProvider внешний провайдер OpenID Connect. Документ discovery читается из
+// Issuer/.well-known/openid-configuration, RedirectURL — страница фронтенда, куда провайдер
+// возвращает код. Без Scopes запрашиваются openid, email и profile.
+type OIDCProvider struct {
+	Name         string   `yaml:"name"`
+	Issuer       string   `yaml:"issuer"`
+	ClientID     string   `yaml:"clientId"`
+	ClientSecret string   `yaml:"clientSecret"`
+	RedirectURL  string   `yaml:"redirectUrl"`
+	Scopes       []string `yaml:"scopes"`
+}
+
+// OIDC провайдеры входа OpenID Connect. Задаются только в файле конфига.
+type OIDC struct {
+	Providers []OIDCProvider `yaml:"providers"`
+}
+
+// Database настройки базы данных. Driver: postgres, sqlite или memory (без базы, для демо);
+// для sqlite используется файл Path.
 type Database struct {
-	Host         string        `env-default:"localhost" yaml:"dbHost"            env:"DB_HOST"`
-	Port         int           `env-default:"5432"      yaml:"dbPort"            env:"DB_PORT"`
-	User         string        `env-default:"postgres"  yaml:"dbUser"            env:"DB_USER"`
-	Password     string        `env-default:"postgres"  yaml:"dbPassword"        env:"DB_PASSWORD"`
-	Database     string        `env-default:"postgres"  yaml:"dbDatabase"        env:"DB_DATABASE"`
-	SSLMode      string        `env-default:"disable"   yaml:"dbSslmode"         env:"DB_SSLMODE"`
-	MaxOpenConns int           `env-default:"25"        yaml:"dbMaxOpenConnects" env:"DB_MAX_OPEN_CONNS"`
-	MaxIdleConns int           `env-default:"25"        yaml:"dbMaxIdleConns"    env:"DB_MAX_IDLE_CONNS"`
-	MaxTimeLife  time.Duration `env-default:"24h"       yaml:"dbMaxTimeLife"     env:"DB_MAX_TIME_LIFE"`
+	Driver       string        `env-default:"postgres"        yaml:"dbDriver"          env:"DB_DRIVER"`
+	Path         string        `env-default:"gopher_chess.db" yaml:"dbPath"            env:"DB_PATH"`
+	Host         string        `env-default:"localhost"       yaml:"dbHost"            env:"DB_HOST"`
+	Port         int           `env-default:"5432"            yaml:"dbPort"            env:"DB_PORT"`
+	User         string        `env-default:"postgres"        yaml:"dbUser"            env:"DB_USER"`
+	Password     string        `env-default:"postgres"        yaml:"dbPassword"        env:"DB_PASSWORD"`
+	Database     string        `env-default:"postgres"        yaml:"dbDatabase"        env:"DB_DATABASE"`
+	SSLMode      string        `env-default:"disable"         yaml:"dbSslmode"         env:"DB_SSLMODE"`
+	MaxOpenConns int           `env-default:"25"              yaml:"dbMaxOpenConnects" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns int           `env-default:"25"              yaml:"dbMaxIdleConns"    env:"DB_MAX_IDLE_CONNS"`
+	MaxTimeLife  time.Duration `env-default:"24h"             yaml:"dbMaxTimeLife"     env:"DB_MAX_TIME_LIFE"`
+	ReadTimeout  time.Duration `env-default:"3s"              yaml:"dbReadTimeout"     env:"DB_READ_TIMEOUT"`
+	WriteTimeout time.Duration `env-default:"5s"              yaml:"dbWriteTimeout"    env:"DB_WRITE_TIMEOUT"`
+	// Применять ожидающие миграции PostgreSQL при запуске
+	MigrateOnStart bool `env-default:"false"           yaml:"dbMigrateOnStart"  env:"DB_MIGRATE_ON_START"`
+}
+
+// SQLiteDSN строка подключения к файлу SQLite: WAL позволяет читать во время записи,
+// busy_timeout ждёт освобождения блокировки, immediate берёт блокировку записи в начале транзакции
+func (d *Database) SQLiteDSN() string {
+	return fmt.Sprintf(
+		"file:%s?_fk=1&_journal_mode=WAL&_synchronous=NORMAL&_busy_timeout=5000&_txlock=immediate",
+		d.Path,
+	)
 }
 
 func (d *Database) Url() string {
@@ -31,7 +70,48 @@ func (d *Database) Url() string {
 	)
 }
 
+type GameConfig struct {
+	TimeControl time.Duration `env-default:"10m" yaml:"timeControl" env:"GAME_TIME_CONTROL"`
+	Increment   time.Duration `env-default:"0s"  yaml:"increment"   env:"GAME_INCREMENT"`
+	MaxPremoves int           `env-default:"3"   yaml:"maxPremoves" env:"GAME_MAX_PREMOVES"`
+	// Время на первый ход каждой стороны, после которого партия прерывается
+	FirstMoveTimeout time.Duration `env-default:"30s" yaml:"firstMoveTimeout" env:"GAME_FIRST_MOVE_TIMEOUT"`
+	// Время без активности, после которого партия не продолжается после перезапуска
+	StaleAfter time.Duration `env-default:"10m" yaml:"staleAfter" env:"GAME_STALE_AFTER"`
+	// Завершённая партия остаётся в памяти, пока игроки получают итоговое состояние
+	FinishedTTL time.Duration `env-default:"30s" yaml:"finishedTtl" env:"GAME_FINISHED_TTL"`
+	// Предел числа партий в памяти, сверх которого выгружаются раньше всех завершённые партии
+	MaxGames int `env-default:"10000" yaml:"maxGames" env:"GAME_MAX_GAMES"`
+}
+
+// Cluster настройки работы нескольких узлов. Bus: memory — один узел, postgres — несколько узлов
+type Cluster struct {
+	NodeID   string        `                     yaml:"nodeId"   env:"NODE_ID"`
+	Bus      string        `env-default:"memory" yaml:"bus"      env:"CLUSTER_BUS"`
+	LeaseTTL time.Duration `env-default:"15s"    yaml:"leaseTtl" env:"CLUSTER_LEASE_TTL"`
+}
+
+// Mailer настройки писем. Driver: smtp, file (письма сохраняются в Dir) или log (письма пишутся
+// в лог). file и log оставляют токены из писем на диске и в логах, поэтому доступны только
+// с Env local. BaseURL — адрес фронтенда для ссылок в письмах.
+type Mailer struct {
+	Driver   string `env-default:"smtp"                                  yaml:"driver"   env:"MAIL_DRIVER"`
+	From     string `env-default:"GopherChessParty <no-reply@localhost>" yaml:"from"     env:"MAIL_FROM"`
+	Host     string `                                                    yaml:"host"     env:"SMTP_HOST"`
+	Port     int    `env-default:"587"                                   yaml:"port"     env:"SMTP_PORT"`
+	User     string `                                                    yaml:"user"     env:"SMTP_USER"`
+	Password string `                                                    yaml:"password" env:"SMTP_PASSWORD"`
+	Dir      string `env-default:"mail"                                  yaml:"dir"      env:"MAIL_DIR"`
+	BaseURL  string `env-default:"http://localhost:3000"                 yaml:"baseUrl"  env:"MAIL_BASE_URL"`
+}
+
+// Application настройки приложения. InternalNetworks — сети, из которых доступны служебные
+// эндпоинты /internal, по умолчанию только loopback. TrustedProxies — адреса и сети обратных
+// прокси, которым доверяется X-Forwarded-For; по умолчанию адрес клиента берётся из соединения.
+// Env по умолчанию prod, чтобы без явного ENV не включались послабления локального окружения.
 type Application struct {
-	Port int    `env-default:"8000"  yaml:"port"`
-	Env  string `env-default:"local" yaml:"env"  env:"ENV"`
+	Port             int      `env-default:"8000"                 yaml:"port"`
+	Env              string   `env-default:"prod"                 yaml:"env"              env:"ENV"`
+	InternalNetworks []string `env-default:"127.0.0.1/32,::1/128" yaml:"internalNetworks" env:"INTERNAL_NETWORKS" env-separator:","`
+	TrustedProxies   []string `                                   yaml:"trustedProxies"   env:"TRUSTED_PROXIES"   env-separator:","`
 }
@@ -10,13 +10,32 @@ import (
 type Player struct {
 	ID   uuid.UUID `json:"id"`
 	Name string    `json:"name"`
+	Bot  bool      `json:"bot"`
 }
 type GameHistory struct {
-	ID          uuid.UUID    `json:"id"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
-	Status      chess.Status `json:"status"`
-	Result      chess.Result `json:"result"`
-	BlackPlayer *Player      `json:"black_player"`
-	WhitePlayer *Player      `json:"white_player"`
+	ID          uuid.UUID          `json:"id"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+	Status      chess.Status       `json:"status"`
+	Result      chess.Result       `json:"result"`
+	Termination *chess.Termination `json:"termination"`
+	BlackPlayer *Player            `json:"black_player"`
+	WhitePlayer *Player            `json:"white_player"`
+}
+
+// GameOutcome итог партии: статус, результат и причина завершения
+type GameOutcome struct {
+	Status      chess.Status
+	Result      chess.Result
+	Termination chess.Termination
+}
+
+// GameStats партии в памяти узла
+type GameStats struct {
+	Node           string `json:"node"`
+	LiveGames      int    `json:"live_games"`   // Идущие партии
+	CachedGames    int    `json:"cached_games"` // Завершённые и загруженные для чтения партии
+	MaxGames       int    `json:"max_games"`
+	EvictedGames   uint64 `json:"evicted_games"`
+	HeapAllocBytes uint64 `json:"heap_alloc_bytes"`
 }
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Identity привязка аккаунта к пользователю внешнего провайдера OpenID Connect
+type Identity struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"-"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExternalIdentity пользователь провайдера из проверенного ID-токена
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// OIDCFlow незавершённый вход через провайдера. Хранится у клиента в подписанном flow-токене,
+// поэтому вход можно завершить на любом узле.
+type OIDCFlow struct {
+	Provider string
+	State    string
+	Nonce    string
+	// Verifier PKCE: провайдер выдаст токены только тому, кто знает его вместе с кодом
+	Verifier string
+}
+
+type OIDCStart struct {
+	AuthorizationURL string `json:"authorization_url"`
+	FlowToken        string `json:"flow_token"`
+}
+
+type OIDCCallback struct {
+	Code      string `binding:"required" json:"code"`
+	State     string `binding:"required" json:"state"`
+	FlowToken string `binding:"required" json:"flow_token"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+// JWK открытый ключ подписи токенов в формате RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JWKS набор открытых ключей, которыми проверяются токены
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
@@ -14,9 +14,46 @@ const (
 	BlackMotion
 )
 
+// Действия, которые игрок может отправить в сокет партии вместо хода
+const (
+	ActionPremove       = "premove"
+	ActionCancelPremove = "cancel_premove"
+	ActionClaimDraw     = "claim_draw"
+	ActionAbort         = "abort"
+	ActionResign        = "resign"
+	ActionOfferDraw     = "offer_draw" // Предложить ничью или принять предложение соперника
+	ActionDeclineDraw   = "decline_draw"
+	ActionCancelSearch  = "cancel"
+)
+
+// GameAction сообщение игрока в сокете партии
+type GameAction struct {
+	Action string `json:"action"`
+	Move   string `json:"move"`
+	Method string `json:"method"` // Способ ничьей для claim_draw
+}
+
+// QueueEntry игрок в очереди поиска соперника и узел, на котором открыт его сокет поиска
+type QueueEntry struct {
+	UserID      uuid.UUID `json:"id"`
+	Node        string    `json:"node_id"`
+	AbortCount  int       `json:"abort_count"`
+	JoinedAt    time.Time `json:"joined_at"`
+	HeartbeatAt time.Time `json:"heartbeat_at"` // Последнее подтверждение поиска узлом игрока
+}
+
 type PlayerConn struct {
-	UserID uuid.UUID
-	Conn   *websocket.Conn
+	UserID     uuid.UUID
+	Conn       *websocket.Conn
+	AutoQueen  bool          // Превращение в ферзя, если фигура не указана
+	AbortCount int           // Сколько партий игрок прервал
+	Remote     *RemoteSocket // Сокет игрока открыт на другом узле
+	Stream     *EventStream  // Вместо сокета бот читает партию из HTTP-потока
+}
+
+// Connected проверяет, что у игрока открыт сокет или поток на этом или другом узле
+func (player *PlayerConn) Connected() bool {
+	return player != nil && (player.Conn != nil || player.Stream != nil || player.Remote != nil)
 }
 
 type Move struct {
@@ -28,20 +65,64 @@ type Move struct {
 }
 
 type Match struct {
-	ID          uuid.UUID    `json:"id"           db:"id"`
-	CreatedAt   time.Time    `json:"created_at"   db:"created_at"`
-	Result      chess.Result `json:"result"       db:"result"`
-	Status      chess.Status `json:"status"       db:"status"`
-	WhiteUser   *GetUser     `json:"white_user"   db:"white_user"`
-	BlackUser   *GetUser     `json:"black_user"   db:"black_user"`
-	HistoryMove []*Move      `json:"history_move"`
+	ID          uuid.UUID          `json:"id"           db:"id"`
+	CreatedAt   time.Time          `json:"created_at"   db:"created_at"`
+	Result      chess.Result       `json:"result"       db:"result"`
+	Status      chess.Status       `json:"status"       db:"status"`
+	Termination *chess.Termination `json:"termination"  db:"termination"`
+	WhiteUser   *PublicUser        `json:"white_user"   db:"white_user"`
+	BlackUser   *PublicUser        `json:"black_user"   db:"black_user"`
+	HistoryMove []*Move            `json:"history_move"`
+}
+
+// Clock шахматные часы партии
+type Clock struct {
+	White      time.Duration
+	Black      time.Duration
+	Increment  time.Duration
+	LastMoveAt time.Time
+}
+
+func NewClock(timeControl, increment time.Duration) *Clock {
+	return &Clock{
+		White:     timeControl,
+		Black:     timeControl,
+		Increment: increment,
+	}
+}
+
+// Remaining возвращает оставшееся время стороны
+func (clock *Clock) Remaining(motion int) time.Duration {
+	if motion == WhiteMotion {
+		return clock.White
+	}
+	return clock.Black
+}
+
+// Charge списывает время хода и начисляет добавление.
+// Возвращает false, если у стороны упал флаг.
+func (clock *Clock) Charge(motion int, elapsed time.Duration, now time.Time) bool {
+	remaining := clock.Remaining(motion) - elapsed
+	if remaining <= 0 {
+		remaining = 0
+	} else {
+		remaining += clock.Increment
+	}
+	if motion == WhiteMotion {
+		clock.White = remaining
+	} else {
+		clock.Black = remaining
+	}
+	clock.LastMoveAt = now
+	return remaining > 0
 }
 
 type Game struct {
-	ID        uuid.UUID
-	CreatedAt time.Time
-	Result    chess.Result
-	Status    chess.Status
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	Result      chess.Result
+	Status      chess.Status
+	Termination *chess.Termination
 
 	Match         *chess2.Game
 	WhitePlayer   *PlayerConn
@@ -49,6 +130,11 @@ type Game struct {
 	CurrentMotion int
 	HistoryMove   []string
 	NumMove       int
+	Clock         *Clock
+	Premoves      map[uuid.UUID][]string // Предходы игроков, видны только владельцу
+	Resumed       bool                   // Партия восстановлена после перезапуска и ещё не продолжена
+	DrawOffer     uuid.UUID              // Игрок, предложивший ничью, uuid.Nil без предложения
+	FinishedAt    time.Time
 }
 
 func (game *Game) GetOpponentUser() *PlayerConn {
@@ -67,6 +153,25 @@ func (game *Game) GetCurrentUser() *PlayerConn {
 	}
 }
 
+// Player возвращает игрока партии по идентификатору
+func (game *Game) Player(userID uuid.UUID) *PlayerConn {
+	switch userID {
+	case game.WhitePlayer.UserID:
+		return game.WhitePlayer
+	case game.BlackPlayer.UserID:
+		return game.BlackPlayer
+	}
+	return nil
+}
+
+// OpponentOf возвращает соперника игрока
+func (game *Game) OpponentOf(userID uuid.UUID) *PlayerConn {
+	if game.WhitePlayer.UserID == userID {
+		return game.BlackPlayer
+	}
+	return game.WhitePlayer
+}
+
 func (game *Game) SetMove(move string) {
 	if game.CurrentMotion == WhiteMotion {
 		game.CurrentMotion = BlackMotion
@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// MigrationState миграция и её состояние в базе
+type MigrationState struct {
+	Version     string    `json:"version"`
+	Description string    `json:"description"`
+	Applied     bool      `json:"applied"`
+	ExecutedAt  time.Time `json:"executed_at"`
+}
+
+// MigrationStatus состояние схемы базы относительно миграций, встроенных в бинарник
+type MigrationStatus struct {
+	Current    string            `json:"current"`  // Последняя применённая версия
+	Expected   string            `json:"expected"` // Последняя встроенная версия
+	Migrations []*MigrationState `json:"migrations"`
+	Unknown    []string          `json:"unknown"` // Применённые версии, которых нет в бинарнике
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PersonalTokenPrefix начало персональных токенов, по нему они отличаются от JWT
+const PersonalTokenPrefix = "gcp_"
+
+// Scope право персонального токена
+type Scope string
+
+const (
+	ScopeGamesRead       Scope = "games:read"       // Чтение партий
+	ScopeBotPlay         Scope = "bot:play"         // Игра от имени бота
+	ScopeChallengesWrite Scope = "challenges:write" // Управление вызовами
+)
+
+// PersonalToken долгоживущий токен для ботов и скриптов. Хранится только хэш токена.
+type PersonalToken struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"-"`
+	Name       string     `json:"name"`
+	TokenHash  string     `json:"-"`
+	Scopes     []Scope    `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+// HasAnyScope проверяет, что у токена есть хотя бы одно из прав
+func (t *PersonalToken) HasAnyScope(scopes ...Scope) bool {
+	for _, scope := range scopes {
+		if slices.Contains(t.Scopes, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+type CreatePersonalToken struct {
+	Name   string  `binding:"required,max=100"                                               json:"name"`
+	Scopes []Scope `binding:"required,min=1,dive,oneof=games:read bot:play challenges:write" json:"scopes"`
+	// Срок действия в днях, 0 — бессрочный
+	ExpiresInDays int `binding:"min=0,max=365"                                                  json:"expires_in_days"`
+}
+
+// CreatedPersonalToken токен сразу после создания, единственный раз вместе с самим токеном
+type CreatedPersonalToken struct {
+	PersonalToken
+	Token string `json:"token"`
+}
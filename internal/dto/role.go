@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"slices"
+
+	"GopherChessParty/ent/user"
+)
+
+// Permission право роли на закрытые маршруты
+type Permission string
+
+const (
+	PermissionUsersList   Permission = "users:list"   // Список пользователей с почтой
+	PermissionUsersView   Permission = "users:view"   // Полный профиль любого пользователя
+	PermissionRolesManage Permission = "roles:manage" // Назначение ролей
+)
+
+// rolePermissions права ролей. Обычный пользователь прав не имеет.
+var rolePermissions = map[user.Role][]Permission{
+	user.RoleModerator: {PermissionUsersView},
+	user.RoleAdmin:     {PermissionUsersList, PermissionUsersView, PermissionRolesManage},
+}
+
+// RoleAllows проверяет, что у роли есть право
+func RoleAllows(role user.Role, permission Permission) bool {
+	return slices.Contains(rolePermissions[role], permission)
+}
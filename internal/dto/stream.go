@@ -0,0 +1,49 @@
+package dto
+
+import "sync"
+
+// streamBuffer сколько сообщений поток держит, пока клиент их не прочитал
+const streamBuffer = 64
+
+// EventStream поток событий по HTTP для ботов: сообщения копятся в буфере, пока обработчик запроса
+// не запишет их клиенту
+type EventStream struct {
+	messages chan []byte
+	done     chan struct{}
+	once     sync.Once
+}
+
+func NewEventStream() *EventStream {
+	return &EventStream{
+		messages: make(chan []byte, streamBuffer),
+		done:     make(chan struct{}),
+	}
+}
+
+// Send ставит сообщение в очередь, не блокируясь. Переполненный поток закрывается: клиент
+// переподключится и получит актуальное состояние.
+func (s *EventStream) Send(message []byte) bool {
+	select {
+	case <-s.done:
+		return false
+	case s.messages <- message:
+		return true
+	default:
+		s.Close()
+		return false
+	}
+}
+
+func (s *EventStream) Messages() <-chan []byte {
+	return s.messages
+}
+
+func (s *EventStream) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *EventStream) Close() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
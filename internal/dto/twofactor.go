@@ -0,0 +1,32 @@
+package dto
+
+// TOTP состояние двухфакторной аутентификации пользователя. Пустой Secret — 2FA не подключалась.
+type TOTP struct {
+	Secret   string
+	Enabled  bool
+	LastStep int64
+}
+
+// TOTPEnrollment данные для подключения приложения-аутентификатора. QRCode — PNG с ProvisioningURI
+// в виде data URI. Коды восстановления показываются один раз, в базе хранятся только их хэши.
+type TOTPEnrollment struct {
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioning_uri"`
+	QRCode          string   `json:"qr_payload"`
+	RecoveryCodes   []string `json:"recovery_codes"`
+}
+
+type TwoFactorCode struct {
+	Code string `binding:"required" json:"code"`
+}
+
+// TwoFactorLogin второй шаг входа: код TOTP или код восстановления
+type TwoFactorLogin struct {
+	ChallengeToken string `binding:"required" json:"challenge_token"`
+	Code           string `binding:"required" json:"code"`
+}
+
+type DisableTwoFactor struct {
+	Password string `binding:"required" json:"password"`
+	Code     string `binding:"required" json:"code"`
+}
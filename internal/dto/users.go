@@ -4,13 +4,14 @@ import (
 	"fmt"
 	"time"
 
+	"GopherChessParty/ent/user"
 	"github.com/google/uuid"
 )
 
 type CreateUser struct {
-	Name     string `binding:"required"       json:"name"`
-	Email    string `binding:"required,email" json:"email"`
-	Password string `binding:"required,min=8" json:"password"`
+	Name     string `binding:"required"               json:"name"`
+	Email    string `binding:"required,email,max=254" json:"email"`
+	Password string `binding:"required,min=8"         json:"password"`
 }
 
 type AuthenticateUser struct {
@@ -22,14 +23,41 @@ type GetUser struct {
 	ID    uuid.UUID `json:"id"    db:"id"`
 	Name  string    `json:"name"  db:"name"`
 	Email string    `json:"email" db:"email"`
+	Bot   bool      `json:"bot"   db:"bot"`
 }
 
 type User struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	Email         string    `json:"email"`
+	AutoQueen     bool      `json:"auto_queen"`
+	AbortCount    int       `json:"abort_count"`
+	EmailVerified bool      `json:"email_verified"`
+	Bot           bool      `json:"bot"`
+	Role          user.Role `json:"role"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// PublicUser публичный профиль пользователя без почты и настроек
+type PublicUser struct {
 	ID        uuid.UUID `json:"id"`
 	Name      string    `json:"name"`
-	Email     string    `json:"email"`
+	Bot       bool      `json:"bot"`
 	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Public возвращает публичный профиль пользователя
+func (u User) Public() PublicUser {
+	return PublicUser{ID: u.ID, Name: u.Name, Bot: u.Bot, CreatedAt: u.CreatedAt}
+}
+
+type SetUserRole struct {
+	Role user.Role `binding:"required,oneof=user moderator admin" json:"role"`
+}
+
+type UserPreferences struct {
+	AutoQueen *bool `binding:"required" json:"auto_queen"`
 }
 
 func (u User) String() string {
@@ -3,6 +3,28 @@ package errors
 import "errors"
 
 var (
-	ErrValidateToken = errors.New("unexpected signing method")
-	ErrUserNotFound  = errors.New("user not found")
+	ErrValidateToken         = errors.New("unexpected signing method")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrEmailTaken            = errors.New("email already registered")
+	ErrSessionNotFound       = errors.New("session not found")
+	ErrRefreshReused         = errors.New("refresh token reused, session revoked")
+	ErrSessionEnded          = errors.New("session expired or signed out")
+	ErrNoSigningKey          = errors.New("no active signing key")
+	ErrUnknownKey            = errors.New("unknown signing key")
+	ErrInvalidPassword       = errors.New("invalid password")
+	ErrTOTPNotEnabled        = errors.New("two-factor authentication is not enabled")
+	ErrTOTPEnabled           = errors.New("two-factor authentication is already enabled")
+	ErrInvalidOTP            = errors.New("invalid one-time code")
+	ErrTokenInvalid          = errors.New("token is invalid, used or expired")
+	ErrEmailVerified         = errors.New("email already verified")
+	ErrEmailUnverified       = errors.New("email is not verified")
+	ErrBadCredentials        = errors.New("invalid email or password")
+	ErrBadSecondFactor       = errors.New("invalid password or one-time code")
+	ErrLoginThrottled        = errors.New("too many failed login attempts, try again later")
+	ErrChallengeExhausted    = errors.New("too many invalid codes, sign in again")
+	ErrPersonalTokenNotFound = errors.New("personal token not found")
+	ErrScopeDenied           = errors.New("token lacks the scope required for this route")
+	ErrPermissionDenied      = errors.New("role lacks the permission required for this route")
+	ErrOwnRole               = errors.New("cannot change own role")
+	ErrNetworkDenied         = errors.New("route is not available from this address")
 )
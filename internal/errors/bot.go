@@ -0,0 +1,13 @@
+package errors
+
+import "errors"
+
+var (
+	ErrNotBot            = errors.New("account is not a bot")
+	ErrAlreadyBot        = errors.New("account is already a bot")
+	ErrBotHasGames       = errors.New("account with played games cannot become a bot")
+	ErrBotSearch         = errors.New("bots cannot join the search pool")
+	ErrChallengeNotFound = errors.New("challenge not found")
+	ErrChallengeSelf     = errors.New("cannot challenge yourself")
+	ErrTooManyChallenges = errors.New("too many pending challenges")
+)
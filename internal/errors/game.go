@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 var (
 	ErrGameEnd           = errors.New("game is over")
@@ -10,4 +13,37 @@ var (
 	ErrInvalidMove       = errors.New("invalid move")
 	ErrPlayersNotConn    = errors.New("players not connected")
 	ErrPlayerNotFound    = errors.New("player not found")
+	ErrTimeOut           = errors.New("time is over")
+	ErrPremoveQueueFull  = errors.New("premove queue is full")
+	ErrPremoveIllegal    = errors.New("premove is illegal")
+	ErrUnknownAction     = errors.New("unknown action")
+	ErrMalformedMove     = errors.New("malformed move")
+	ErrAmbiguousMove     = errors.New("ambiguous move")
+	ErrGameNotActive     = errors.New("game is not in progress")
+	ErrDrawNotEligible   = errors.New("draw cannot be claimed")
+	ErrAbortNotAllowed   = errors.New("game can no longer be aborted")
+	ErrNoDrawOffer       = errors.New("no draw offer to decline")
 )
+
+// MoveRejectReason возвращает код причины отклонения хода для клиента
+func MoveRejectReason(err error) string {
+	switch {
+	case errors.Is(err, ErrInvalidMove):
+		return "illegal"
+	case errors.Is(err, ErrCurrentUserMotion):
+		return "wrong_turn"
+	case errors.Is(err, ErrAmbiguousMove):
+		return "ambiguous"
+	case errors.Is(err, ErrMalformedMove):
+		return "malformed"
+	case errors.Is(err, ErrGameNotActive), errors.Is(err, ErrGameEnd):
+		return "game_over"
+	case errors.Is(err, ErrTimeOut):
+		return "timeout"
+	case errors.Is(err, ErrPlayersNotConn):
+		return "players_not_connected"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "db_timeout"
+	}
+	return "internal"
+}
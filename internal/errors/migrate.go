@@ -0,0 +1,10 @@
+package errors
+
+import "errors"
+
+var (
+	ErrSchemaAhead    = errors.New("database schema is ahead of the binary")
+	ErrSchemaDiverged = errors.New("database schema diverges from embedded migrations")
+	ErrSchemaBehind   = errors.New("database schema has pending migrations")
+	ErrNoRollback     = errors.New("migration has no rollback")
+)
@@ -0,0 +1,15 @@
+package errors
+
+import "errors"
+
+var (
+	ErrProviderNotFound    = errors.New("identity provider not found")
+	ErrProviderUnavailable = errors.New("identity provider is unavailable")
+	ErrOIDCState           = errors.New("login state mismatch or expired")
+	ErrOIDCExchange        = errors.New("authorization code exchange failed")
+	ErrOIDCToken           = errors.New("invalid id token")
+	ErrOIDCEmailUnverified = errors.New("identity provider has not verified the email")
+	ErrOIDCEmailTooLong    = errors.New("identity provider email is too long")
+	ErrIdentityNotFound    = errors.New("identity not found")
+	ErrIdentityTaken       = errors.New("identity is already linked")
+)
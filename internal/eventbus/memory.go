@@ -0,0 +1,77 @@
+package eventbus
+
+import (
+	"sync"
+
+	"GopherChessParty/internal/interfaces"
+)
+
+// subscriptionBuffer размер очереди событий одного подписчика
+const subscriptionBuffer = 256
+
+// MemoryBus шина событий в памяти процесса для работы на одном узле
+type MemoryBus struct {
+	log           interfaces.ILogger
+	subscriptions map[string][]chan []byte
+	mu            sync.RWMutex
+	closed        bool
+}
+
+func NewMemoryBus(log interfaces.ILogger) interfaces.IEventBus {
+	return &MemoryBus{
+		log:           log,
+		subscriptions: make(map[string][]chan []byte),
+	}
+}
+
+// Publish раздаёт событие подписчикам канала, не дожидаясь их. Если очередь подписчика заполнена,
+// событие ему не доставляется, как и уведомление PostgreSQL потерявшему соединение узлу: иначе
+// медленный обработчик или обработчик, публикующий в свой же канал, остановил бы всю шину.
+func (b *MemoryBus) Publish(channel string, payload []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return ErrBusClosed
+	}
+	for _, subscription := range b.subscriptions[channel] {
+		select {
+		case subscription <- payload:
+		default:
+			b.log.ErrorWithMsg(channel, ErrSubscriptionFull)
+		}
+	}
+	return nil
+}
+
+// Subscribe регистрирует обработчик канала. События одного подписчика обрабатываются по порядку
+// в отдельной горутине.
+func (b *MemoryBus) Subscribe(channel string, handler func(payload []byte)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrBusClosed
+	}
+	subscription := make(chan []byte, subscriptionBuffer)
+	b.subscriptions[channel] = append(b.subscriptions[channel], subscription)
+	go func() {
+		for payload := range subscription {
+			handler(payload)
+		}
+	}()
+	return nil
+}
+
+func (b *MemoryBus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	for _, subscriptions := range b.subscriptions {
+		for _, subscription := range subscriptions {
+			close(subscription)
+		}
+	}
+	return nil
+}
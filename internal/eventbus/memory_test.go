@@ -0,0 +1,116 @@
+package eventbus_test
+
+import (
+	exc "errors"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/eventbus"
+	"GopherChessParty/internal/logger"
+)
+
+func newBus() *eventbus.MemoryBus {
+	return eventbus.NewMemoryBus(logger.New(dto.Application{Env: "prod"})).(*eventbus.MemoryBus)
+}
+
+// receive ждёт событие из канала обработчика
+func receive(t *testing.T, events <-chan string) string {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("event was not delivered")
+		return ""
+	}
+}
+
+func TestMemoryBusDelivery(t *testing.T) {
+	bus := newBus()
+	defer bus.Close()
+
+	first, second, other := make(chan string, 8), make(chan string, 8), make(chan string, 8)
+	for channel, events := range map[string]chan string{"game": first, "other": other} {
+		if err := bus.Subscribe(channel, func(payload []byte) { events <- string(payload) }); err != nil {
+			t.Fatalf("Subscribe: %v", err)
+		}
+	}
+	if err := bus.Subscribe("game", func(payload []byte) { second <- string(payload) }); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	for _, payload := range []string{"e4", "e5"} {
+		if err := bus.Publish("game", []byte(payload)); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+	for _, events := range []chan string{first, second} {
+		if got := receive(t, events) + " " + receive(t, events); got != "e4 e5" {
+			t.Fatalf("events = %q, want in publish order", got)
+		}
+	}
+	select {
+	case event := <-other:
+		t.Fatalf("event %q delivered to another channel", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMemoryBusSlowSubscriber(t *testing.T) {
+	bus := newBus()
+	release := make(chan struct{})
+	delivered := make(chan string, 1024)
+	// Обработчик публикует в свой же канал и висит, пока его не отпустят
+	err := bus.Subscribe("game", func(payload []byte) {
+		_ = bus.Publish("game", payload)
+		<-release
+		delivered <- string(payload)
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 1000 {
+			_ = bus.Publish("game", []byte("move"))
+		}
+		_ = bus.Subscribe("other", func([]byte) {})
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Publish blocked on a slow subscriber")
+	}
+
+	close(release)
+	receive(t, delivered)
+	closed := make(chan struct{})
+	go func() {
+		_ = bus.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close blocked on a slow subscriber")
+	}
+}
+
+func TestMemoryBusClosed(t *testing.T) {
+	bus := newBus()
+	if err := bus.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+	if err := bus.Publish("game", nil); !exc.Is(err, eventbus.ErrBusClosed) {
+		t.Fatalf("Publish error = %v, want %v", err, eventbus.ErrBusClosed)
+	}
+	if err := bus.Subscribe("game", func([]byte) {}); !exc.Is(err, eventbus.ErrBusClosed) {
+		t.Fatalf("Subscribe error = %v, want %v", err, eventbus.ErrBusClosed)
+	}
+}
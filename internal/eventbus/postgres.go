@@ -0,0 +1,111 @@
+package eventbus
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"github.com/lib/pq"
+)
+
+const (
+	minReconnectInterval = 10 * time.Second
+	maxReconnectInterval = time.Minute
+	// maxPayloadSize ограничение PostgreSQL на размер уведомления
+	maxPayloadSize = 8000
+)
+
+var (
+	ErrBusClosed        = errors.New("event bus is closed")
+	ErrPayloadTooLarge  = errors.New("event payload is too large")
+	ErrSubscriptionFull = errors.New("event subscription is full, event dropped")
+)
+
+// PostgresBus шина событий между узлами на основе LISTEN/NOTIFY
+type PostgresBus struct {
+	log      interfaces.ILogger
+	db       *sql.DB
+	listener *pq.Listener
+	handlers map[string][]func(payload []byte)
+	mu       sync.RWMutex
+}
+
+// MustNewPostgresBus Создание шины событий поверх PostgreSQL.
+func MustNewPostgresBus(log interfaces.ILogger, cfg dto.Database) interfaces.IEventBus {
+	db, err := sql.Open("postgres", cfg.Url())
+	if err != nil {
+		panic(err)
+	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+
+	listener := pq.NewListener(
+		cfg.Url(),
+		minReconnectInterval,
+		maxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				log.ErrorWithMsg("event bus listener", err)
+			}
+		},
+	)
+	bus := &PostgresBus{
+		log:      log,
+		db:       db,
+		listener: listener,
+		handlers: make(map[string][]func(payload []byte)),
+	}
+	go bus.dispatch()
+	return bus
+}
+
+func (b *PostgresBus) Publish(channel string, payload []byte) error {
+	if len(payload) > maxPayloadSize {
+		b.log.Error(ErrPayloadTooLarge)
+		return ErrPayloadTooLarge
+	}
+	_, err := b.db.Exec("SELECT pg_notify($1, $2)", channel, string(payload))
+	if err != nil {
+		b.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (b *PostgresBus) Subscribe(channel string, handler func(payload []byte)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.handlers[channel]; !ok {
+		if err := b.listener.Listen(channel); err != nil {
+			b.log.Error(err)
+			return err
+		}
+	}
+	b.handlers[channel] = append(b.handlers[channel], handler)
+	return nil
+}
+
+func (b *PostgresBus) Close() error {
+	if err := b.listener.Close(); err != nil {
+		return err
+	}
+	return b.db.Close()
+}
+
+// dispatch передаёт уведомления обработчикам канала
+func (b *PostgresBus) dispatch() {
+	for notification := range b.listener.Notify {
+		// nil приходит после переподключения: пропущенные уведомления потеряны
+		if notification == nil {
+			continue
+		}
+		b.mu.RLock()
+		handlers := b.handlers[notification.Channel]
+		b.mu.RUnlock()
+		for _, handler := range handlers {
+			handler([]byte(notification.Extra))
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IAccountService interface {
+	SendVerification(ctx context.Context, user *dto.User) error
+	SendPasswordReset(ctx context.Context, user *dto.User) error
+	SendLockoutNotice(user *dto.User, ip string)
+	ConsumeVerification(ctx context.Context, token string) (uuid.UUID, error)
+	ConsumePasswordReset(ctx context.Context, token string) (uuid.UUID, error)
+}
@@ -1,16 +1,38 @@
 package interfaces
 
 import (
+	"context"
+
 	"GopherChessParty/internal/dto"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 )
 
 type IAuthService interface {
-	GenerateTokenPair(userId uuid.UUID) (*dto.TokenPair, error)
-	RefreshAccessToken(refreshToken string) (*dto.TokenPair, error)
+	GenerateTokenPair(
+		ctx context.Context,
+		userId uuid.UUID,
+		device dto.Device,
+	) (*dto.TokenPair, error)
+	RefreshAccessToken(
+		ctx context.Context,
+		refreshToken string,
+		device dto.Device,
+	) (*dto.TokenPair, error)
+	GenerateChallenge(userId uuid.UUID) (string, error)
+	ValidateChallenge(challenge string) (uuid.UUID, uuid.UUID, error)
+	GenerateOIDCFlow(flow *dto.OIDCFlow) (string, error)
+	ValidateOIDCFlow(flowToken string) (*dto.OIDCFlow, error)
+	Logout(ctx context.Context, sessionID uuid.UUID) error
+	LogoutAll(ctx context.Context, userID uuid.UUID) error
+	Sessions(ctx context.Context, userID, currentID uuid.UUID) ([]*dto.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	IsSessionRevoked(sessionID uuid.UUID) bool
+	SyncRevocations(ctx context.Context) error
 	ValidateAccess(access string) (*jwt.Token, error)
 	ValidateRefresh(refresh string) (*jwt.Token, error)
+	JWKS() *dto.JWKS
+	ReloadKeys() error
 	GeneratePassword(rawPassword string) (string, error)
 	IsValidPassword(hashedPassword string, plainPassword string) bool
 }
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IChallengeRepo interface {
+	CreateChallenge(ctx context.Context, challenge *dto.Challenge) error
+	Challenge(ctx context.Context, challengeID uuid.UUID) (*dto.Challenge, error)
+	PendingChallenges(
+		ctx context.Context,
+		userID uuid.UUID,
+		since time.Time,
+	) ([]*dto.Challenge, error)
+	FinishChallenge(ctx context.Context, challengeID uuid.UUID, status challenge.Status) error
+	SetChallengeGame(ctx context.Context, challengeID, gameID uuid.UUID) error
+}
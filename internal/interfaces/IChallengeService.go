@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IChallengeService interface {
+	CreateChallenge(
+		ctx context.Context,
+		challengerID uuid.UUID,
+		data *dto.CreateChallenge,
+	) (*dto.Challenge, error)
+	PendingChallenges(ctx context.Context, userID uuid.UUID) ([]*dto.Challenge, error)
+	Challenge(ctx context.Context, challengeID uuid.UUID) (*dto.Challenge, error)
+	AnswerChallenge(
+		ctx context.Context,
+		userID, challengeID uuid.UUID,
+		status challenge.Status,
+	) (*dto.Challenge, error)
+	SetChallengeGame(ctx context.Context, challengeID, gameID uuid.UUID) error
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IClusterService interface {
+	NodeID() string
+	AcquireGame(ctx context.Context, GameID uuid.UUID) bool
+	OwnsGame(GameID uuid.UUID) bool
+	ReleaseGame(ctx context.Context, GameID uuid.UUID)
+	PublishEvent(event *dto.Event) error
+	SubscribeEvents(handler func(event *dto.Event)) error
+}
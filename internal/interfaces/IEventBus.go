@@ -0,0 +1,7 @@
+package interfaces
+
+type IEventBus interface {
+	Publish(channel string, payload []byte) error
+	Subscribe(channel string, handler func(payload []byte)) error
+	Close() error
+}
@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"context"
+
 	"GopherChessParty/ent"
 	"GopherChessParty/ent/chess"
 	"GopherChessParty/internal/dto"
@@ -8,10 +10,18 @@ import (
 )
 
 type IGameRepo interface {
-	Games(userID uuid.UUID) ([]*dto.GameHistory, error)
-	Create(playerID1, playerID2 uuid.UUID) (*ent.Chess, error)
-	GameById(gameId uuid.UUID) (*dto.Match, error)
-	Status(GameID uuid.UUID) chess.Status
-	UpdateGame(GameId uuid.UUID, status chess.Status, result chess.Result) error
-	SaveMove(GameID uuid.UUID, move string, UserID uuid.UUID, numMove int) (*ent.GameHistory, error)
+	Games(ctx context.Context, userID uuid.UUID) ([]*dto.GameHistory, error)
+	Create(ctx context.Context, playerID1, playerID2 uuid.UUID) (*ent.Chess, error)
+	GameById(ctx context.Context, gameId uuid.UUID) (*dto.Match, error)
+	InProgressGames(ctx context.Context) ([]uuid.UUID, error)
+	Status(ctx context.Context, GameID uuid.UUID) chess.Status
+	UpdateGame(ctx context.Context, GameID uuid.UUID, outcome *dto.GameOutcome) error
+	SaveMove(
+		ctx context.Context,
+		GameID uuid.UUID,
+		move string,
+		UserID uuid.UUID,
+		numMove int,
+		outcome *dto.GameOutcome,
+	) error
 }
@@ -1,20 +1,45 @@
 package interfaces
 
 import (
+	"context"
+
 	"GopherChessParty/ent"
 	"GopherChessParty/internal/dto"
 	"github.com/google/uuid"
 )
 
 type IGameService interface {
-	GamesByUserID(userID uuid.UUID) ([]*dto.GameHistory, error)
-	CreateGame(playerID1, playerID2 uuid.UUID) (*ent.Chess, error)
-	GameByID(gameID uuid.UUID) (*dto.Match, error)
-	MoveGame(GameID uuid.UUID, move string, player *dto.PlayerConn) error
-	SetPlayer(GameID uuid.UUID, player *dto.PlayerConn) error
+	GamesByUserID(ctx context.Context, userID uuid.UUID) ([]*dto.GameHistory, error)
+	CreateGame(ctx context.Context, playerID1, playerID2 uuid.UUID) (*ent.Chess, error)
+	GameByID(ctx context.Context, gameID uuid.UUID) (*dto.Match, error)
+	MoveGame(ctx context.Context, GameID uuid.UUID, move string, player *dto.PlayerConn) error
+	SetPlayer(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error
 	IsConnectPlayers(GameID uuid.UUID) bool
 	Opponent(gameID uuid.UUID) *dto.PlayerConn
 	GameMemory(GameID uuid.UUID) *dto.Game
-	MoveValid(GameID uuid.UUID, move string) error
-	GameDB(gameID uuid.UUID) (*dto.Game, error)
+	NormalizeMove(
+		ctx context.Context,
+		GameID uuid.UUID,
+		move string,
+		autoQueen bool,
+	) (string, error)
+	GameDB(ctx context.Context, gameID uuid.UUID) (*dto.Game, error)
+	AddPremove(ctx context.Context, GameID uuid.UUID, move string, player *dto.PlayerConn) error
+	CancelPremoves(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error
+	Premoves(GameID uuid.UUID, userID uuid.UUID) []string
+	PlayPremove(ctx context.Context, GameID uuid.UUID) (string, *dto.PlayerConn, error)
+	EligibleDraws(GameID uuid.UUID) []string
+	ClaimDraw(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn, method string) error
+	FlagExpired() []uuid.UUID
+	Abort(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error
+	Resign(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error
+	OfferDraw(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) (bool, error)
+	DeclineDraw(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error
+	AbortUnstarted() []uuid.UUID
+	ActiveGames() []uuid.UUID
+	DropGame(GameID uuid.UUID)
+	InProgressGames(ctx context.Context) ([]uuid.UUID, error)
+	RecoverGame(ctx context.Context, GameID uuid.UUID) error
+	EvictGames() []uuid.UUID
+	GameStats() *dto.GameStats
 }
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+)
+
+type IIdentityProvider interface {
+	// Name возвращает имя провайдера из конфига
+	Name() string
+	// AuthCodeURL возвращает адрес страницы входа провайдера
+	AuthCodeURL(ctx context.Context, flow *dto.OIDCFlow) (string, error)
+	// Exchange обменивает код авторизации на проверенного пользователя провайдера
+	Exchange(ctx context.Context, code string, flow *dto.OIDCFlow) (*dto.ExternalIdentity, error)
+}
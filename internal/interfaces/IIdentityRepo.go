@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IIdentityRepo interface {
+	CreateIdentity(ctx context.Context, identity *dto.Identity) error
+	CreateUserWithIdentity(
+		ctx context.Context,
+		user *dto.CreateUser,
+		identity *dto.Identity,
+	) (*dto.User, error)
+	IdentityUser(ctx context.Context, provider, subject string) (uuid.UUID, error)
+	Identities(ctx context.Context, userID uuid.UUID) ([]*dto.Identity, error)
+}
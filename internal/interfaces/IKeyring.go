@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"crypto"
+
+	"GopherChessParty/internal/dto"
+	"github.com/golang-jwt/jwt"
+)
+
+type IKeyring interface {
+	// SigningKey возвращает ключ, которым подписываются новые токены
+	SigningKey() (kid string, method jwt.SigningMethod, key crypto.PrivateKey, err error)
+	// VerificationKey возвращает открытый ключ kid и алгоритм его подписи
+	VerificationKey(kid string) (jwt.SigningMethod, crypto.PublicKey, bool)
+	// JWKS возвращает открытые ключи для проверки токенов другими сервисами
+	JWKS() *dto.JWKS
+	// Reload перечитывает каталог ключей
+	Reload() error
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ILeaseRepo interface {
+	Acquire(ctx context.Context, GameID uuid.UUID, nodeID string, expiresAt time.Time) (bool, error)
+	Release(ctx context.Context, GameID uuid.UUID, nodeID string) error
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/internal/dto"
+)
+
+type ILoginFailureRepo interface {
+	LoginFailures(ctx context.Context, keys ...string) ([]*dto.LoginFailures, error)
+	AddLoginFailure(
+		ctx context.Context,
+		key string,
+		now time.Time,
+		window time.Duration,
+	) (*dto.LoginFailures, error)
+	ClearLoginFailures(ctx context.Context, key string) error
+	PruneLoginFailures(ctx context.Context, before time.Time) error
+}
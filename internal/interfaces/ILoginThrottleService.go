@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ILoginThrottleService interface {
+	LoginAttempt(ctx context.Context, email, ip string) (int, time.Duration, error)
+	LoginFailed(ctx context.Context, attempt int, email, ip string) (bool, error)
+	LoginSucceeded(ctx context.Context, email string) error
+	TwoFactorAttempt(ctx context.Context, userID, challengeID uuid.UUID) (time.Duration, error)
+	TwoFactorCodeAttempt(ctx context.Context, userID uuid.UUID) (time.Duration, error)
+	TwoFactorSucceeded(ctx context.Context, userID uuid.UUID) error
+	PruneLoginFailures(ctx context.Context) error
+}
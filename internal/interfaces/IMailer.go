@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+)
+
+type IMailer interface {
+	Send(ctx context.Context, mail *dto.Mail) error
+}
@@ -1,18 +1,22 @@
 package interfaces
 
 import (
+	"context"
+
 	"GopherChessParty/internal/dto"
 	"github.com/google/uuid"
 )
 
 type IMatchService interface {
-	CheckPair() bool
+	CheckPair(ctx context.Context) bool
 	ExistsChannel() <-chan struct{}
-	AddUser(player *dto.PlayerConn) error
-	ReturnPlayers() (player1, player2 *dto.PlayerConn)
-	SendGemID(player *dto.PlayerConn, gameID uuid.UUID) error
+	AddUser(ctx context.Context, player *dto.PlayerConn) error
+	ReturnPlayers(ctx context.Context) (entry1, entry2 *dto.QueueEntry)
+	FinishSearch(userID uuid.UUID, message map[string]interface{}) bool
 	CloseConnection(player *dto.PlayerConn) error
 	SendMove(player *dto.PlayerConn, move string) error
 	SendMessage(player *dto.PlayerConn, message map[string]interface{}) error
-	ExitPlayerAdd(playerID uuid.UUID)
+	RemovePlayer(ctx context.Context, player *dto.PlayerConn) bool
+	NotifyQueue(ctx context.Context)
+	DrainSearch(ctx context.Context, message map[string]interface{})
 }
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IOIDCService interface {
+	OIDCProviders() []string
+	StartOIDC(ctx context.Context, provider string) (*dto.OIDCFlow, string, error)
+	ExchangeOIDC(
+		ctx context.Context,
+		code string,
+		flow *dto.OIDCFlow,
+	) (*dto.ExternalIdentity, error)
+	IdentityUser(ctx context.Context, provider, subject string) (uuid.UUID, error)
+	LinkIdentity(ctx context.Context, userID uuid.UUID, identity *dto.ExternalIdentity) error
+	CreateLinkedUser(
+		ctx context.Context,
+		data *dto.CreateUser,
+		identity *dto.ExternalIdentity,
+	) (*dto.User, error)
+	Identities(ctx context.Context, userID uuid.UUID) ([]*dto.Identity, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IPersonalTokenRepo interface {
+	CreatePersonalToken(ctx context.Context, token *dto.PersonalToken) error
+	PersonalTokens(ctx context.Context, userID uuid.UUID) ([]*dto.PersonalToken, error)
+	PersonalTokenByHash(ctx context.Context, hash string) (*dto.PersonalToken, error)
+	TouchPersonalToken(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error
+	RevokePersonalToken(ctx context.Context, userID, tokenID uuid.UUID) error
+	RevokePersonalTokens(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IPersonalTokenService interface {
+	CreatePersonalToken(
+		ctx context.Context,
+		userID uuid.UUID,
+		data *dto.CreatePersonalToken,
+	) (*dto.CreatedPersonalToken, error)
+	PersonalTokens(ctx context.Context, userID uuid.UUID) ([]*dto.PersonalToken, error)
+	RevokePersonalToken(ctx context.Context, userID, tokenID uuid.UUID) error
+	RevokePersonalTokens(ctx context.Context, userID uuid.UUID) error
+	AuthenticatePersonalToken(ctx context.Context, token string) (*dto.PersonalToken, error)
+}
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IQueueRepo interface {
+	Enqueue(ctx context.Context, entry *dto.QueueEntry) error
+	Remove(ctx context.Context, userID uuid.UUID, nodeID string) (bool, error)
+	Heartbeat(ctx context.Context, nodeID string, at time.Time) error
+	PruneStale(ctx context.Context, before time.Time) (int, error)
+	PopPair(ctx context.Context) (*dto.QueueEntry, *dto.QueueEntry, error)
+	Entries(ctx context.Context) ([]*dto.QueueEntry, error)
+	Len(ctx context.Context) (int, error)
+}
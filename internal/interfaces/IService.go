@@ -1,6 +1,10 @@
 package interfaces
 
 import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent/user"
 	"GopherChessParty/internal/dto"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
@@ -11,13 +15,104 @@ type IService interface {
 	IGameService
 	IAuthService
 	IMatchService
-	CreateUser(data *dto.CreateUser) (*dto.User, error)
-	ValidPassword(data dto.AuthenticateUser) (*uuid.UUID, bool)
+	IClusterService
+	ITwoFactorService
+	IAccountService
+	ILoginThrottleService
+	IPersonalTokenService
+	IChallengeService
+	IOIDCService
+	CreateUser(ctx context.Context, data *dto.CreateUser) (*dto.User, error)
+	ValidPassword(ctx context.Context, data dto.AuthenticateUser) (*uuid.UUID, bool)
+	Authenticate(
+		ctx context.Context,
+		data dto.AuthenticateUser,
+		ip string,
+	) (uuid.UUID, time.Duration, error)
 	IsValidateToken(tokenString string) (*jwt.Token, bool)
 	SearchPlayerConn()
-	RegisterUser(data *dto.CreateUser) (*dto.User, error)
-	MoveGameStr(gameID uuid.UUID, move string, player *dto.PlayerConn) bool
-	SetConnGame(GameID uuid.UUID, player *dto.PlayerConn) error
-	GetGameInfoMemory(GameID uuid.UUID, ok bool, move string) (map[string]interface{}, error)
+	JoinSearch(ctx context.Context, player *dto.PlayerConn) error
+	CancelSearch(player *dto.PlayerConn) error
+	RegisterUser(ctx context.Context, data *dto.CreateUser) (*dto.User, error)
+	RequestEmailVerification(ctx context.Context, userID uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, data *dto.PasswordReset) error
+	LogoutEverywhere(ctx context.Context, userID uuid.UUID) error
+	EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (*dto.TOTPEnrollment, error)
+	DisableTwoFactor(
+		ctx context.Context,
+		userID uuid.UUID,
+		data *dto.DisableTwoFactor,
+	) (time.Duration, error)
+	LoginTwoFactor(
+		ctx context.Context,
+		data *dto.TwoFactorLogin,
+		device dto.Device,
+	) (*dto.TokenPair, time.Duration, error)
+	MoveGameStr(ctx context.Context, gameID uuid.UUID, move string, player *dto.PlayerConn) error
+	SetConnGame(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error
+	LeaveGame(GameID uuid.UUID, player *dto.PlayerConn)
+	HandleGameMessage(
+		ctx context.Context,
+		GameID uuid.UUID,
+		player *dto.PlayerConn,
+		message []byte,
+	) error
+	GetGameInfoMemory(
+		ctx context.Context,
+		GameID uuid.UUID,
+		ok bool,
+		move string,
+	) (map[string]interface{}, error)
 	PlayerExit(player *dto.PlayerConn) error
+	SendGameInfo(
+		ctx context.Context,
+		GameID uuid.UUID,
+		player *dto.PlayerConn,
+		ok bool,
+		move string,
+	) error
+	SendMoveResult(
+		ctx context.Context,
+		GameID uuid.UUID,
+		player *dto.PlayerConn,
+		moveErr error,
+	) error
+	BroadcastGameInfo(ctx context.Context, GameID uuid.UUID)
+	GameAction(
+		ctx context.Context,
+		GameID uuid.UUID,
+		action *dto.GameAction,
+		player *dto.PlayerConn,
+	) error
+	SetUserRole(ctx context.Context, actorID, userID uuid.UUID, role user.Role) error
+	BootstrapAdmins(ctx context.Context, emails []string)
+	StartOIDCLogin(ctx context.Context, provider string) (*dto.OIDCStart, error)
+	AuthenticateOIDC(
+		ctx context.Context,
+		provider string,
+		data *dto.OIDCCallback,
+	) (uuid.UUID, error)
+	UpgradeToBot(ctx context.Context, userID uuid.UUID) error
+	RequireBot(ctx context.Context, userID uuid.UUID) error
+	BotGameAction(
+		ctx context.Context,
+		GameID uuid.UUID,
+		userID uuid.UUID,
+		action *dto.GameAction,
+	) (bool, error)
+	SendChallenge(
+		ctx context.Context,
+		userID uuid.UUID,
+		data *dto.CreateChallenge,
+	) (*dto.Challenge, error)
+	AcceptChallenge(ctx context.Context, userID, challengeID uuid.UUID) (*dto.Challenge, error)
+	DeclineChallenge(ctx context.Context, userID, challengeID uuid.UUID) (*dto.Challenge, error)
+	CancelChallenge(ctx context.Context, userID, challengeID uuid.UUID) (*dto.Challenge, error)
+	OpenEventStream(ctx context.Context, userID uuid.UUID) (*dto.EventStream, error)
+	CloseEventStream(userID uuid.UUID, stream *dto.EventStream)
+	RecoverGames()
+	Stats() *dto.GameStats
+	Shutdown()
 }
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type ISessionRepo interface {
+	CreateSession(ctx context.Context, session *dto.Session) error
+	// RotateSession заменяет refresh-токен oldJTI на newJTI. Возвращает false, если сессия
+	// отозвана, истекла или oldJTI уже не последний токен сессии.
+	RotateSession(
+		ctx context.Context,
+		sessionID, oldJTI, newJTI uuid.UUID,
+		expiresAt time.Time,
+		device dto.Device,
+	) (bool, error)
+	// Sessions возвращает действующие сессии пользователя, начиная с последней использованной
+	Sessions(ctx context.Context, userID uuid.UUID) ([]*dto.Session, error)
+	// RevokedSessions возвращает сессии, отозванные начиная с since
+	RevokedSessions(ctx context.Context, since time.Time) ([]*dto.Session, error)
+	RevokeSession(ctx context.Context, sessionID uuid.UUID) error
+	// RevokeUserSession отзывает сессию пользователя. Возвращает ErrSessionNotFound, если
+	// действующей сессии с таким id у пользователя нет.
+	RevokeUserSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type ITwoFactorRepo interface {
+	// StartTOTP сохраняет неподтверждённый секрет и заменяет коды восстановления. Возвращает
+	// ErrTOTPEnabled, если 2FA уже включена.
+	StartTOTP(
+		ctx context.Context,
+		userID uuid.UUID,
+		secret string,
+		recoveryHashes []string,
+	) error
+	TOTP(ctx context.Context, userID uuid.UUID) (*dto.TOTP, error)
+	// ConfirmTOTP включает 2FA с принятым шагом step. Возвращает false, если она уже включена.
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	// UseTOTPStep принимает шаг TOTP, только если он новее последнего принятого
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	// UseRecoveryCode погашает неиспользованный код восстановления с хэшем hash
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) (bool, error)
+	DisableTOTP(ctx context.Context, userID uuid.UUID) error
+}
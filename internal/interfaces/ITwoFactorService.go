@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type ITwoFactorService interface {
+	TwoFactorEnabled(ctx context.Context, userID uuid.UUID) (bool, error)
+	EnrollTOTP(ctx context.Context, userID uuid.UUID, account string) (*dto.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) error
+	VerifyTOTP(ctx context.Context, userID uuid.UUID, code string) error
+	VerifySecondFactor(ctx context.Context, userID uuid.UUID, code string) error
+	DisableTOTP(ctx context.Context, userID uuid.UUID) error
+}
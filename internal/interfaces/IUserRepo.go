@@ -1,13 +1,23 @@
 package interfaces
 
 import (
+	"context"
+
+	"GopherChessParty/ent/user"
 	"GopherChessParty/internal/dto"
 	"github.com/google/uuid"
 )
 
 type IUserRepo interface {
-	CreateUser(user *dto.CreateUser) (*dto.User, error)
-	Users() ([]*dto.User, error)
-	UserPassword(email string) (*dto.AuthUser, error)
-	UserByID(UserID uuid.UUID) (*dto.User, error)
+	CreateUser(ctx context.Context, user *dto.CreateUser) (*dto.User, error)
+	Users(ctx context.Context) ([]*dto.User, error)
+	UserPassword(ctx context.Context, email string) (*dto.AuthUser, error)
+	UserByEmail(ctx context.Context, email string) (*dto.User, error)
+	UserByID(ctx context.Context, UserID uuid.UUID) (*dto.User, error)
+	SetAutoQueen(ctx context.Context, UserID uuid.UUID, autoQueen bool) error
+	IncrementAborts(ctx context.Context, UserID uuid.UUID) error
+	SetEmailVerified(ctx context.Context, UserID uuid.UUID) error
+	SetPassword(ctx context.Context, UserID uuid.UUID, hashedPassword string) error
+	SetBot(ctx context.Context, UserID uuid.UUID) error
+	SetRole(ctx context.Context, UserID uuid.UUID, role user.Role) error
 }
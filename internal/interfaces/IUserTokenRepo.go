@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"GopherChessParty/ent/usertoken"
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+type IUserTokenRepo interface {
+	CreateUserToken(ctx context.Context, token *dto.UserToken) error
+	// ConsumeUserToken погашает действующий токен с хэшем hash и остальные токены того же
+	// назначения его пользователя. Возвращает ErrTokenInvalid, если токен не найден, истёк или
+	// уже использован.
+	ConsumeUserToken(
+		ctx context.Context,
+		purpose usertoken.Purpose,
+		hash string,
+	) (uuid.UUID, error)
+}
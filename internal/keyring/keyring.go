@@ -0,0 +1,230 @@
+package keyring
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	// timeLayout формат времени начала подписи в имени файла ключа, как у файлов миграций
+	timeLayout = "20060102150405"
+	minRSABits = 2048
+)
+
+// key ключ подписи из каталога ключей
+type key struct {
+	kid        string
+	method     jwt.SigningMethod
+	private    crypto.PrivateKey
+	public     crypto.PublicKey
+	activeFrom time.Time
+}
+
+// Keyring ключи подписи токенов из каталога. Файл <YYYYMMDDHHMMSS>[_метка].pem содержит закрытый
+// ключ Ed25519 или RSA и подписывает токены с указанного времени (UTC) до начала следующего ключа.
+// Сменённый ключ ещё retireAfter проверяет выданные им токены, затем перестаёт публиковаться.
+type Keyring struct {
+	log         interfaces.ILogger
+	dir         string
+	retireAfter time.Duration
+	keys        []*key // По возрастанию времени начала подписи
+	mu          sync.RWMutex
+}
+
+// MustNew загружает ключи из dir. Паникует, если ключи не читаются или ни один ещё не подписывает.
+func MustNew(log interfaces.ILogger, dir string, retireAfter time.Duration) *Keyring {
+	k := &Keyring{
+		log:         log,
+		dir:         dir,
+		retireAfter: retireAfter,
+	}
+	if err := k.Reload(); err != nil {
+		panic(err)
+	}
+	if _, _, _, err := k.SigningKey(); err != nil {
+		panic(fmt.Errorf("keyring %s: %w", dir, err))
+	}
+	return k
+}
+
+// Reload перечитывает каталог ключей. При ошибке остаются прежние ключи.
+func (k *Keyring) Reload() error {
+	keys, err := loadDir(k.dir)
+	if err != nil {
+		return err
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if !slices.EqualFunc(k.keys, keys, func(a, b *key) bool { return a.kid == b.kid }) {
+		kids := make([]string, 0, len(keys))
+		for _, key := range keys {
+			kids = append(kids, key.kid)
+		}
+		k.log.Info("signing keys loaded", "dir", k.dir, "kids", kids)
+	}
+	k.keys = keys
+	return nil
+}
+
+func (k *Keyring) SigningKey() (string, jwt.SigningMethod, crypto.PrivateKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	i := k.active(time.Now())
+	if i < 0 {
+		return "", nil, nil, errors.ErrNoSigningKey
+	}
+	return k.keys[i].kid, k.keys[i].method, k.keys[i].private, nil
+}
+
+func (k *Keyring) VerificationKey(kid string) (jwt.SigningMethod, crypto.PublicKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	now := time.Now()
+	for i, key := range k.keys {
+		if key.kid == kid && !k.retired(i, now) {
+			return key.method, key.public, true
+		}
+	}
+	return nil, nil, false
+}
+
+// JWKS публикует действующие ключи вместе с ещё не начавшими подпись, чтобы другие сервисы
+// получили новый ключ заранее
+func (k *Keyring) JWKS() *dto.JWKS {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	now := time.Now()
+	jwks := &dto.JWKS{Keys: make([]dto.JWK, 0, len(k.keys))}
+	for i, key := range k.keys {
+		if !k.retired(i, now) {
+			jwks.Keys = append(jwks.Keys, key.jwk())
+		}
+	}
+	return jwks
+}
+
+// active возвращает индекс ключа, подписывающего в момент now, или -1
+func (k *Keyring) active(now time.Time) int {
+	for i := len(k.keys) - 1; i >= 0; i-- {
+		if !k.keys[i].activeFrom.After(now) {
+			return i
+		}
+	}
+	return -1
+}
+
+// retired сообщает, что ключ i сменён следующим дольше retireAfter назад
+func (k *Keyring) retired(i int, now time.Time) bool {
+	if i+1 >= len(k.keys) {
+		return false
+	}
+	return now.Sub(k.keys[i+1].activeFrom) > k.retireAfter
+}
+
+func (k *key) jwk() dto.JWK {
+	jwk := dto.JWK{Kid: k.kid, Use: "sig", Alg: k.method.Alg()}
+	switch public := k.public.(type) {
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	}
+	return jwk
+}
+
+// loadDir читает все ключи *.pem каталога
+func loadDir(dir string) ([]*key, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*key, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".pem" {
+			continue
+		}
+		key, err := loadKey(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", entry.Name(), err)
+		}
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b *key) int {
+		return a.activeFrom.Compare(b.activeFrom)
+	})
+	for i := 1; i < len(keys); i++ {
+		if keys[i].activeFrom.Equal(keys[i-1].activeFrom) {
+			return nil, fmt.Errorf(
+				"keys %s and %s start signing at the same time",
+				keys[i-1].kid,
+				keys[i].kid,
+			)
+		}
+	}
+	return keys, nil
+}
+
+func loadKey(path string) (*key, error) {
+	kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+	stamp, _, _ := strings.Cut(kid, "_")
+	activeFrom, err := time.ParseInLocation(timeLayout, stamp, time.UTC)
+	if err != nil {
+		return nil, fmt.Errorf("file name must start with %s: %w", timeLayout, err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block")
+	}
+	var private any
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	k := &key{kid: kid, private: private, activeFrom: activeFrom}
+	switch private := private.(type) {
+	case ed25519.PrivateKey:
+		k.method = jwt.SigningMethodEdDSA
+		k.public = private.Public()
+	case *rsa.PrivateKey:
+		if private.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("RSA key is shorter than %d bits", minRSABits)
+		}
+		k.method = jwt.SigningMethodRS256
+		k.public = &private.PublicKey
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", private)
+	}
+	return k, nil
+}
@@ -0,0 +1,281 @@
+package keyring_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/keyring"
+	"GopherChessParty/internal/logger"
+)
+
+const timeLayout = "20060102150405"
+
+var log = logger.New(dto.Application{Env: "prod"})
+
+// stamp префикс имени файла ключа, начинающего подпись в момент at
+func stamp(at time.Time) string {
+	return at.UTC().Format(timeLayout)
+}
+
+func newEd25519(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return private
+}
+
+func newRSA(t *testing.T, bits int) *rsa.PrivateKey {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return private
+}
+
+// writeKey сохраняет закрытый ключ в каталог: Ed25519 в PKCS#8, RSA в PKCS#1
+func writeKey(t *testing.T, dir, name string, private any) {
+	t.Helper()
+	block := &pem.Block{}
+	switch private := private.(type) {
+	case *rsa.PrivateKey:
+		block.Type, block.Bytes = "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(private)
+	default:
+		der, err := x509.MarshalPKCS8PrivateKey(private)
+		if err != nil {
+			t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+		}
+		block.Type, block.Bytes = "PRIVATE KEY", der
+	}
+	writeFile(t, dir, name, pem.EncodeToMemory(block))
+}
+
+func writeFile(t *testing.T, dir, name string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
+
+func TestLoadKeys(t *testing.T) {
+	rsaKey := newRSA(t, 2048)
+	past := stamp(time.Now().Add(-time.Hour))
+	tests := []struct {
+		name    string
+		files   func(t *testing.T, dir string)
+		kid     string
+		alg     string
+		wantErr string
+	}{
+		{
+			name:  "ed25519",
+			files: func(t *testing.T, dir string) { writeKey(t, dir, past+".pem", newEd25519(t)) },
+			kid:   past,
+			alg:   "EdDSA",
+		},
+		{
+			name:  "rsa",
+			files: func(t *testing.T, dir string) { writeKey(t, dir, past+".pem", rsaKey) },
+			kid:   past,
+			alg:   "RS256",
+		},
+		{
+			name: "label",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, past+"_main_node.pem", newEd25519(t))
+			},
+			kid: past + "_main_node",
+			alg: "EdDSA",
+		},
+		{
+			name: "other files are ignored",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, past+".pem", newEd25519(t))
+				writeFile(t, dir, "README.md", []byte("keys"))
+				if err := os.Mkdir(filepath.Join(dir, "old.pem"), 0o700); err != nil {
+					t.Fatalf("Mkdir: %v", err)
+				}
+			},
+			kid: past,
+			alg: "EdDSA",
+		},
+		{
+			name:    "short rsa",
+			files:   func(t *testing.T, dir string) { writeKey(t, dir, past+".pem", newRSA(t, 1024)) },
+			wantErr: "shorter than 2048 bits",
+		},
+		{
+			name:    "name without time",
+			files:   func(t *testing.T, dir string) { writeKey(t, dir, "signing.pem", newEd25519(t)) },
+			wantErr: "file name must start with",
+		},
+		{
+			name:    "not pem",
+			files:   func(t *testing.T, dir string) { writeFile(t, dir, past+".pem", []byte("key")) },
+			wantErr: "no PEM block",
+		},
+		{
+			name: "public key",
+			files: func(t *testing.T, dir string) {
+				der, err := x509.MarshalPKIXPublicKey(newEd25519(t).Public())
+				if err != nil {
+					t.Fatalf("MarshalPKIXPublicKey: %v", err)
+				}
+				writeFile(t, dir, past+".pem", pem.EncodeToMemory(&pem.Block{
+					Type:  "PUBLIC KEY",
+					Bytes: der,
+				}))
+			},
+			wantErr: "unsupported PEM block",
+		},
+		{
+			name: "same start time",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, past+"_a.pem", newEd25519(t))
+				writeKey(t, dir, past+"_b.pem", newEd25519(t))
+			},
+			wantErr: "start signing at the same time",
+		},
+		{
+			name: "no active key",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, stamp(time.Now().Add(time.Hour))+".pem", newEd25519(t))
+			},
+			wantErr: "no active signing key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.files(t, dir)
+			var keys *keyring.Keyring
+			err := func() (err error) {
+				defer func() {
+					if r := recover(); r != nil {
+						err, _ = r.(error)
+					}
+				}()
+				keys = keyring.MustNew(log, dir, time.Hour)
+				return nil
+			}()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("MustNew error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MustNew: %v", err)
+			}
+			kid, method, _, err := keys.SigningKey()
+			if err != nil {
+				t.Fatalf("SigningKey: %v", err)
+			}
+			if kid != tt.kid || method.Alg() != tt.alg {
+				t.Fatalf("signing key = %s %s, want %s %s", kid, method.Alg(), tt.kid, tt.alg)
+			}
+		})
+	}
+}
+
+func TestRotation(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	retired := stamp(now.Add(-3 * time.Hour))
+	previous := stamp(now.Add(-2 * time.Hour))
+	current := stamp(now.Add(-time.Hour + time.Minute))
+	next := stamp(now.Add(time.Hour))
+	for _, kid := range []string{retired, previous, current, next} {
+		writeKey(t, dir, kid+".pem", newEd25519(t))
+	}
+	// Ключ проверяет токены ещё час после того, как его сменил следующий
+	keys := keyring.MustNew(log, dir, time.Hour)
+
+	if kid, _, _, _ := keys.SigningKey(); kid != current {
+		t.Fatalf("signing kid = %s, want %s", kid, current)
+	}
+	for kid, want := range map[string]bool{
+		retired:  false,
+		previous: true,
+		current:  true,
+		next:     true,
+		"other":  false,
+	} {
+		if _, _, ok := keys.VerificationKey(kid); ok != want {
+			t.Errorf("VerificationKey(%s) = %v, want %v", kid, ok, want)
+		}
+	}
+	published := make([]string, 0)
+	for _, jwk := range keys.JWKS().Keys {
+		published = append(published, jwk.Kid)
+	}
+	if strings.Join(published, ",") != strings.Join([]string{previous, current, next}, ",") {
+		t.Fatalf("JWKS kids = %v, want previous, current and next", published)
+	}
+
+	// Новый ключ подхватывается без перезапуска, битый файл не сбрасывает прежние ключи
+	rotated := stamp(now.Add(-time.Minute))
+	writeKey(t, dir, rotated+"_rotated.pem", newEd25519(t))
+	if err := keys.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if kid, _, _, _ := keys.SigningKey(); kid != rotated+"_rotated" {
+		t.Fatalf("signing kid after reload = %s, want %s_rotated", kid, rotated)
+	}
+	writeFile(t, dir, stamp(now)+".pem", []byte("broken"))
+	if err := keys.Reload(); err == nil {
+		t.Fatal("Reload accepted a broken key")
+	}
+	if kid, _, _, _ := keys.SigningKey(); kid != rotated+"_rotated" {
+		t.Fatalf("signing kid after failed reload = %s, want %s_rotated", kid, rotated)
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	dir := t.TempDir()
+	edKey, rsaKey := newEd25519(t), newRSA(t, 2048)
+	edKid, rsaKid := stamp(time.Now().Add(-time.Hour)), stamp(time.Now().Add(-time.Minute))
+	writeKey(t, dir, edKid+".pem", edKey)
+	writeKey(t, dir, rsaKid+".pem", rsaKey)
+	keys := keyring.MustNew(log, dir, time.Hour)
+
+	want := map[string]dto.JWK{
+		edKid: {
+			Kty: "OKP",
+			Kid: edKid,
+			Use: "sig",
+			Alg: "EdDSA",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(edKey.Public().(ed25519.PublicKey)),
+		},
+		rsaKid: {
+			Kty: "RSA",
+			Kid: rsaKid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			E:   "AQAB",
+		},
+	}
+	jwks := keys.JWKS()
+	if len(jwks.Keys) != len(want) {
+		t.Fatalf("JWKS keys = %d, want %d", len(jwks.Keys), len(want))
+	}
+	for _, jwk := range jwks.Keys {
+		if jwk != want[jwk.Kid] {
+			t.Fatalf("JWK %s = %+v, want %+v", jwk.Kid, jwk, want[jwk.Kid])
+		}
+	}
+}
@@ -22,6 +22,10 @@ func (logger *Logger) Info(msg string, v ...interface{}) {
 	logger.log.Info(msg, v...)
 }
 
+func (logger *Logger) Warn(msg string, v ...interface{}) {
+	logger.log.Warn(msg, v...)
+}
+
 func (logger *Logger) Error(err error) {
 	if err != nil {
 		logger.log.Error(err.Error())
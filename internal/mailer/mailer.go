@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+)
+
+// envLocal окружение локальной разработки, в котором письма можно писать в лог и файлы
+const envLocal = "local"
+
+// MustNew создаёт отправителя писем по cfg.Driver. Паникует на неизвестном драйвере и на драйверах
+// file и log вне локального окружения env.
+func MustNew(log interfaces.ILogger, cfg dto.Mailer, env string) interfaces.IMailer {
+	if (cfg.Driver == "file" || cfg.Driver == "log") && env != envLocal {
+		panic(fmt.Sprintf("mail driver %q is allowed only with ENV=%s", cfg.Driver, envLocal))
+	}
+	switch cfg.Driver {
+	case "smtp":
+		if cfg.Host == "" {
+			panic("smtp mailer requires SMTP_HOST")
+		}
+		return NewSMTPMailer(cfg)
+	case "file":
+		return NewFileMailer(log, cfg)
+	case "log":
+		return NewLogMailer(log)
+	default:
+		panic(fmt.Sprintf("unknown mail driver %q", cfg.Driver))
+	}
+}
+
+// message собирает письмо в формате RFC 5322 с телом в UTF-8
+func message(from string, mail *dto.Mail) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mail.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	buf.WriteString(mail.Body)
+	return buf.Bytes()
+}
@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+)
+
+// FileMailer сохраняет письма файлами .eml в каталог для локальной разработки
+type FileMailer struct {
+	log interfaces.ILogger
+	cfg dto.Mailer
+}
+
+func NewFileMailer(log interfaces.ILogger, cfg dto.Mailer) *FileMailer {
+	return &FileMailer{log: log, cfg: cfg}
+}
+
+func (m *FileMailer) Send(_ context.Context, mail *dto.Mail) error {
+	if err := os.MkdirAll(m.cfg.Dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf(
+		"%s_%s.eml",
+		time.Now().Format("20060102150405.000000000"),
+		strings.NewReplacer("@", "_at_", "/", "_", "\\", "_").Replace(mail.To),
+	)
+	path := filepath.Join(m.cfg.Dir, name)
+	if err := os.WriteFile(path, message(m.cfg.From, mail), 0o600); err != nil {
+		return err
+	}
+	m.log.Info("mail saved", "to", mail.To, "subject", mail.Subject, "path", path)
+	return nil
+}
+
+// LogMailer пишет письма в лог вместо отправки
+type LogMailer struct {
+	log interfaces.ILogger
+}
+
+func NewLogMailer(log interfaces.ILogger) *LogMailer {
+	return &LogMailer{log: log}
+}
+
+func (m *LogMailer) Send(_ context.Context, mail *dto.Mail) error {
+	m.log.Info("mail", "to", mail.To, "subject", mail.Subject, "body", mail.Body)
+	return nil
+}
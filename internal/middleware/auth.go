@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"strings"
 
+	"GopherChessParty/ent/user"
+	"GopherChessParty/internal/dto"
 	"GopherChessParty/internal/errors"
 	"GopherChessParty/internal/interfaces"
 	"github.com/gin-gonic/gin"
@@ -11,13 +13,18 @@ import (
 	"github.com/google/uuid"
 )
 
-// JWTAuthMiddleware проверяет валидность JWT-токена.
-func JWTAuthMiddleware(service interfaces.IService) gin.HandlerFunc {
+// JWTAuthMiddleware проверяет валидность JWT-токена или персонального токена.
+// Персональный токен пропускается, только если у него есть одно из прав scopes группы маршрутов,
+// поэтому группы без прав доступны лишь по токену сессии.
+func JWTAuthMiddleware(service interfaces.IService, scopes ...dto.Scope) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Извлекаем токен из заголовка Authorization
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing Authorization header"})
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				gin.H{"error": "Missing Authorization header"},
+			)
 			return
 		}
 
@@ -31,6 +38,25 @@ func JWTAuthMiddleware(service interfaces.IService) gin.HandlerFunc {
 
 		tokenString := parts[1]
 
+		if strings.HasPrefix(tokenString, dto.PersonalTokenPrefix) {
+			personal, err := service.AuthenticatePersonalToken(c.Request.Context(), tokenString)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+				return
+			}
+			if !personal.HasAnyScope(scopes...) {
+				c.AbortWithStatusJSON(
+					http.StatusForbidden,
+					gin.H{"error": errors.ErrScopeDenied.Error()},
+				)
+				return
+			}
+			c.Set("id", personal.UserID.String())
+			c.Set("scopes", personal.Scopes)
+			c.Next()
+			return
+		}
+
 		// Парсим токен
 		token, ok := service.IsValidateToken(tokenString)
 
@@ -42,12 +68,45 @@ func JWTAuthMiddleware(service interfaces.IService) gin.HandlerFunc {
 		// Можно извлечь claims и положить в контекст запроса, если потребуется
 		if claims, ok := token.Claims.(jwt.MapClaims); ok {
 			c.Set("id", claims["id"])
+			c.Set("sid", claims["sid"])
+			c.Set("role", claims["role"])
 		}
 
 		c.Next()
 	}
 }
 
+// RequirePermission пропускает запрос, только если у роли из токена есть право permission.
+// Ставится после JWTAuthMiddleware.
+func RequirePermission(permission dto.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !HasPermission(c, permission) {
+			c.AbortWithStatusJSON(
+				http.StatusForbidden,
+				gin.H{"error": errors.ErrPermissionDenied.Error()},
+			)
+			return
+		}
+		c.Next()
+	}
+}
+
+// HasPermission проверяет право роли из токена запроса
+func HasPermission(c *gin.Context, permission dto.Permission) bool {
+	return dto.RoleAllows(GetRole(c), permission)
+}
+
+// GetRole возвращает роль из токена запроса. У персональных токенов и токенов, выданных до
+// появления ролей, роли нет: они получают права обычного пользователя.
+func GetRole(c *gin.Context) user.Role {
+	raw, _ := c.Get("role")
+	role, ok := raw.(string)
+	if !ok || user.RoleValidator(user.Role(role)) != nil {
+		return user.RoleUser
+	}
+	return user.Role(role)
+}
+
 func GetUserID(c *gin.Context) (uuid.UUID, error) {
 	id, exists := c.Get("id")
 	if !exists {
@@ -62,6 +121,23 @@ func GetUserID(c *gin.Context) (uuid.UUID, error) {
 	return userId, nil
 }
 
+// GetSessionID возвращает сессию входа, которой выдан токен запроса
+func GetSessionID(c *gin.Context) (uuid.UUID, error) {
+	id, ok := c.Get("sid")
+	if !ok {
+		return uuid.UUID{}, errors.ErrSessionNotFound
+	}
+	raw, ok := id.(string)
+	if !ok {
+		return uuid.UUID{}, errors.ErrSessionNotFound
+	}
+	sessionID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.UUID{}, errors.ErrType
+	}
+	return sessionID, nil
+}
+
 // WebSocketTokenMiddleware извлекает токен из query-параметра ?token=Bearer%20...
 func WebSocketTokenMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"GopherChessParty/internal/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// AllowNetworks пропускает только запросы с адресов из сетей networks (CIDR). Адрес берётся
+// из соединения, а не из X-Forwarded-For, поэтому подменить его заголовком нельзя.
+// Паникует на неверной записи сети.
+func AllowNetworks(networks []string) gin.HandlerFunc {
+	allowed := make([]*net.IPNet, 0, len(networks))
+	for _, network := range networks {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(network))
+		if err != nil {
+			panic(err)
+		}
+		allowed = append(allowed, ipNet)
+	}
+	return func(c *gin.Context) {
+		ip := net.ParseIP(c.RemoteIP())
+		for _, ipNet := range allowed {
+			if ip != nil && ipNet.Contains(ip) {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(
+			http.StatusForbidden,
+			gin.H{"error": errors.ErrNetworkDenied.Error()},
+		)
+	}
+}
@@ -0,0 +1,363 @@
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	exc "errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"time"
+
+	entmigrate "GopherChessParty/ent/migrate"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	_ "github.com/lib/pq"
+)
+
+const (
+	// lockName имя advisory lock, под которым узлы применяют миграции по очереди
+	lockName    = "gopher_chess_migrate"
+	lockTimeout = time.Minute
+)
+
+// Migrator применяет и откатывает миграции PostgreSQL, встроенные в бинарник
+type Migrator struct {
+	log       interfaces.ILogger
+	db        *sql.DB
+	dir       *migrate.MemDir
+	rollbacks fs.FS
+}
+
+// MustNew Создание мигратора. Паникует, если встроенные миграции не совпадают с atlas.sum.
+func MustNew(log interfaces.ILogger, cfg dto.Database) *Migrator {
+	dir, err := embeddedDir()
+	if err != nil {
+		panic(err)
+	}
+	db, err := sql.Open("postgres", cfg.Url())
+	if err != nil {
+		panic(err)
+	}
+	return &Migrator{
+		log:       log,
+		db:        db,
+		dir:       dir,
+		rollbacks: entmigrate.Rollbacks,
+	}
+}
+
+// embeddedDir копирует встроенные миграции в каталог Atlas и проверяет их контрольные суммы
+func embeddedDir() (*migrate.MemDir, error) {
+	entries, err := fs.ReadDir(entmigrate.Migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	dir := &migrate.MemDir{}
+	for _, entry := range entries {
+		data, err := fs.ReadFile(entmigrate.Migrations, path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if err := dir.WriteFile(entry.Name(), data); err != nil {
+			return nil, err
+		}
+	}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("embedded migrations: %w", err)
+	}
+	return dir, nil
+}
+
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
+
+// Status возвращает состояние схемы базы относительно встроенных миграций
+func (m *Migrator) Status(ctx context.Context) (*dto.MigrationStatus, error) {
+	rrw := &revisions{conn: m.db}
+	if err := rrw.init(ctx); err != nil {
+		return nil, err
+	}
+	revs, err := rrw.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files, err := m.dir.Files()
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[string]*migrate.Revision, len(revs))
+	for _, rev := range revs {
+		applied[rev.Version] = rev
+	}
+	status := &dto.MigrationStatus{
+		Migrations: make([]*dto.MigrationState, 0, len(files)),
+		Unknown:    make([]string, 0),
+	}
+	if len(revs) > 0 {
+		status.Current = revs[len(revs)-1].Version
+	}
+	known := make(map[string]bool, len(files))
+	for _, file := range files {
+		known[file.Version()] = true
+		status.Expected = file.Version()
+		state := &dto.MigrationState{Version: file.Version(), Description: file.Desc()}
+		if rev, ok := applied[file.Version()]; ok && rev.Applied == rev.Total {
+			state.Applied = true
+			state.ExecutedAt = rev.ExecutedAt
+		}
+		status.Migrations = append(status.Migrations, state)
+	}
+	for _, rev := range revs {
+		if !known[rev.Version] {
+			status.Unknown = append(status.Unknown, rev.Version)
+		}
+	}
+	return status, nil
+}
+
+// Check сверяет ревизии базы со встроенными миграциями. Возвращает ErrSchemaAhead, если база
+// применила миграции новее бинарника, ErrSchemaDiverged, если история разошлась,
+// и ErrSchemaBehind, если остались неприменённые миграции.
+func (m *Migrator) Check(ctx context.Context) error {
+	rrw := &revisions{conn: m.db}
+	if err := rrw.init(ctx); err != nil {
+		return err
+	}
+	revs, err := rrw.ReadRevisions(ctx)
+	if err != nil {
+		return err
+	}
+	return check(revs, m.dir)
+}
+
+// check сверяет ревизии базы с каталогом миграций
+func check(revs []*migrate.Revision, dir migrate.Dir) error {
+	files, err := dir.Files()
+	if err != nil {
+		return err
+	}
+	sums, err := dir.Checksum()
+	if err != nil {
+		return err
+	}
+	expected := ""
+	if len(files) > 0 {
+		expected = files[len(files)-1].Version()
+	}
+	byVersion := make(map[string]migrate.File, len(files))
+	for _, file := range files {
+		byVersion[file.Version()] = file
+	}
+	applied := make(map[string]bool, len(revs))
+	// Миграции до базовой ревизии Atlas уже есть в схеме и не записываются в таблицу ревизий
+	baseline := ""
+	for _, rev := range revs {
+		applied[rev.Version] = true
+		file, ok := byVersion[rev.Version]
+		switch {
+		case !ok && rev.Version > expected:
+			return fmt.Errorf(
+				"%w: database is at %s, binary expects %s",
+				errors.ErrSchemaAhead,
+				rev.Version,
+				expected,
+			)
+		case !ok:
+			return fmt.Errorf(
+				"%w: revision %s is not embedded",
+				errors.ErrSchemaDiverged,
+				rev.Version,
+			)
+		case rev.Type == migrate.RevisionTypeBaseline:
+			baseline = rev.Version
+			continue
+		case rev.Applied != rev.Total || rev.Error != "":
+			return fmt.Errorf(
+				"%w: revision %s is partially applied",
+				errors.ErrSchemaDiverged,
+				rev.Version,
+			)
+		}
+		hash, err := sums.SumByName(file.Name())
+		if err != nil {
+			return err
+		}
+		if rev.Hash != hash {
+			return fmt.Errorf(
+				"%w: migration %s was changed after it was applied",
+				errors.ErrSchemaDiverged,
+				file.Name(),
+			)
+		}
+	}
+	current := ""
+	if len(revs) > 0 {
+		current = revs[len(revs)-1].Version
+	}
+	pending := 0
+	for _, file := range files {
+		switch {
+		case applied[file.Version()], file.Version() <= baseline:
+		case file.Version() < current:
+			return fmt.Errorf("%w: migration %s was skipped", errors.ErrSchemaDiverged, file.Name())
+		default:
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d to apply up to %s", errors.ErrSchemaBehind, pending, expected)
+	}
+	return nil
+}
+
+// Up применяет до n ожидающих миграций, все при n <= 0. Каждая миграция выполняется в своей транзакции,
+// а узлы применяют миграции по очереди под advisory lock.
+func (m *Migrator) Up(ctx context.Context, n int) (int, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer m.unlock(unlock)
+
+	if err := m.Check(ctx); err != nil && !exc.Is(err, errors.ErrSchemaBehind) {
+		return 0, err
+	}
+	executor, err := m.executor(m.db)
+	if err != nil {
+		return 0, err
+	}
+	pending, err := executor.Pending(ctx)
+	if exc.Is(err, migrate.ErrNoPendingFiles) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 && n < len(pending) {
+		pending = pending[:n]
+	}
+	for i, file := range pending {
+		if err := m.apply(ctx, file); err != nil {
+			return i, fmt.Errorf("apply %s: %w", file.Name(), err)
+		}
+		m.log.Info("migration applied", "version", file.Version(), "description", file.Desc())
+	}
+	return len(pending), nil
+}
+
+// Down откатывает n последних применённых миграций
+func (m *Migrator) Down(ctx context.Context, n int) (int, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer m.unlock(unlock)
+
+	if err := m.Check(ctx); err != nil && !exc.Is(err, errors.ErrSchemaBehind) {
+		return 0, err
+	}
+	revs, err := (&revisions{conn: m.db}).ReadRevisions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	reverted := 0
+	for ; reverted < n && len(revs) > 0; reverted++ {
+		rev := revs[len(revs)-1]
+		if rev.Type == migrate.RevisionTypeBaseline {
+			break
+		}
+		if err := m.revert(ctx, rev); err != nil {
+			return reverted, fmt.Errorf("revert %s: %w", rev.Version, err)
+		}
+		m.log.Info("migration reverted", "version", rev.Version, "description", rev.Description)
+		revs = revs[:len(revs)-1]
+	}
+	return reverted, nil
+}
+
+// apply выполняет миграцию и записывает её ревизию в одной транзакции
+func (m *Migrator) apply(ctx context.Context, file migrate.File) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	executor, err := m.executor(tx)
+	if err == nil {
+		err = executor.Execute(ctx, file)
+	}
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w: rollback: %v", err, rollbackErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// revert выполняет откат миграции и удаляет её ревизию в одной транзакции
+func (m *Migrator) revert(ctx context.Context, rev *migrate.Revision) error {
+	name := fmt.Sprintf("%s_%s.sql", rev.Version, rev.Description)
+	data, err := fs.ReadFile(m.rollbacks, path.Join("down", name))
+	if exc.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %s", errors.ErrNoRollback, name)
+	}
+	if err != nil {
+		return err
+	}
+	stmts, err := migrate.Stmts(string(data))
+	if err != nil {
+		return err
+	}
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = func() error {
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt.Text); err != nil {
+				return err
+			}
+		}
+		return (&revisions{conn: tx}).DeleteRevision(ctx, rev.Version)
+	}()
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w: rollback: %v", err, rollbackErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *Migrator) executor(conn schema.ExecQuerier) (*migrate.Executor, error) {
+	drv, err := postgres.Open(conn)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.NewExecutor(drv, m.dir, &revisions{conn: conn})
+}
+
+// lock берёт advisory lock на время применения миграций
+func (m *Migrator) lock(ctx context.Context) (schema.UnlockFunc, error) {
+	drv, err := postgres.Open(m.db)
+	if err != nil {
+		return nil, err
+	}
+	locker, ok := drv.(schema.Locker)
+	if !ok {
+		return nil, fmt.Errorf("migrate: driver %T does not support locks", drv)
+	}
+	return locker.Lock(ctx, lockName, lockTimeout)
+}
+
+func (m *Migrator) unlock(unlock schema.UnlockFunc) {
+	if err := unlock(); err != nil {
+		m.log.ErrorWithMsg("release migration lock", err)
+	}
+}
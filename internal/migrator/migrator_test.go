@@ -0,0 +1,129 @@
+package migrator
+
+import (
+	exc "errors"
+	"testing"
+
+	"GopherChessParty/internal/errors"
+	"ariga.io/atlas/sql/migrate"
+)
+
+var migrationFiles = map[string]string{
+	"20250101000000_init.sql":     "CREATE TABLE users (id int);",
+	"20250201000000_addGames.sql": "CREATE TABLE games (id int);",
+	"20250301000000_addIndex.sql": "CREATE INDEX users_id ON users (id);",
+}
+
+func testDir(t *testing.T) *migrate.MemDir {
+	t.Helper()
+	dir := &migrate.MemDir{}
+	for name, data := range migrationFiles {
+		if err := dir.WriteFile(name, []byte(data)); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+	}
+	return dir
+}
+
+// applied ревизия полностью применённой миграции с её контрольной суммой
+func applied(t *testing.T, dir *migrate.MemDir, version string) *migrate.Revision {
+	t.Helper()
+	files, err := dir.Files()
+	if err != nil {
+		t.Fatalf("Files: %v", err)
+	}
+	sums, err := dir.Checksum()
+	if err != nil {
+		t.Fatalf("Checksum: %v", err)
+	}
+	for _, file := range files {
+		if file.Version() != version {
+			continue
+		}
+		hash, err := sums.SumByName(file.Name())
+		if err != nil {
+			t.Fatalf("SumByName: %v", err)
+		}
+		return &migrate.Revision{
+			Version: version,
+			Type:    migrate.RevisionTypeExecute,
+			Applied: 1,
+			Total:   1,
+			Hash:    hash,
+		}
+	}
+	t.Fatalf("migration %s is not in the directory", version)
+	return nil
+}
+
+func TestCheck(t *testing.T) {
+	dir := testDir(t)
+	first := func() *migrate.Revision { return applied(t, dir, "20250101000000") }
+	second := func() *migrate.Revision { return applied(t, dir, "20250201000000") }
+	third := func() *migrate.Revision { return applied(t, dir, "20250301000000") }
+
+	tests := []struct {
+		name string
+		revs []*migrate.Revision
+		want error
+	}{
+		{"up to date", []*migrate.Revision{first(), second(), third()}, nil},
+		{"empty database", nil, errors.ErrSchemaBehind},
+		{"behind", []*migrate.Revision{first(), second()}, errors.ErrSchemaBehind},
+		{
+			"baseline",
+			[]*migrate.Revision{{Version: "20250201000000", Type: migrate.RevisionTypeBaseline}},
+			errors.ErrSchemaBehind,
+		},
+		{
+			"baseline up to date",
+			[]*migrate.Revision{
+				{Version: "20250201000000", Type: migrate.RevisionTypeBaseline},
+				third(),
+			},
+			nil,
+		},
+		{
+			"ahead",
+			[]*migrate.Revision{first(), second(), third(), {Version: "20250401000000"}},
+			errors.ErrSchemaAhead,
+		},
+		{
+			"unknown revision",
+			[]*migrate.Revision{first(), {Version: "20250115000000"}, second(), third()},
+			errors.ErrSchemaDiverged,
+		},
+		{"skipped", []*migrate.Revision{first(), third()}, errors.ErrSchemaDiverged},
+		{
+			"changed",
+			[]*migrate.Revision{first(), {
+				Version: "20250201000000",
+				Applied: 1,
+				Total:   1,
+				Hash:    "changed",
+			}},
+			errors.ErrSchemaDiverged,
+		},
+		{
+			"partially applied",
+			[]*migrate.Revision{first(), {
+				Version: "20250201000000",
+				Applied: 0,
+				Total:   1,
+				Error:   "syntax error",
+			}},
+			errors.ErrSchemaDiverged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := check(tt.revs, dir)
+			if tt.want == nil && err != nil {
+				t.Fatalf("check: %v, want nil", err)
+			}
+			if !exc.Is(err, tt.want) {
+				t.Fatalf("check: %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	"GopherChessParty/internal/dto"
+	"github.com/golang-jwt/jwt"
+)
+
+// publicKey ключ подписи ID-токенов из набора ключей провайдера
+type publicKey struct {
+	kty string
+	alg string // Пусто, если провайдер не ограничил алгоритм ключа
+	key crypto.PublicKey
+}
+
+// accepts проверяет, что токен подписан алгоритмом, подходящим к типу ключа. Так токен,
+// подписанный HMAC на открытом ключе провайдера или без подписи, не пройдёт проверку.
+func (k *publicKey) accepts(method jwt.SigningMethod) bool {
+	if k.alg != "" && k.alg != method.Alg() {
+		return false
+	}
+	switch method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		return k.kty == "RSA"
+	case *jwt.SigningMethodECDSA:
+		return k.kty == "EC"
+	case *jwt.SigningMethodEd25519:
+		return k.kty == "OKP"
+	}
+	return false
+}
+
+// parseJWKS разбирает ключи подписи. Ключи шифрования и ключи неизвестных типов пропускаются.
+func parseJWKS(jwks *dto.JWKS) map[string]*publicKey {
+	keys := make(map[string]*publicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, ok := parseJWK(jwk)
+		if !ok {
+			continue
+		}
+		keys[jwk.Kid] = &publicKey{kty: jwk.Kty, alg: jwk.Alg, key: key}
+	}
+	return keys
+}
+
+func parseJWK(jwk dto.JWK) (crypto.PublicKey, bool) {
+	switch jwk.Kty {
+	case "RSA":
+		n, okN := decodeInt(jwk.N)
+		e, okE := decodeInt(jwk.E)
+		if !okN || !okE || !e.IsInt64() {
+			return nil, false
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, true
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, false
+		}
+		x, okX := decodeInt(jwk.X)
+		y, okY := decodeInt(jwk.Y)
+		if !okX || !okY {
+			return nil, false
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, true
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if jwk.Crv != "Ed25519" || err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, false
+		}
+		return ed25519.PublicKey(x), true
+	}
+	return nil, false
+}
+
+func decodeInt(value string) (*big.Int, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(raw) == 0 {
+		return nil, false
+	}
+	return new(big.Int).SetBytes(raw), true
+}
@@ -0,0 +1,338 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	httpTimeout = 10 * time.Second
+	// keysRefreshPeriod ключи с незнакомым kid перечитываются не чаще этого периода
+	keysRefreshPeriod = time.Minute
+	// clockSkew допустимое расхождение часов с провайдером при проверке сроков ID-токена
+	clockSkew = time.Minute
+	// maxResponseSize ограничение на размер ответов провайдера
+	maxResponseSize = 1 << 20
+)
+
+// defaultScopes права, которые запрашиваются, если в конфиге провайдера они не заданы
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// metadata нужная часть документа discovery провайдера
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider клиент провайдера OpenID Connect: вход по коду авторизации с PKCE и проверка ID-токенов.
+// Документ discovery и ключи загружаются при первом входе, а не при запуске, чтобы недоступный
+// провайдер не мешал серверу стартовать.
+type Provider struct {
+	cfg    dto.OIDCProvider
+	client *http.Client
+	meta   *metadata
+	keys   map[string]*publicKey
+	keysAt time.Time
+	mu     sync.Mutex
+}
+
+// MustNew создаёт клиента провайдера. Паникует, если в конфиге не хватает обязательных полей.
+func MustNew(cfg dto.OIDCProvider) *Provider {
+	if cfg.Name == "" || cfg.Issuer == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+		panic("oidc provider requires name, issuer, clientId and redirectUrl: " + cfg.Name)
+	}
+	return New(cfg, &http.Client{Timeout: httpTimeout})
+}
+
+func New(cfg dto.OIDCProvider, client *http.Client) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = defaultScopes
+	}
+	if !slices.Contains(cfg.Scopes, "openid") {
+		cfg.Scopes = append([]string{"openid"}, cfg.Scopes...)
+	}
+	return &Provider{cfg: cfg, client: client}
+}
+
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// AuthCodeURL возвращает адрес страницы входа провайдера для flow
+func (p *Provider) AuthCodeURL(ctx context.Context, flow *dto.OIDCFlow) (string, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	endpoint, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errors.ErrProviderUnavailable, err)
+	}
+	query := endpoint.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	query.Set("state", flow.State)
+	query.Set("nonce", flow.Nonce)
+	query.Set("code_challenge", Challenge(flow.Verifier))
+	query.Set("code_challenge_method", "S256")
+	endpoint.RawQuery = query.Encode()
+	return endpoint.String(), nil
+}
+
+// Exchange обменивает код авторизации на токены и возвращает пользователя из проверенного ID-токена
+func (p *Provider) Exchange(
+	ctx context.Context,
+	code string,
+	flow *dto.OIDCFlow,
+) (*dto.ExternalIdentity, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {flow.Verifier},
+		"client_id":     {p.cfg.ClientID},
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		meta.TokenEndpoint,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		// RFC 6749, 2.3.1: идентификатор и секрет кодируются как значения формы
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrProviderUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("%w: token response: %v", errors.ErrOIDCExchange, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"%w: %s %s",
+			errors.ErrOIDCExchange,
+			body.Error,
+			body.ErrorDescription,
+		)
+	}
+	if body.IDToken == "" {
+		return nil, fmt.Errorf("%w: token response has no id_token", errors.ErrOIDCToken)
+	}
+	return p.verify(ctx, meta, body.IDToken, flow.Nonce)
+}
+
+// verify проверяет подпись ID-токена ключом провайдера, издателя, получателя, сроки и nonce
+func (p *Provider) verify(
+	ctx context.Context,
+	meta *metadata,
+	raw, nonce string,
+) (*dto.ExternalIdentity, error) {
+	parser := &jwt.Parser{SkipClaimsValidation: true}
+	token, err := parser.Parse(raw, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := p.key(ctx, meta, kid)
+		if err != nil {
+			return nil, err
+		}
+		if !key.accepts(token.Method) {
+			return nil, fmt.Errorf("%w: algorithm %s", errors.ErrOIDCToken, token.Method.Alg())
+		}
+		return key.key, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrOIDCToken, err)
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.ErrOIDCToken
+	}
+
+	now := time.Now()
+	switch {
+	case claims["iss"] != meta.Issuer:
+		return nil, fmt.Errorf("%w: issuer %v", errors.ErrOIDCToken, claims["iss"])
+	case !p.audienceValid(claims):
+		return nil, fmt.Errorf("%w: audience %v", errors.ErrOIDCToken, claims["aud"])
+	case !claims.VerifyExpiresAt(now.Add(-clockSkew).Unix(), true):
+		return nil, fmt.Errorf("%w: token expired", errors.ErrOIDCToken)
+	case !claims.VerifyIssuedAt(now.Add(clockSkew).Unix(), false),
+		!claims.VerifyNotBefore(now.Add(clockSkew).Unix(), false):
+		return nil, fmt.Errorf("%w: token used before issued", errors.ErrOIDCToken)
+	case claims["nonce"] != nonce:
+		return nil, fmt.Errorf("%w: nonce mismatch", errors.ErrOIDCToken)
+	}
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, fmt.Errorf("%w: no subject", errors.ErrOIDCToken)
+	}
+
+	identity := &dto.ExternalIdentity{
+		Provider:      p.cfg.Name,
+		Subject:       subject,
+		EmailVerified: claimBool(claims["email_verified"]),
+	}
+	identity.Email, _ = claims["email"].(string)
+	identity.Name, _ = claims["name"].(string)
+	if identity.Name == "" {
+		identity.Name, _ = claims["preferred_username"].(string)
+	}
+	return identity, nil
+}
+
+// audienceValid проверяет, что токен выдан нашему клиенту. При нескольких получателях
+// токен должен быть выдан именно нам: azp совпадает с идентификатором клиента.
+func (p *Provider) audienceValid(claims jwt.MapClaims) bool {
+	var audience []string
+	switch aud := claims["aud"].(type) {
+	case string:
+		audience = []string{aud}
+	case []interface{}:
+		for _, item := range aud {
+			if value, ok := item.(string); ok {
+				audience = append(audience, value)
+			}
+		}
+	}
+	if !slices.Contains(audience, p.cfg.ClientID) {
+		return false
+	}
+	azp, ok := claims["azp"].(string)
+	if len(audience) > 1 || ok {
+		return azp == p.cfg.ClientID
+	}
+	return true
+}
+
+// metadata загружает документ discovery. Издатель в документе должен совпадать с настроенным.
+func (p *Provider) metadata(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+	var meta metadata
+	discovery := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, discovery, &meta); err != nil {
+		return nil, err
+	}
+	switch {
+	case meta.Issuer != p.cfg.Issuer:
+		return nil, fmt.Errorf(
+			"%w: discovery issuer %q does not match %q",
+			errors.ErrProviderUnavailable,
+			meta.Issuer,
+			p.cfg.Issuer,
+		)
+	case meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "":
+		return nil, fmt.Errorf("%w: incomplete discovery document", errors.ErrProviderUnavailable)
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// key возвращает ключ подписи kid. Незнакомый kid означает, что провайдер сменил ключи,
+// поэтому набор ключей перечитывается, но не чаще keysRefreshPeriod.
+func (p *Provider) key(ctx context.Context, meta *metadata, kid string) (*publicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysAt) < keysRefreshPeriod {
+		return nil, fmt.Errorf("%w: unknown key %q", errors.ErrOIDCToken, kid)
+	}
+	var jwks dto.JWKS
+	if err := p.getJSON(ctx, meta.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+	p.keys = parseJWKS(&jwks)
+	p.keysAt = time.Now()
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %q", errors.ErrOIDCToken, kid)
+}
+
+// lookup ищет ключ kid. Токен без kid принимается, только если у провайдера один ключ.
+func (p *Provider) lookup(kid string) (*publicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *Provider) getJSON(ctx context.Context, target string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrProviderUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s returned %s", errors.ErrProviderUnavailable, target, resp.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", errors.ErrProviderUnavailable, target, err)
+	}
+	return nil
+}
+
+// Challenge возвращает code_challenge PKCE по методу S256
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// claimBool читает булев claim. Некоторые провайдеры передают email_verified строкой.
+func claimBool(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
@@ -0,0 +1,301 @@
+package oidc_test
+
+import (
+	"context"
+	exc "errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/oidc"
+	"GopherChessParty/internal/oidc/oidctest"
+	"github.com/golang-jwt/jwt"
+)
+
+const redirectURL = "http://localhost:3000/oidc/callback"
+
+var alice = oidctest.User{
+	Subject:       "alice-sub",
+	Email:         "alice@example.com",
+	EmailVerified: true,
+	Name:          "Alice",
+}
+
+func newFlow() *dto.OIDCFlow {
+	return &dto.OIDCFlow{
+		Provider: "mock",
+		State:    "state-" + time.Now().String(),
+		Nonce:    "nonce-value",
+		Verifier: "verifier-value-that-is-long-enough-for-pkce-0123456789",
+	}
+}
+
+// login проходит вход на провайдере и возвращает код авторизации
+func login(
+	t *testing.T,
+	server *oidctest.Server,
+	provider *oidc.Provider,
+	flow *dto.OIDCFlow,
+) string {
+	t.Helper()
+	authURL, err := provider.AuthCodeURL(context.Background(), flow)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	code, state, err := server.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if state != flow.State {
+		t.Fatalf("state = %q, want %q", state, flow.State)
+	}
+	return code
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+
+	flow := newFlow()
+	authURL, err := provider.AuthCodeURL(context.Background(), flow)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parse %q: %v", authURL, err)
+	}
+	query := parsed.Query()
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             "client",
+		"redirect_uri":          redirectURL,
+		"scope":                 "openid email profile",
+		"state":                 flow.State,
+		"nonce":                 flow.Nonce,
+		"code_challenge":        oidc.Challenge(flow.Verifier),
+		"code_challenge_method": "S256",
+	}
+	for name, value := range want {
+		if got := query.Get(name); got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+	if parsed.Path != "/authorize" {
+		t.Errorf("authorization endpoint = %q, want /authorize from discovery", parsed.Path)
+	}
+}
+
+func TestChallengeS256(t *testing.T) {
+	// Пример из RFC 7636, приложение B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	if got := oidc.Challenge(verifier); got != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Fatalf("Challenge = %q", got)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+	server.Login(alice)
+
+	flow := newFlow()
+	identity, err := provider.Exchange(context.Background(), login(t, server, provider, flow), flow)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	want := dto.ExternalIdentity{
+		Provider:      "mock",
+		Subject:       alice.Subject,
+		Email:         alice.Email,
+		EmailVerified: true,
+		Name:          alice.Name,
+	}
+	if *identity != want {
+		t.Fatalf("identity = %+v, want %+v", *identity, want)
+	}
+}
+
+func TestExchangeRejectsReusedCode(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+	server.Login(alice)
+
+	flow := newFlow()
+	code := login(t, server, provider, flow)
+	if _, err := provider.Exchange(context.Background(), code, flow); err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	_, err := provider.Exchange(context.Background(), code, flow)
+	if !exc.Is(err, errors.ErrOIDCExchange) {
+		t.Fatalf("second Exchange error = %v, want %v", err, errors.ErrOIDCExchange)
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+	server.Login(alice)
+
+	flow := newFlow()
+	code := login(t, server, provider, flow)
+	stolen := *flow
+	stolen.Verifier = "attacker-does-not-know-the-verifier-0123456789abcdef"
+	_, err := provider.Exchange(context.Background(), code, &stolen)
+	if !exc.Is(err, errors.ErrOIDCExchange) {
+		t.Fatalf("Exchange error = %v, want %v", err, errors.ErrOIDCExchange)
+	}
+}
+
+func TestExchangeRejectsWrongClientSecret(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	cfg := server.Provider("mock", redirectURL)
+	cfg.ClientSecret = "wrong"
+	provider := oidc.New(cfg, http.DefaultClient)
+	server.Login(alice)
+
+	flow := newFlow()
+	_, err := provider.Exchange(context.Background(), login(t, server, provider, flow), flow)
+	if !exc.Is(err, errors.ErrOIDCExchange) {
+		t.Fatalf("Exchange error = %v, want %v", err, errors.ErrOIDCExchange)
+	}
+}
+
+func TestIDTokenValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims func(claims jwt.MapClaims)
+		nonce  string
+	}{
+		{
+			name:   "wrong issuer",
+			claims: func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" },
+		},
+		{
+			name:   "wrong audience",
+			claims: func(claims jwt.MapClaims) { claims["aud"] = "another-client" },
+		},
+		{
+			name: "foreign authorized party",
+			claims: func(claims jwt.MapClaims) {
+				claims["aud"] = []string{"client", "another-client"}
+				claims["azp"] = "another-client"
+			},
+		},
+		{
+			name: "expired",
+			claims: func(claims jwt.MapClaims) {
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+			},
+		},
+		{
+			name:   "no expiry",
+			claims: func(claims jwt.MapClaims) { delete(claims, "exp") },
+		},
+		{
+			name: "issued in the future",
+			claims: func(claims jwt.MapClaims) {
+				claims["iat"] = time.Now().Add(time.Hour).Unix()
+			},
+		},
+		{
+			name:  "nonce mismatch",
+			nonce: "replayed-nonce",
+		},
+		{
+			name:   "no subject",
+			claims: func(claims jwt.MapClaims) { claims["sub"] = "" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := oidctest.NewServer("client", "secret")
+			defer server.Close()
+			server.Claims = tt.claims
+			provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+			server.Login(alice)
+
+			flow := newFlow()
+			code := login(t, server, provider, flow)
+			if tt.nonce != "" {
+				flow.Nonce = tt.nonce
+			}
+			_, err := provider.Exchange(context.Background(), code, flow)
+			if !exc.Is(err, errors.ErrOIDCToken) {
+				t.Fatalf("Exchange error = %v, want %v", err, errors.ErrOIDCToken)
+			}
+		})
+	}
+}
+
+func TestIDTokenUnknownKey(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+	server.Login(alice)
+
+	flow := newFlow()
+	if _, err := provider.Exchange(
+		context.Background(),
+		login(t, server, provider, flow),
+		flow,
+	); err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	// Ключи только что загружены, поэтому незнакомый kid не вызывает повторной загрузки
+	server.RotateKey()
+	flow = newFlow()
+	_, err := provider.Exchange(context.Background(), login(t, server, provider, flow), flow)
+	if !exc.Is(err, errors.ErrOIDCToken) {
+		t.Fatalf("Exchange error = %v, want %v", err, errors.ErrOIDCToken)
+	}
+}
+
+func TestEmailVerifiedString(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	server.Claims = func(claims jwt.MapClaims) { claims["email_verified"] = "true" }
+	provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+	server.Login(alice)
+
+	flow := newFlow()
+	identity, err := provider.Exchange(context.Background(), login(t, server, provider, flow), flow)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if !identity.EmailVerified {
+		t.Fatal("email_verified \"true\" is not accepted")
+	}
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	defer server.Close()
+	server.DiscoveryIssuer = "https://evil.example.com"
+	provider := oidc.New(server.Provider("mock", redirectURL), http.DefaultClient)
+
+	_, err := provider.AuthCodeURL(context.Background(), newFlow())
+	if !exc.Is(err, errors.ErrProviderUnavailable) {
+		t.Fatalf("AuthCodeURL error = %v, want %v", err, errors.ErrProviderUnavailable)
+	}
+}
+
+func TestProviderUnavailable(t *testing.T) {
+	server := oidctest.NewServer("client", "secret")
+	cfg := server.Provider("mock", redirectURL)
+	server.Close()
+	provider := oidc.New(cfg, http.DefaultClient)
+
+	_, err := provider.AuthCodeURL(context.Background(), newFlow())
+	if !exc.Is(err, errors.ErrProviderUnavailable) {
+		t.Fatalf("AuthCodeURL error = %v, want %v", err, errors.ErrProviderUnavailable)
+	}
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+type ChallengeRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewChallengeRepository(log interfaces.ILogger, connection *Connection) *ChallengeRepository {
+	return &ChallengeRepository{log, connection}
+}
+
+func (r *ChallengeRepository) CreateChallenge(ctx context.Context, item *dto.Challenge) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.Challenge.Create().
+		SetID(item.ID).
+		SetChallengerID(item.ChallengerID).
+		SetDestUserID(item.DestUserID).
+		SetColor(item.Color).
+		SetStatus(item.Status).
+		SetCreatedAt(item.CreatedAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *ChallengeRepository) Challenge(
+	ctx context.Context,
+	challengeID uuid.UUID,
+) (*dto.Challenge, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	row, err := r.client.Challenge.Get(ctx, challengeID)
+	if ent.IsNotFound(err) {
+		return nil, errors.ErrChallengeNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	return toChallenge(row), nil
+}
+
+// PendingChallenges возвращает входящие и исходящие вызовы пользователя, созданные после since
+// и ещё ожидающие ответа, начиная с новых
+func (r *ChallengeRepository) PendingChallenges(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+) ([]*dto.Challenge, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	rows, err := r.client.Challenge.Query().
+		Where(
+			challenge.Or(challenge.ChallengerID(userID), challenge.DestUserID(userID)),
+			challenge.StatusEQ(challenge.StatusCreated),
+			challenge.CreatedAtGT(since),
+		).
+		Order(ent.Desc(challenge.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	challenges := make([]*dto.Challenge, 0, len(rows))
+	for _, row := range rows {
+		challenges = append(challenges, toChallenge(row))
+	}
+	return challenges, nil
+}
+
+// FinishChallenge переводит ожидающий вызов в status. Возвращает ErrChallengeNotFound, если на вызов
+// уже ответили, поэтому из одновременных ответов проходит только один.
+func (r *ChallengeRepository) FinishChallenge(
+	ctx context.Context,
+	challengeID uuid.UUID,
+	status challenge.Status,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	updated, err := r.client.Challenge.Update().
+		Where(challenge.ID(challengeID), challenge.StatusEQ(challenge.StatusCreated)).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	if updated == 0 {
+		return errors.ErrChallengeNotFound
+	}
+	return nil
+}
+
+func (r *ChallengeRepository) SetChallengeGame(
+	ctx context.Context,
+	challengeID, gameID uuid.UUID,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.Challenge.UpdateOneID(challengeID).SetGameID(gameID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrChallengeNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func toChallenge(row *ent.Challenge) *dto.Challenge {
+	return &dto.Challenge{
+		ID:           row.ID,
+		ChallengerID: row.ChallengerID,
+		DestUserID:   row.DestUserID,
+		Color:        row.Color,
+		Status:       row.Status,
+		GameID:       row.GameID,
+		CreatedAt:    row.CreatedAt,
+	}
+}
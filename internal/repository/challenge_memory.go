@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// MemoryChallengeRepository вызовы в памяти процесса для тестов и демо без базы данных
+type MemoryChallengeRepository struct {
+	users      *MemoryUserRepository
+	challenges map[uuid.UUID]*dto.Challenge
+	mu         sync.Mutex
+}
+
+func NewMemoryChallengeRepository(users *MemoryUserRepository) *MemoryChallengeRepository {
+	return &MemoryChallengeRepository{
+		users:      users,
+		challenges: make(map[uuid.UUID]*dto.Challenge),
+	}
+}
+
+func (r *MemoryChallengeRepository) CreateChallenge(_ context.Context, item *dto.Challenge) error {
+	if _, ok := r.users.player(item.ChallengerID); !ok {
+		return errors.ErrUserNotFound
+	}
+	if _, ok := r.users.player(item.DestUserID); !ok {
+		return errors.ErrUserNotFound
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.challenges[item.ID] = copyChallenge(item)
+	return nil
+}
+
+func (r *MemoryChallengeRepository) Challenge(
+	_ context.Context,
+	challengeID uuid.UUID,
+) (*dto.Challenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.challenges[challengeID]
+	if !ok {
+		return nil, errors.ErrChallengeNotFound
+	}
+	return copyChallenge(stored), nil
+}
+
+func (r *MemoryChallengeRepository) PendingChallenges(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+) ([]*dto.Challenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	challenges := make([]*dto.Challenge, 0)
+	for _, stored := range r.challenges {
+		if stored.ChallengerID != userID && stored.DestUserID != userID {
+			continue
+		}
+		if stored.Status == challenge.StatusCreated && stored.CreatedAt.After(since) {
+			challenges = append(challenges, copyChallenge(stored))
+		}
+	}
+	slices.SortFunc(challenges, func(a, b *dto.Challenge) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return challenges, nil
+}
+
+func (r *MemoryChallengeRepository) FinishChallenge(
+	_ context.Context,
+	challengeID uuid.UUID,
+	status challenge.Status,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.challenges[challengeID]
+	if !ok || stored.Status != challenge.StatusCreated {
+		return errors.ErrChallengeNotFound
+	}
+	stored.Status = status
+	return nil
+}
+
+func (r *MemoryChallengeRepository) SetChallengeGame(
+	_ context.Context,
+	challengeID, gameID uuid.UUID,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.challenges[challengeID]
+	if !ok {
+		return errors.ErrChallengeNotFound
+	}
+	stored.GameID = &gameID
+	return nil
+}
+
+// copyChallenge возвращает копию вызова, не разделяющую партию с хранилищем
+func copyChallenge(item *dto.Challenge) *dto.Challenge {
+	copied := *item
+	if item.GameID != nil {
+		gameID := *item.GameID
+		copied.GameID = &gameID
+	}
+	return &copied
+}
@@ -0,0 +1,1398 @@
+package repository_test
+
+import (
+	"context"
+	exc "errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/ent/chess"
+	"GopherChessParty/ent/usertoken"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+	"github.com/google/uuid"
+)
+
+// repos набор репозиториев одной реализации
+type repos struct {
+	users      interfaces.IUserRepo
+	games      interfaces.IGameRepo
+	sessions   interfaces.ISessionRepo
+	twoFactor  interfaces.ITwoFactorRepo
+	tokens     interfaces.IUserTokenRepo
+	failures   interfaces.ILoginFailureRepo
+	personal   interfaces.IPersonalTokenRepo
+	challenges interfaces.IChallengeRepo
+	identities interfaces.IIdentityRepo
+}
+
+// implementations реализации репозиториев, которые должны вести себя одинаково
+var implementations = map[string]func(t *testing.T) repos{
+	"memory": func(t *testing.T) repos {
+		users := repository.NewMemoryUserRepository()
+		return repos{
+			users:      users,
+			games:      repository.NewMemoryGameRepository(users),
+			sessions:   repository.NewMemorySessionRepository(users),
+			twoFactor:  repository.NewMemoryTwoFactorRepository(users),
+			tokens:     repository.NewMemoryUserTokenRepository(users),
+			failures:   repository.NewMemoryLoginFailureRepository(),
+			personal:   repository.NewMemoryPersonalTokenRepository(users),
+			challenges: repository.NewMemoryChallengeRepository(users),
+			identities: repository.NewMemoryIdentityRepository(users),
+		}
+	},
+	"sqlite": func(t *testing.T) repos {
+		connection := repository.MustNewConnection(dto.Database{
+			Driver:       "sqlite",
+			Path:         filepath.Join(t.TempDir(), "conformance.db"),
+			MaxOpenConns: 4,
+			MaxIdleConns: 4,
+			ReadTimeout:  5 * time.Second,
+			WriteTimeout: 5 * time.Second,
+		})
+		log := logger.New(dto.Application{Env: "prod"})
+		return repos{
+			users:      repository.NewUserRepository(log, connection),
+			games:      repository.NewGameRepository(log, connection),
+			sessions:   repository.NewSessionRepository(log, connection),
+			twoFactor:  repository.NewTwoFactorRepository(log, connection),
+			tokens:     repository.NewUserTokenRepository(log, connection),
+			failures:   repository.NewLoginFailureRepository(log, connection),
+			personal:   repository.NewPersonalTokenRepository(log, connection),
+			challenges: repository.NewChallengeRepository(log, connection),
+			identities: repository.NewIdentityRepository(log, connection),
+		}
+	},
+}
+
+func TestUserRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("CreateAndRead", func(t *testing.T) {
+				testCreateAndReadUser(t, newRepos(t).users)
+			})
+			t.Run("UniqueEmail", func(t *testing.T) {
+				testUniqueEmail(t, newRepos(t).users)
+			})
+			t.Run("NotFound", func(t *testing.T) {
+				testUserNotFound(t, newRepos(t).users)
+			})
+			t.Run("Preferences", func(t *testing.T) {
+				testUserPreferences(t, newRepos(t).users)
+			})
+			t.Run("Account", func(t *testing.T) {
+				testUserAccount(t, newRepos(t).users)
+			})
+		})
+	}
+}
+
+func TestGameRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Create", func(t *testing.T) {
+				testCreateGame(t, newRepos(t))
+			})
+			t.Run("OrderedGames", func(t *testing.T) {
+				testOrderedGames(t, newRepos(t))
+			})
+			t.Run("Moves", func(t *testing.T) {
+				testSaveMoves(t, newRepos(t))
+			})
+			t.Run("Finish", func(t *testing.T) {
+				testFinishGame(t, newRepos(t))
+			})
+			t.Run("NotFound", func(t *testing.T) {
+				testGameNotFound(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func TestSessionRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Rotate", func(t *testing.T) {
+				testRotateSession(t, newRepos(t))
+			})
+			t.Run("Revoke", func(t *testing.T) {
+				testRevokeSessions(t, newRepos(t))
+			})
+			t.Run("List", func(t *testing.T) {
+				testListSessions(t, newRepos(t))
+			})
+			t.Run("RevokeOne", func(t *testing.T) {
+				testRevokeUserSession(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func TestTwoFactorRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Enroll", func(t *testing.T) {
+				testEnrollTOTP(t, newRepos(t))
+			})
+			t.Run("Steps", func(t *testing.T) {
+				testTOTPSteps(t, newRepos(t))
+			})
+			t.Run("RecoveryCodes", func(t *testing.T) {
+				testRecoveryCodes(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func TestUserTokenRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Consume", func(t *testing.T) {
+				testConsumeUserToken(t, newRepos(t))
+			})
+			t.Run("Expired", func(t *testing.T) {
+				testExpiredUserToken(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func TestLoginFailureRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Count", func(t *testing.T) {
+				testCountLoginFailures(t, newRepos(t))
+			})
+			t.Run("Prune", func(t *testing.T) {
+				testPruneLoginFailures(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func TestPersonalTokenRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("CreateAndFind", func(t *testing.T) {
+				testCreatePersonalToken(t, newRepos(t))
+			})
+			t.Run("Revoke", func(t *testing.T) {
+				testRevokePersonalToken(t, newRepos(t))
+			})
+			t.Run("RevokeAll", func(t *testing.T) {
+				testRevokePersonalTokens(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func TestChallengeRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Pending", func(t *testing.T) {
+				testPendingChallenges(t, newRepos(t))
+			})
+			t.Run("Finish", func(t *testing.T) {
+				testFinishChallenge(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func TestIdentityRepoConformance(t *testing.T) {
+	for name, newRepos := range implementations {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Link", func(t *testing.T) {
+				testLinkIdentity(t, newRepos(t))
+			})
+			t.Run("CreateUser", func(t *testing.T) {
+				testCreateUserWithIdentity(t, newRepos(t))
+			})
+		})
+	}
+}
+
+func createUser(t *testing.T, users interfaces.IUserRepo, email string) *dto.User {
+	t.Helper()
+	user, err := users.CreateUser(context.Background(), &dto.CreateUser{
+		Name:     "player " + email,
+		Email:    email,
+		Password: "hashed-" + email,
+	})
+	if err != nil {
+		t.Fatalf("CreateUser(%q): %v", email, err)
+	}
+	return user
+}
+
+func testCreateAndReadUser(t *testing.T, users interfaces.IUserRepo) {
+	ctx := context.Background()
+	created := createUser(t, users, "alice@example.com")
+	if created.ID == uuid.Nil {
+		t.Fatal("created user has no id")
+	}
+	if !created.AutoQueen || created.AbortCount != 0 {
+		t.Fatalf("unexpected defaults: %+v", created)
+	}
+
+	byID, err := users.UserByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("UserByID: %v", err)
+	}
+	if byID.Email != created.Email || byID.Name != created.Name {
+		t.Fatalf("UserByID = %+v, want %+v", byID, created)
+	}
+
+	auth, err := users.UserPassword(ctx, created.Email)
+	if err != nil {
+		t.Fatalf("UserPassword: %v", err)
+	}
+	if auth.UserID != created.ID || auth.HashedPassword != "hashed-alice@example.com" {
+		t.Fatalf("UserPassword = %+v", auth)
+	}
+
+	createUser(t, users, "bob@example.com")
+	all, err := users.Users(ctx)
+	if err != nil {
+		t.Fatalf("Users: %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("Users returned %d users, want 2", len(all))
+	}
+}
+
+func testUniqueEmail(t *testing.T, users interfaces.IUserRepo) {
+	createUser(t, users, "alice@example.com")
+	_, err := users.CreateUser(context.Background(), &dto.CreateUser{
+		Name:     "another alice",
+		Email:    "alice@example.com",
+		Password: "hashed",
+	})
+	if !exc.Is(err, errors.ErrEmailTaken) {
+		t.Fatalf("duplicate email error = %v, want %v", err, errors.ErrEmailTaken)
+	}
+}
+
+func testUserNotFound(t *testing.T, users interfaces.IUserRepo) {
+	ctx := context.Background()
+	missing := uuid.New()
+	if _, err := users.UserByID(ctx, missing); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("UserByID error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+	if _, err := users.UserPassword(ctx, "nobody@example.com"); !exc.Is(
+		err,
+		errors.ErrUserNotFound,
+	) {
+		t.Fatalf("UserPassword error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+	if err := users.SetAutoQueen(ctx, missing, false); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("SetAutoQueen error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+	if err := users.IncrementAborts(ctx, missing); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("IncrementAborts error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+}
+
+func testUserPreferences(t *testing.T, users interfaces.IUserRepo) {
+	ctx := context.Background()
+	user := createUser(t, users, "alice@example.com")
+	if err := users.SetAutoQueen(ctx, user.ID, false); err != nil {
+		t.Fatalf("SetAutoQueen: %v", err)
+	}
+	for range 2 {
+		if err := users.IncrementAborts(ctx, user.ID); err != nil {
+			t.Fatalf("IncrementAborts: %v", err)
+		}
+	}
+	updated, err := users.UserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("UserByID: %v", err)
+	}
+	if updated.AutoQueen || updated.AbortCount != 2 {
+		t.Fatalf("preferences not saved: %+v", updated)
+	}
+	if updated.UpdatedAt.Before(user.UpdatedAt) {
+		t.Fatalf("updated_at moved back: %v < %v", updated.UpdatedAt, user.UpdatedAt)
+	}
+}
+
+func testUserAccount(t *testing.T, users interfaces.IUserRepo) {
+	ctx := context.Background()
+	user := createUser(t, users, "alice@example.com")
+	if user.EmailVerified {
+		t.Fatal("new user has verified email")
+	}
+	if err := users.SetEmailVerified(ctx, user.ID); err != nil {
+		t.Fatalf("SetEmailVerified: %v", err)
+	}
+	if err := users.SetPassword(ctx, user.ID, "new-hash"); err != nil {
+		t.Fatalf("SetPassword: %v", err)
+	}
+	updated, err := users.UserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("UserByID: %v", err)
+	}
+	if !updated.EmailVerified {
+		t.Fatal("email not verified")
+	}
+	auth, err := users.UserPassword(ctx, user.Email)
+	if err != nil {
+		t.Fatalf("UserPassword: %v", err)
+	}
+	if auth.HashedPassword != "new-hash" {
+		t.Fatalf("password = %q, want new-hash", auth.HashedPassword)
+	}
+	if updated.Bot {
+		t.Fatal("new user is a bot")
+	}
+	if err := users.SetBot(ctx, user.ID); err != nil {
+		t.Fatalf("SetBot: %v", err)
+	}
+	if bot, err := users.UserByID(ctx, user.ID); err != nil || !bot.Bot {
+		t.Fatalf("UserByID after SetBot = %+v, %v", bot, err)
+	}
+	if bot, _ := users.UserByID(ctx, user.ID); bot.Role != "user" {
+		t.Fatalf("new user role = %q, want user", bot.Role)
+	}
+	if err := users.SetRole(ctx, user.ID, "moderator"); err != nil {
+		t.Fatalf("SetRole: %v", err)
+	}
+	if moderator, err := users.UserByID(ctx, user.ID); err != nil || moderator.Role != "moderator" {
+		t.Fatalf("UserByID after SetRole = %+v, %v", moderator, err)
+	}
+
+	missing := uuid.New()
+	if err := users.SetEmailVerified(ctx, missing); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("SetEmailVerified error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+	if err := users.SetPassword(ctx, missing, "hash"); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("SetPassword error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+	if err := users.SetBot(ctx, missing); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("SetBot error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+	if err := users.SetRole(ctx, missing, "admin"); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("SetRole error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+}
+
+func testCreateGame(t *testing.T, r repos) {
+	ctx := context.Background()
+	white := createUser(t, r.users, "white@example.com")
+	black := createUser(t, r.users, "black@example.com")
+	game, err := r.games.Create(ctx, white.ID, black.ID)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if game.Status != chess.StatusInProgress || game.Result != chess.ResultInProgress {
+		t.Fatalf("unexpected defaults: %v", game)
+	}
+	if status := r.games.Status(ctx, game.ID); status != chess.StatusInProgress {
+		t.Fatalf("Status = %q, want %q", status, chess.StatusInProgress)
+	}
+
+	match, err := r.games.GameById(ctx, game.ID)
+	if err != nil {
+		t.Fatalf("GameById: %v", err)
+	}
+	if match.WhiteUser.ID != white.ID || match.WhiteUser.Name != white.Name {
+		t.Fatalf("white user = %+v, want %+v", match.WhiteUser, white)
+	}
+	if match.BlackUser.ID != black.ID || match.BlackUser.Name != black.Name {
+		t.Fatalf("black user = %+v, want %+v", match.BlackUser, black)
+	}
+	if match.Termination != nil || len(match.HistoryMove) != 0 {
+		t.Fatalf("new game is not empty: %+v", match)
+	}
+
+	if _, err := r.games.Create(ctx, white.ID, uuid.New()); err == nil {
+		t.Fatal("Create with unknown player succeeded")
+	}
+}
+
+func testOrderedGames(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	carol := createUser(t, r.users, "carol@example.com")
+
+	var created []uuid.UUID
+	for _, pair := range [][2]uuid.UUID{
+		{alice.ID, bob.ID},
+		{carol.ID, alice.ID},
+		{bob.ID, carol.ID},
+		{bob.ID, alice.ID},
+	} {
+		game, err := r.games.Create(ctx, pair[0], pair[1])
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		created = append(created, game.ID)
+		time.Sleep(time.Millisecond)
+	}
+
+	games, err := r.games.Games(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("Games: %v", err)
+	}
+	want := []uuid.UUID{created[3], created[1], created[0]}
+	if len(games) != len(want) {
+		t.Fatalf("Games returned %d games, want %d", len(games), len(want))
+	}
+	for i, game := range games {
+		if game.ID != want[i] {
+			t.Fatalf("Games[%d] = %s, want %s", i, game.ID, want[i])
+		}
+	}
+	if games[0].WhitePlayer.ID != bob.ID || games[0].WhitePlayer.Name != bob.Name {
+		t.Fatalf("white player = %+v, want %+v", games[0].WhitePlayer, bob)
+	}
+	if games[0].BlackPlayer.ID != alice.ID {
+		t.Fatalf("black player = %+v, want %+v", games[0].BlackPlayer, alice)
+	}
+
+	none, err := r.games.Games(ctx, uuid.New())
+	if err != nil {
+		t.Fatalf("Games: %v", err)
+	}
+	if len(none) != 0 {
+		t.Fatalf("Games for unknown user returned %d games", len(none))
+	}
+}
+
+func testSaveMoves(t *testing.T, r repos) {
+	ctx := context.Background()
+	white := createUser(t, r.users, "white@example.com")
+	black := createUser(t, r.users, "black@example.com")
+	game, err := r.games.Create(ctx, white.ID, black.ID)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	moves := []string{"e2e4", "e7e5", "g1f3"}
+	for i, move := range moves {
+		userID := white.ID
+		if i%2 == 1 {
+			userID = black.ID
+		}
+		if err := r.games.SaveMove(ctx, game.ID, move, userID, i+1, nil); err != nil {
+			t.Fatalf("SaveMove(%q): %v", move, err)
+		}
+	}
+
+	match, err := r.games.GameById(ctx, game.ID)
+	if err != nil {
+		t.Fatalf("GameById: %v", err)
+	}
+	if len(match.HistoryMove) != len(moves) {
+		t.Fatalf("GameById returned %d moves, want %d", len(match.HistoryMove), len(moves))
+	}
+	for i, move := range match.HistoryMove {
+		if move.Num != i+1 || move.Move != moves[i] {
+			t.Fatalf("move %d = %+v, want %q", i, move, moves[i])
+		}
+	}
+	if match.HistoryMove[1].UserID != black.ID {
+		t.Fatalf("move 2 user = %s, want %s", match.HistoryMove[1].UserID, black.ID)
+	}
+
+}
+
+func testFinishGame(t *testing.T, r repos) {
+	ctx := context.Background()
+	white := createUser(t, r.users, "white@example.com")
+	black := createUser(t, r.users, "black@example.com")
+	game, err := r.games.Create(ctx, white.ID, black.ID)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	outcome := &dto.GameOutcome{
+		Status:      chess.StatusFinished,
+		Result:      chess.ResultWhiteWon,
+		Termination: chess.TerminationCheckmate,
+	}
+	if err := r.games.SaveMove(ctx, game.ID, "f7f6", white.ID, 1, outcome); err != nil {
+		t.Fatalf("SaveMove: %v", err)
+	}
+
+	match, err := r.games.GameById(ctx, game.ID)
+	if err != nil {
+		t.Fatalf("GameById: %v", err)
+	}
+	if match.Status != chess.StatusFinished || match.Result != chess.ResultWhiteWon {
+		t.Fatalf("game not finished: %+v", match)
+	}
+	if match.Termination == nil || *match.Termination != chess.TerminationCheckmate {
+		t.Fatalf("termination = %v, want %q", match.Termination, chess.TerminationCheckmate)
+	}
+	if len(match.HistoryMove) != 1 {
+		t.Fatalf("GameById returned %d moves, want 1", len(match.HistoryMove))
+	}
+
+	games, err := r.games.Games(ctx, black.ID)
+	if err != nil {
+		t.Fatalf("Games: %v", err)
+	}
+	if len(games) != 1 || games[0].Status != chess.StatusFinished {
+		t.Fatalf("Games = %+v", games)
+	}
+	if games[0].UpdatedAt.Before(games[0].CreatedAt) {
+		t.Fatalf("updated_at %v before created_at %v", games[0].UpdatedAt, games[0].CreatedAt)
+	}
+
+	running, err := r.games.Create(ctx, black.ID, white.ID)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	inProgress, err := r.games.InProgressGames(ctx)
+	if err != nil {
+		t.Fatalf("InProgressGames: %v", err)
+	}
+	if len(inProgress) != 1 || inProgress[0] != running.ID {
+		t.Fatalf("InProgressGames = %v, want [%s]", inProgress, running.ID)
+	}
+}
+
+func testGameNotFound(t *testing.T, r repos) {
+	ctx := context.Background()
+	user := createUser(t, r.users, "alice@example.com")
+	missing := uuid.New()
+	if _, err := r.games.GameById(ctx, missing); !exc.Is(err, errors.ErrGameNotFound) {
+		t.Fatalf("GameById error = %v, want %v", err, errors.ErrGameNotFound)
+	}
+	outcome := &dto.GameOutcome{
+		Status:      chess.StatusAborted,
+		Result:      chess.ResultInProgress,
+		Termination: chess.TerminationAborted,
+	}
+	if err := r.games.UpdateGame(ctx, missing, outcome); !exc.Is(err, errors.ErrGameNotFound) {
+		t.Fatalf("UpdateGame error = %v, want %v", err, errors.ErrGameNotFound)
+	}
+	if err := r.games.SaveMove(ctx, missing, "e2e4", user.ID, 1, nil); err == nil {
+		t.Fatal("SaveMove into unknown game succeeded")
+	}
+	if status := r.games.Status(ctx, missing); status != "" {
+		t.Fatalf("Status = %q, want empty", status)
+	}
+}
+
+func createSession(t *testing.T, r repos, userID uuid.UUID) *dto.Session {
+	t.Helper()
+	now := time.Now()
+	session := &dto.Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		RefreshJTI: uuid.New(),
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(time.Hour),
+		UserAgent:  "Mozilla/5.0",
+		IP:         "192.0.2.1",
+	}
+	if err := r.sessions.CreateSession(context.Background(), session); err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	return session
+}
+
+func rotate(t *testing.T, r repos, session *dto.Session, oldJTI, newJTI uuid.UUID) bool {
+	t.Helper()
+	rotated, err := r.sessions.RotateSession(
+		context.Background(),
+		session.ID,
+		oldJTI,
+		newJTI,
+		time.Now().Add(time.Hour),
+		dto.Device{UserAgent: "Mozilla/5.0", IP: "198.51.100.7"},
+	)
+	if err != nil {
+		t.Fatalf("RotateSession: %v", err)
+	}
+	return rotated
+}
+
+func testRotateSession(t *testing.T, r repos) {
+	user := createUser(t, r.users, "alice@example.com")
+	session := createSession(t, r, user.ID)
+
+	second := uuid.New()
+	if !rotate(t, r, session, session.RefreshJTI, second) {
+		t.Fatal("rotation with the current token failed")
+	}
+	if rotate(t, r, session, session.RefreshJTI, uuid.New()) {
+		t.Fatal("rotation with an already rotated token succeeded")
+	}
+	if !rotate(t, r, session, second, uuid.New()) {
+		t.Fatal("rotation with the latest token failed")
+	}
+	if rotate(t, r, &dto.Session{ID: uuid.New()}, second, uuid.New()) {
+		t.Fatal("rotation of an unknown session succeeded")
+	}
+}
+
+func testRevokeSessions(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	first := createSession(t, r, alice.ID)
+	second := createSession(t, r, alice.ID)
+	other := createSession(t, r, bob.ID)
+
+	if err := r.sessions.RevokeSession(ctx, first.ID); err != nil {
+		t.Fatalf("RevokeSession: %v", err)
+	}
+	if rotate(t, r, first, first.RefreshJTI, uuid.New()) {
+		t.Fatal("rotation of a revoked session succeeded")
+	}
+	if !rotate(t, r, second, second.RefreshJTI, second.RefreshJTI) {
+		t.Fatal("revoking one session revoked another")
+	}
+
+	if err := r.sessions.RevokeUserSessions(ctx, alice.ID); err != nil {
+		t.Fatalf("RevokeUserSessions: %v", err)
+	}
+	if rotate(t, r, second, second.RefreshJTI, uuid.New()) {
+		t.Fatal("rotation after logout everywhere succeeded")
+	}
+	if !rotate(t, r, other, other.RefreshJTI, uuid.New()) {
+		t.Fatal("logout everywhere revoked another user's session")
+	}
+}
+
+func testListSessions(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	older := createSession(t, r, alice.ID)
+	time.Sleep(time.Millisecond)
+	newer := createSession(t, r, alice.ID)
+	revoked := createSession(t, r, alice.ID)
+	createSession(t, r, bob.ID)
+	if err := r.sessions.RevokeSession(ctx, revoked.ID); err != nil {
+		t.Fatalf("RevokeSession: %v", err)
+	}
+
+	sessions, err := r.sessions.Sessions(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("Sessions: %v", err)
+	}
+	if len(sessions) != 2 || sessions[0].ID != newer.ID || sessions[1].ID != older.ID {
+		t.Fatalf("Sessions = %+v, want [%s %s]", sessions, newer.ID, older.ID)
+	}
+	if sessions[0].UserAgent != "Mozilla/5.0" || sessions[0].IP != "192.0.2.1" {
+		t.Fatalf("device not saved: %+v", sessions[0])
+	}
+
+	time.Sleep(time.Millisecond)
+	if !rotate(t, r, older, older.RefreshJTI, uuid.New()) {
+		t.Fatal("rotation with the current token failed")
+	}
+	sessions, err = r.sessions.Sessions(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("Sessions: %v", err)
+	}
+	if sessions[0].ID != older.ID || sessions[0].IP != "198.51.100.7" {
+		t.Fatalf(
+			"rotated session = %+v, want %s used last from 198.51.100.7",
+			sessions[0],
+			older.ID,
+		)
+	}
+}
+
+func testRevokeUserSession(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	session := createSession(t, r, alice.ID)
+	before := time.Now().Add(-time.Second)
+
+	err := r.sessions.RevokeUserSession(ctx, bob.ID, session.ID)
+	if !exc.Is(err, errors.ErrSessionNotFound) {
+		t.Fatalf(
+			"revoking another user's session error = %v, want %v",
+			err,
+			errors.ErrSessionNotFound,
+		)
+	}
+	if err := r.sessions.RevokeUserSession(ctx, alice.ID, session.ID); err != nil {
+		t.Fatalf("RevokeUserSession: %v", err)
+	}
+	err = r.sessions.RevokeUserSession(ctx, alice.ID, session.ID)
+	if !exc.Is(err, errors.ErrSessionNotFound) {
+		t.Fatalf("revoking twice error = %v, want %v", err, errors.ErrSessionNotFound)
+	}
+
+	revoked, err := r.sessions.RevokedSessions(ctx, before)
+	if err != nil {
+		t.Fatalf("RevokedSessions: %v", err)
+	}
+	if len(revoked) != 1 || revoked[0].ID != session.ID || revoked[0].RevokedAt == nil {
+		t.Fatalf("RevokedSessions = %+v, want [%s]", revoked, session.ID)
+	}
+	later, err := r.sessions.RevokedSessions(ctx, time.Now().Add(time.Second))
+	if err != nil {
+		t.Fatalf("RevokedSessions: %v", err)
+	}
+	if len(later) != 0 {
+		t.Fatalf("RevokedSessions after the revocation returned %d sessions", len(later))
+	}
+}
+
+func enableTOTP(t *testing.T, r repos, userID uuid.UUID, hashes ...string) {
+	t.Helper()
+	ctx := context.Background()
+	if err := r.twoFactor.StartTOTP(ctx, userID, "JBSWY3DPEHPK3PXP", hashes); err != nil {
+		t.Fatalf("StartTOTP: %v", err)
+	}
+	confirmed, err := r.twoFactor.ConfirmTOTP(ctx, userID, 100)
+	if err != nil {
+		t.Fatalf("ConfirmTOTP: %v", err)
+	}
+	if !confirmed {
+		t.Fatal("ConfirmTOTP of a pending secret failed")
+	}
+}
+
+func testEnrollTOTP(t *testing.T, r repos) {
+	ctx := context.Background()
+	user := createUser(t, r.users, "alice@example.com")
+	totp, err := r.twoFactor.TOTP(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("TOTP: %v", err)
+	}
+	if totp.Secret != "" || totp.Enabled {
+		t.Fatalf("new user has 2FA: %+v", totp)
+	}
+	if _, err := r.twoFactor.TOTP(ctx, uuid.New()); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("TOTP error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+
+	if err := r.twoFactor.StartTOTP(ctx, user.ID, "OLDSECRET", nil); err != nil {
+		t.Fatalf("StartTOTP: %v", err)
+	}
+	enableTOTP(t, r, user.ID)
+	totp, err = r.twoFactor.TOTP(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("TOTP: %v", err)
+	}
+	if totp.Secret != "JBSWY3DPEHPK3PXP" || !totp.Enabled || totp.LastStep != 100 {
+		t.Fatalf("TOTP = %+v, want the confirmed secret", totp)
+	}
+
+	err = r.twoFactor.StartTOTP(ctx, user.ID, "ANOTHERSECRET", nil)
+	if !exc.Is(err, errors.ErrTOTPEnabled) {
+		t.Fatalf("StartTOTP over enabled 2FA error = %v, want %v", err, errors.ErrTOTPEnabled)
+	}
+	if confirmed, _ := r.twoFactor.ConfirmTOTP(ctx, user.ID, 200); confirmed {
+		t.Fatal("ConfirmTOTP of enabled 2FA succeeded")
+	}
+
+	if err := r.twoFactor.DisableTOTP(ctx, user.ID); err != nil {
+		t.Fatalf("DisableTOTP: %v", err)
+	}
+	totp, err = r.twoFactor.TOTP(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("TOTP: %v", err)
+	}
+	if totp.Secret != "" || totp.Enabled {
+		t.Fatalf("2FA left after disabling: %+v", totp)
+	}
+}
+
+func testTOTPSteps(t *testing.T, r repos) {
+	ctx := context.Background()
+	user := createUser(t, r.users, "alice@example.com")
+	if used, _ := r.twoFactor.UseTOTPStep(ctx, user.ID, 101); used {
+		t.Fatal("step accepted without 2FA")
+	}
+	enableTOTP(t, r, user.ID)
+
+	for _, tc := range []struct {
+		step int64
+		want bool
+	}{
+		{100, false}, // Шаг подтверждения уже принят
+		{101, true},
+		{101, false},
+		{99, false},
+		{103, true},
+	} {
+		used, err := r.twoFactor.UseTOTPStep(ctx, user.ID, tc.step)
+		if err != nil {
+			t.Fatalf("UseTOTPStep(%d): %v", tc.step, err)
+		}
+		if used != tc.want {
+			t.Fatalf("UseTOTPStep(%d) = %v, want %v", tc.step, used, tc.want)
+		}
+	}
+}
+
+func testRecoveryCodes(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	enableTOTP(t, r, alice.ID, "hash-1", "hash-2")
+	enableTOTP(t, r, bob.ID, "hash-3")
+
+	for _, tc := range []struct {
+		user uuid.UUID
+		hash string
+		want bool
+	}{
+		{alice.ID, "hash-1", true},
+		{alice.ID, "hash-1", false},
+		{alice.ID, "hash-3", false},
+		{alice.ID, "unknown", false},
+		{bob.ID, "hash-3", true},
+	} {
+		used, err := r.twoFactor.UseRecoveryCode(ctx, tc.user, tc.hash)
+		if err != nil {
+			t.Fatalf("UseRecoveryCode(%q): %v", tc.hash, err)
+		}
+		if used != tc.want {
+			t.Fatalf("UseRecoveryCode(%q) = %v, want %v", tc.hash, used, tc.want)
+		}
+	}
+
+	if err := r.twoFactor.DisableTOTP(ctx, alice.ID); err != nil {
+		t.Fatalf("DisableTOTP: %v", err)
+	}
+	enableTOTP(t, r, alice.ID, "hash-4")
+	if used, _ := r.twoFactor.UseRecoveryCode(ctx, alice.ID, "hash-2"); used {
+		t.Fatal("recovery code survived re-enrollment")
+	}
+	if used, _ := r.twoFactor.UseRecoveryCode(ctx, alice.ID, "hash-4"); !used {
+		t.Fatal("new recovery code rejected")
+	}
+}
+
+func createUserToken(
+	t *testing.T,
+	r repos,
+	userID uuid.UUID,
+	purpose usertoken.Purpose,
+	hash string,
+	ttl time.Duration,
+) {
+	t.Helper()
+	now := time.Now()
+	err := r.tokens.CreateUserToken(context.Background(), &dto.UserToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hash,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	})
+	if err != nil {
+		t.Fatalf("CreateUserToken(%q): %v", hash, err)
+	}
+}
+
+func testConsumeUserToken(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	createUserToken(t, r, alice.ID, usertoken.PurposeVerifyEmail, "verify-1", time.Hour)
+	createUserToken(t, r, alice.ID, usertoken.PurposeVerifyEmail, "verify-2", time.Hour)
+	createUserToken(t, r, alice.ID, usertoken.PurposeResetPassword, "reset-1", time.Hour)
+	createUserToken(t, r, bob.ID, usertoken.PurposeVerifyEmail, "verify-3", time.Hour)
+
+	if _, err := r.tokens.ConsumeUserToken(
+		ctx,
+		usertoken.PurposeResetPassword,
+		"verify-1",
+	); !exc.Is(err, errors.ErrTokenInvalid) {
+		t.Fatalf("token consumed for another purpose: %v", err)
+	}
+	userID, err := r.tokens.ConsumeUserToken(ctx, usertoken.PurposeVerifyEmail, "verify-1")
+	if err != nil {
+		t.Fatalf("ConsumeUserToken: %v", err)
+	}
+	if userID != alice.ID {
+		t.Fatalf("ConsumeUserToken = %v, want %v", userID, alice.ID)
+	}
+
+	// Токен одноразовый, а более ранние и поздние токены того же назначения гасятся вместе с ним
+	for _, hash := range []string{"verify-1", "verify-2", "unknown"} {
+		if _, err := r.tokens.ConsumeUserToken(
+			ctx,
+			usertoken.PurposeVerifyEmail,
+			hash,
+		); !exc.Is(err, errors.ErrTokenInvalid) {
+			t.Fatalf("ConsumeUserToken(%q) error = %v, want %v", hash, err, errors.ErrTokenInvalid)
+		}
+	}
+	if _, err := r.tokens.ConsumeUserToken(ctx, usertoken.PurposeResetPassword, "reset-1"); err != nil {
+		t.Fatalf("token of another purpose invalidated: %v", err)
+	}
+	if _, err := r.tokens.ConsumeUserToken(ctx, usertoken.PurposeVerifyEmail, "verify-3"); err != nil {
+		t.Fatalf("token of another user invalidated: %v", err)
+	}
+}
+
+func testExpiredUserToken(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	createUserToken(t, r, alice.ID, usertoken.PurposeResetPassword, "expired", -time.Minute)
+	if _, err := r.tokens.ConsumeUserToken(
+		ctx,
+		usertoken.PurposeResetPassword,
+		"expired",
+	); !exc.Is(err, errors.ErrTokenInvalid) {
+		t.Fatalf("expired token error = %v, want %v", err, errors.ErrTokenInvalid)
+	}
+
+	err := r.tokens.CreateUserToken(ctx, &dto.UserToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Purpose:   usertoken.PurposeVerifyEmail,
+		TokenHash: "orphan",
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	if !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("token for missing user error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+}
+
+func addLoginFailure(t *testing.T, r repos, key string, at time.Time) int {
+	t.Helper()
+	failure, err := r.failures.AddLoginFailure(context.Background(), key, at, time.Hour)
+	if err != nil {
+		t.Fatalf("AddLoginFailure(%q): %v", key, err)
+	}
+	if failure.Key != key || !failure.LastFailedAt.Equal(at) {
+		t.Fatalf("AddLoginFailure(%q) = %+v", key, failure)
+	}
+	return failure.Count
+}
+
+func testCountLoginFailures(t *testing.T, r repos) {
+	ctx := context.Background()
+	start := time.Now().Truncate(time.Second)
+	for i, at := range []time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute)} {
+		if count := addLoginFailure(t, r, "email:alice@example.com", at); count != i+1 {
+			t.Fatalf("failure %d counted as %d", i+1, count)
+		}
+	}
+	addLoginFailure(t, r, "ip:10.0.0.1", start)
+
+	failures, err := r.failures.LoginFailures(
+		ctx,
+		"email:alice@example.com",
+		"ip:10.0.0.1",
+		"ip:unknown",
+	)
+	if err != nil {
+		t.Fatalf("LoginFailures: %v", err)
+	}
+	counts := make(map[string]int)
+	for _, failure := range failures {
+		counts[failure.Key] = failure.Count
+	}
+	if len(counts) != 2 || counts["email:alice@example.com"] != 3 || counts["ip:10.0.0.1"] != 1 {
+		t.Fatalf("LoginFailures = %v", counts)
+	}
+
+	// Неудача после окна учёта начинает счётчик заново
+	if count := addLoginFailure(t, r, "email:alice@example.com", start.Add(3*time.Hour)); count != 1 {
+		t.Fatalf("failure after window counted as %d, want 1", count)
+	}
+
+	if err := r.failures.ClearLoginFailures(ctx, "email:alice@example.com"); err != nil {
+		t.Fatalf("ClearLoginFailures: %v", err)
+	}
+	failures, err = r.failures.LoginFailures(ctx, "email:alice@example.com")
+	if err != nil {
+		t.Fatalf("LoginFailures: %v", err)
+	}
+	if len(failures) != 0 {
+		t.Fatalf("cleared failures returned: %+v", failures[0])
+	}
+}
+
+func testPruneLoginFailures(t *testing.T, r repos) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	addLoginFailure(t, r, "ip:10.0.0.1", now.Add(-2*time.Hour))
+	addLoginFailure(t, r, "ip:10.0.0.2", now)
+	if err := r.failures.PruneLoginFailures(ctx, now.Add(-time.Hour)); err != nil {
+		t.Fatalf("PruneLoginFailures: %v", err)
+	}
+	failures, err := r.failures.LoginFailures(ctx, "ip:10.0.0.1", "ip:10.0.0.2")
+	if err != nil {
+		t.Fatalf("LoginFailures: %v", err)
+	}
+	if len(failures) != 1 || failures[0].Key != "ip:10.0.0.2" {
+		t.Fatalf("LoginFailures after prune = %d entries", len(failures))
+	}
+}
+
+func createPersonalToken(
+	t *testing.T,
+	r repos,
+	userID uuid.UUID,
+	hash string,
+	createdAt time.Time,
+	expiresAt *time.Time,
+) *dto.PersonalToken {
+	t.Helper()
+	token := &dto.PersonalToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      "script " + hash,
+		TokenHash: hash,
+		Scopes:    []dto.Scope{dto.ScopeBotPlay, dto.ScopeGamesRead},
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+	}
+	if err := r.personal.CreatePersonalToken(context.Background(), token); err != nil {
+		t.Fatalf("CreatePersonalToken(%q): %v", hash, err)
+	}
+	return token
+}
+
+func testCreatePersonalToken(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	now := time.Now().Truncate(time.Second)
+	expired := now.Add(-time.Minute)
+	older := createPersonalToken(t, r, alice.ID, "hash-1", now.Add(-time.Hour), nil)
+	newer := createPersonalToken(t, r, alice.ID, "hash-2", now, nil)
+	createPersonalToken(t, r, alice.ID, "hash-3", now, &expired)
+
+	found, err := r.personal.PersonalTokenByHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("PersonalTokenByHash: %v", err)
+	}
+	if found.ID != older.ID || found.UserID != alice.ID || found.Name != older.Name ||
+		!found.HasAnyScope(dto.ScopeBotPlay) || found.HasAnyScope(dto.ScopeChallengesWrite) {
+		t.Fatalf("PersonalTokenByHash = %+v, want %+v", found, older)
+	}
+	for _, hash := range []string{"hash-3", "unknown"} {
+		if _, err := r.personal.PersonalTokenByHash(ctx, hash); !exc.Is(
+			err,
+			errors.ErrTokenInvalid,
+		) {
+			t.Fatalf(
+				"PersonalTokenByHash(%q) error = %v, want %v",
+				hash,
+				err,
+				errors.ErrTokenInvalid,
+			)
+		}
+	}
+
+	if err := r.personal.TouchPersonalToken(ctx, newer.ID, now); err != nil {
+		t.Fatalf("TouchPersonalToken: %v", err)
+	}
+	tokens, err := r.personal.PersonalTokens(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("PersonalTokens: %v", err)
+	}
+	if len(tokens) != 2 || tokens[0].ID != newer.ID || tokens[1].ID != older.ID {
+		t.Fatalf("PersonalTokens returned %d tokens in wrong order", len(tokens))
+	}
+	if tokens[0].LastUsedAt == nil || !tokens[0].LastUsedAt.Equal(now) ||
+		tokens[1].LastUsedAt != nil {
+		t.Fatalf("last used at = %v, %v", tokens[0].LastUsedAt, tokens[1].LastUsedAt)
+	}
+
+	err = r.personal.CreatePersonalToken(ctx, &dto.PersonalToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Name:      "orphan",
+		TokenHash: "orphan",
+		Scopes:    []dto.Scope{dto.ScopeGamesRead},
+		CreatedAt: now,
+	})
+	if !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("token for missing user error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+}
+
+func testRevokePersonalToken(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	token := createPersonalToken(t, r, alice.ID, "hash-1", time.Now(), nil)
+
+	if err := r.personal.RevokePersonalToken(
+		ctx,
+		bob.ID,
+		token.ID,
+	); !exc.Is(err, errors.ErrPersonalTokenNotFound) {
+		t.Fatalf("foreign revoke error = %v, want %v", err, errors.ErrPersonalTokenNotFound)
+	}
+	if err := r.personal.RevokePersonalToken(ctx, alice.ID, token.ID); err != nil {
+		t.Fatalf("RevokePersonalToken: %v", err)
+	}
+	if err := r.personal.RevokePersonalToken(
+		ctx,
+		alice.ID,
+		token.ID,
+	); !exc.Is(err, errors.ErrPersonalTokenNotFound) {
+		t.Fatalf("second revoke error = %v, want %v", err, errors.ErrPersonalTokenNotFound)
+	}
+	if _, err := r.personal.PersonalTokenByHash(ctx, "hash-1"); !exc.Is(
+		err,
+		errors.ErrTokenInvalid,
+	) {
+		t.Fatalf("revoked token error = %v, want %v", err, errors.ErrTokenInvalid)
+	}
+	tokens, err := r.personal.PersonalTokens(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("PersonalTokens: %v", err)
+	}
+	if len(tokens) != 0 {
+		t.Fatalf("revoked token listed: %+v", tokens[0])
+	}
+}
+
+func testRevokePersonalTokens(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	now := time.Now()
+	createPersonalToken(t, r, alice.ID, "hash-1", now, nil)
+	createPersonalToken(t, r, alice.ID, "hash-2", now.Add(time.Second), nil)
+	createPersonalToken(t, r, bob.ID, "hash-3", now, nil)
+
+	if err := r.personal.RevokePersonalTokens(ctx, alice.ID); err != nil {
+		t.Fatalf("RevokePersonalTokens: %v", err)
+	}
+	if tokens, err := r.personal.PersonalTokens(ctx, alice.ID); err != nil || len(tokens) != 0 {
+		t.Fatalf("PersonalTokens after revoke = %+v, %v, want none", tokens, err)
+	}
+	if _, err := r.personal.PersonalTokenByHash(ctx, "hash-2"); !exc.Is(
+		err,
+		errors.ErrTokenInvalid,
+	) {
+		t.Fatalf("revoked token error = %v, want %v", err, errors.ErrTokenInvalid)
+	}
+	if _, err := r.personal.PersonalTokenByHash(ctx, "hash-3"); err != nil {
+		t.Fatalf("token of another user: %v", err)
+	}
+	// Пользователь без токенов — не ошибка
+	if err := r.personal.RevokePersonalTokens(ctx, alice.ID); err != nil {
+		t.Fatalf("second RevokePersonalTokens: %v", err)
+	}
+}
+
+func createChallenge(
+	t *testing.T,
+	r repos,
+	challengerID, destUserID uuid.UUID,
+	createdAt time.Time,
+) *dto.Challenge {
+	t.Helper()
+	item := &dto.Challenge{
+		ID:           uuid.New(),
+		ChallengerID: challengerID,
+		DestUserID:   destUserID,
+		Color:        challenge.ColorWhite,
+		Status:       challenge.StatusCreated,
+		CreatedAt:    createdAt,
+	}
+	if err := r.challenges.CreateChallenge(context.Background(), item); err != nil {
+		t.Fatalf("CreateChallenge: %v", err)
+	}
+	return item
+}
+
+func testPendingChallenges(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bot := createUser(t, r.users, "bot@example.com")
+	carol := createUser(t, r.users, "carol@example.com")
+	now := time.Now().Truncate(time.Second)
+	stale := createChallenge(t, r, alice.ID, bot.ID, now.Add(-time.Hour))
+	outgoing := createChallenge(t, r, alice.ID, bot.ID, now.Add(-time.Minute))
+	incoming := createChallenge(t, r, carol.ID, alice.ID, now)
+	createChallenge(t, r, carol.ID, bot.ID, now)
+
+	found, err := r.challenges.Challenge(ctx, stale.ID)
+	if err != nil {
+		t.Fatalf("Challenge: %v", err)
+	}
+	if found.ChallengerID != alice.ID || found.DestUserID != bot.ID ||
+		found.Color != challenge.ColorWhite || found.Status != challenge.StatusCreated ||
+		found.GameID != nil {
+		t.Fatalf("Challenge = %+v, want %+v", found, stale)
+	}
+	if _, err := r.challenges.Challenge(ctx, uuid.New()); !exc.Is(
+		err,
+		errors.ErrChallengeNotFound,
+	) {
+		t.Fatalf("missing challenge error = %v, want %v", err, errors.ErrChallengeNotFound)
+	}
+
+	pending, err := r.challenges.PendingChallenges(ctx, alice.ID, now.Add(-10*time.Minute))
+	if err != nil {
+		t.Fatalf("PendingChallenges: %v", err)
+	}
+	if len(pending) != 2 || pending[0].ID != incoming.ID || pending[1].ID != outgoing.ID {
+		t.Fatalf("PendingChallenges returned %d challenges in wrong order", len(pending))
+	}
+
+	err = r.challenges.CreateChallenge(ctx, &dto.Challenge{
+		ID:           uuid.New(),
+		ChallengerID: alice.ID,
+		DestUserID:   uuid.New(),
+		Color:        challenge.ColorRandom,
+		Status:       challenge.StatusCreated,
+		CreatedAt:    now,
+	})
+	if !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("challenge to missing user error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+}
+
+func testFinishChallenge(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bot := createUser(t, r.users, "bot@example.com")
+	item := createChallenge(t, r, alice.ID, bot.ID, time.Now())
+
+	if err := r.challenges.FinishChallenge(ctx, item.ID, challenge.StatusAccepted); err != nil {
+		t.Fatalf("FinishChallenge: %v", err)
+	}
+	if err := r.challenges.FinishChallenge(
+		ctx,
+		item.ID,
+		challenge.StatusCanceled,
+	); !exc.Is(err, errors.ErrChallengeNotFound) {
+		t.Fatalf("second answer error = %v, want %v", err, errors.ErrChallengeNotFound)
+	}
+	game, err := r.games.Create(ctx, alice.ID, bot.ID)
+	if err != nil {
+		t.Fatalf("Create game: %v", err)
+	}
+	if err := r.challenges.SetChallengeGame(ctx, item.ID, game.ID); err != nil {
+		t.Fatalf("SetChallengeGame: %v", err)
+	}
+	found, err := r.challenges.Challenge(ctx, item.ID)
+	if err != nil {
+		t.Fatalf("Challenge: %v", err)
+	}
+	if found.Status != challenge.StatusAccepted || found.GameID == nil || *found.GameID != game.ID {
+		t.Fatalf("accepted challenge = %+v", found)
+	}
+	pending, err := r.challenges.PendingChallenges(ctx, bot.ID, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("PendingChallenges: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("answered challenge listed: %+v", pending[0])
+	}
+}
+
+func testLinkIdentity(t *testing.T, r repos) {
+	ctx := context.Background()
+	alice := createUser(t, r.users, "alice@example.com")
+	bob := createUser(t, r.users, "bob@example.com")
+	now := time.Now()
+	link := func(userID uuid.UUID, provider, subject string, createdAt time.Time) error {
+		return r.identities.CreateIdentity(ctx, &dto.Identity{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Provider:  provider,
+			Subject:   subject,
+			Email:     "alice@example.com",
+			CreatedAt: createdAt,
+		})
+	}
+
+	_, err := r.identities.IdentityUser(ctx, "google", "sub-1")
+	if !exc.Is(err, errors.ErrIdentityNotFound) {
+		t.Fatalf("IdentityUser before link error = %v, want %v", err, errors.ErrIdentityNotFound)
+	}
+	if err := link(alice.ID, "google", "sub-1", now); err != nil {
+		t.Fatalf("CreateIdentity: %v", err)
+	}
+	if err := link(alice.ID, "gitlab", "sub-1", now.Add(time.Second)); err != nil {
+		t.Fatalf("CreateIdentity for another provider: %v", err)
+	}
+	if userID, err := r.identities.IdentityUser(ctx, "google", "sub-1"); err != nil ||
+		userID != alice.ID {
+		t.Fatalf("IdentityUser = %v, %v, want %v", userID, err, alice.ID)
+	}
+	if err := link(bob.ID, "google", "sub-1", now); !exc.Is(err, errors.ErrIdentityTaken) {
+		t.Fatalf("CreateIdentity taken error = %v, want %v", err, errors.ErrIdentityTaken)
+	}
+	if err := link(uuid.New(), "google", "sub-2", now); !exc.Is(err, errors.ErrUserNotFound) {
+		t.Fatalf("CreateIdentity for missing user error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+
+	items, err := r.identities.Identities(ctx, alice.ID)
+	if err != nil {
+		t.Fatalf("Identities: %v", err)
+	}
+	if len(items) != 2 || items[0].Provider != "google" || items[1].Provider != "gitlab" {
+		t.Fatalf("Identities = %+v, want google then gitlab", items)
+	}
+	if items, _ := r.identities.Identities(ctx, bob.ID); len(items) != 0 {
+		t.Fatalf("Identities of bob = %+v, want none", items)
+	}
+}
+
+func testCreateUserWithIdentity(t *testing.T, r repos) {
+	ctx := context.Background()
+	create := func(email, subject string) (*dto.User, error) {
+		return r.identities.CreateUserWithIdentity(ctx, &dto.CreateUser{
+			Name:     "carol",
+			Email:    email,
+			Password: "hashed",
+		}, &dto.Identity{
+			ID:        uuid.New(),
+			Provider:  "google",
+			Subject:   subject,
+			Email:     email,
+			CreatedAt: time.Now(),
+		})
+	}
+	long := strings.Repeat("c", 200) + "@example.com"
+	carol, err := create(long, "sub-1")
+	if err != nil {
+		t.Fatalf("CreateUserWithIdentity: %v", err)
+	}
+	if !carol.EmailVerified || carol.Email != long {
+		t.Fatalf("created user = %+v, want verified with long email", carol)
+	}
+	if userID, err := r.identities.IdentityUser(ctx, "google", "sub-1"); err != nil ||
+		userID != carol.ID {
+		t.Fatalf("IdentityUser = %v, %v, want %v", userID, err, carol.ID)
+	}
+	found, err := r.users.UserByEmail(ctx, strings.ToUpper(long))
+	if err != nil || found.ID != carol.ID {
+		t.Fatalf("UserByEmail = %+v, %v, want %v", found, err, carol.ID)
+	}
+
+	// Неудачная привязка не оставляет аккаунт без неё
+	if _, err := create("dave@example.com", "sub-1"); !exc.Is(err, errors.ErrIdentityTaken) {
+		t.Fatalf("taken identity error = %v, want %v", err, errors.ErrIdentityTaken)
+	}
+	if _, err := r.users.UserByEmail(ctx, "dave@example.com"); !exc.Is(
+		err,
+		errors.ErrUserNotFound,
+	) {
+		t.Fatalf("UserByEmail after rollback error = %v, want %v", err, errors.ErrUserNotFound)
+	}
+	if _, err := create(long, "sub-2"); !exc.Is(err, errors.ErrEmailTaken) {
+		t.Fatalf("taken email error = %v, want %v", err, errors.ErrEmailTaken)
+	}
+	if _, err := r.identities.IdentityUser(ctx, "google", "sub-2"); !exc.Is(
+		err,
+		errors.ErrIdentityNotFound,
+	) {
+		t.Fatalf("IdentityUser after rollback error = %v, want %v", err, errors.ErrIdentityNotFound)
+	}
+}
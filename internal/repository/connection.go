@@ -1,19 +1,39 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"GopherChessParty/ent"
+	"GopherChessParty/ent/chess"
 	"GopherChessParty/internal/dto"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 )
 
 type Connection struct {
-	client *ent.Client
+	client       *ent.Client
+	readTimeout  time.Duration
+	writeTimeout time.Duration
 }
 
 // MustNewConnection Создание нового подключения.
 func MustNewConnection(cfg dto.Database) *Connection {
-	drv, err := sql.Open("postgres", cfg.Url())
+	switch cfg.Driver {
+	case "postgres":
+		return mustNewPostgresConnection(cfg)
+	case "sqlite":
+		return mustNewSQLiteConnection(cfg)
+	default:
+		panic(fmt.Sprintf("unknown database driver %q", cfg.Driver))
+	}
+}
+
+func mustNewPostgresConnection(cfg dto.Database) *Connection {
+	drv, err := sql.Open(dialect.Postgres, cfg.Url())
 	if err != nil {
 		panic(err)
 	}
@@ -23,5 +43,92 @@ func MustNewConnection(cfg dto.Database) *Connection {
 	db.SetMaxOpenConns(cfg.MaxOpenConns)   // Максимальное количество открытых соединений.
 	db.SetConnMaxLifetime(cfg.MaxTimeLife) // Максимальное время жизни одного соединения.
 
-	return &Connection{client: ent.NewClient(ent.Driver(drv))}
+	return newConnection(drv, cfg)
+}
+
+// mustNewSQLiteConnection открывает файл SQLite и сразу применяет схему: отдельного шага
+// миграций для небольшого клубного сервера нет
+func mustNewSQLiteConnection(cfg dto.Database) *Connection {
+	drv, err := sql.Open(dialect.SQLite, cfg.SQLiteDSN())
+	if err != nil {
+		panic(err)
+	}
+
+	// В режиме WAL соединения пула читают параллельно, а запись SQLite сама выполняет по одной:
+	// остальные пишущие соединения ждут освобождения блокировки до busy_timeout
+	db := drv.DB()
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+
+	connection := newConnection(drv, cfg)
+	ctx, cancel := connection.writeContext(context.Background())
+	defer cancel()
+	addsEmailVerified, err := missingColumn(ctx, drv, "users", "email_verified")
+	if err != nil {
+		panic(err)
+	}
+	if err := connection.client.Schema.Create(ctx); err != nil {
+		panic(err)
+	}
+	// Аккаунты, созданные до подтверждения почты, остаются с доступом к поиску
+	if addsEmailVerified {
+		err = connection.client.User.Update().SetEmailVerified(true).Exec(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}
+	// Незавершённые партии, созданные со старым статусом по умолчанию, восстанавливаются как идущие
+	err = connection.client.Chess.Update().
+		Where(chess.StatusEQ(chess.StatusWaiting), chess.ResultEQ(chess.ResultInProgress)).
+		SetStatus(chess.StatusInProgress).
+		Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return connection
+}
+
+// missingColumn сообщает, что таблица SQLite уже создана, но столбца в ней ещё нет
+func missingColumn(ctx context.Context, drv *sql.Driver, table, column string) (bool, error) {
+	var columns, found int
+	err := drv.DB().QueryRowContext(
+		ctx,
+		"SELECT COUNT(*), COUNT(CASE WHEN name = ? THEN 1 END) FROM pragma_table_info(?)",
+		column,
+		table,
+	).Scan(&columns, &found)
+	return columns > 0 && found == 0, err
+}
+
+func newConnection(drv *sql.Driver, cfg dto.Database) *Connection {
+	return &Connection{
+		client:       ent.NewClient(ent.Driver(drv)),
+		readTimeout:  cfg.ReadTimeout,
+		writeTimeout: cfg.WriteTimeout,
+	}
+}
+
+// readContext ограничивает время запроса на чтение
+func (c *Connection) readContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, c.readTimeout)
+}
+
+// writeContext ограничивает время запроса на запись
+func (c *Connection) writeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, c.writeTimeout)
+}
+
+// withTx выполняет fn в транзакции: при ошибке транзакция откатывается, иначе фиксируется
+func (c *Connection) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := c.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w: rollback: %v", err, rollbackErr)
+		}
+		return err
+	}
+	return tx.Commit()
 }
@@ -0,0 +1,64 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+)
+
+func TestSQLiteBackfillsEmailVerified(t *testing.T) {
+	ctx := context.Background()
+	cfg := dto.Database{
+		Driver:       "sqlite",
+		Path:         filepath.Join(t.TempDir(), "backfill.db"),
+		MaxOpenConns: 4,
+		MaxIdleConns: 4,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 5 * time.Second,
+	}
+	log := logger.New(dto.Application{Env: "prod"})
+	users := repository.NewUserRepository(log, repository.MustNewConnection(cfg))
+	existing, err := users.CreateUser(ctx, &dto.CreateUser{
+		Name:     "gopher",
+		Email:    "gopher@example.com",
+		Password: "hash",
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	// База, созданная до подтверждения почты, ещё не знает этого столбца
+	db, err := sql.Open("sqlite3", cfg.SQLiteDSN())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, "ALTER TABLE users DROP COLUMN email_verified"); err != nil {
+		t.Fatalf("drop column: %v", err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	users = repository.NewUserRepository(log, repository.MustNewConnection(cfg))
+	if user, err := users.UserByID(ctx, existing.ID); err != nil || !user.EmailVerified {
+		t.Fatalf("existing user after upgrade = %+v, %v, want verified", user, err)
+	}
+	created, err := users.CreateUser(ctx, &dto.CreateUser{
+		Name:     "newcomer",
+		Email:    "newcomer@example.com",
+		Password: "hash",
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	// Повторный запуск не подтверждает новые аккаунты
+	users = repository.NewUserRepository(log, repository.MustNewConnection(cfg))
+	if user, err := users.UserByID(ctx, created.ID); err != nil || user.EmailVerified {
+		t.Fatalf("new user after restart = %+v, %v, want unverified", user, err)
+	}
+}
@@ -2,11 +2,14 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"GopherChessParty/ent"
 	"GopherChessParty/ent/chess"
+	"GopherChessParty/ent/gamehistory"
 	"GopherChessParty/ent/user"
 	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
 	"GopherChessParty/internal/interfaces"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
@@ -24,22 +27,16 @@ func NewGameRepository(log interfaces.ILogger, client *Connection) *GameReposito
 	}
 }
 
-func (g *GameRepository) Games(userID uuid.UUID) ([]*dto.GameHistory, error) {
-	ctx := context.Background()
-	var gameHistory []*dto.GameHistory
-	err := g.client.Chess.
+func (g *GameRepository) Games(ctx context.Context, userID uuid.UUID) ([]*dto.GameHistory, error) {
+	ctx, cancel := g.readContext(ctx)
+	defer cancel()
+	games, err := g.client.Chess.
 		Query().
-		Select(
-			chess.FieldID,
-			chess.FieldCreatedAt,
-			chess.FieldResult,
-			chess.FieldStatus,
-		).
 		WithWhiteUser(func(uq *ent.UserQuery) {
-			uq.Select(user.FieldID, user.FieldName)
+			uq.Select(user.FieldID, user.FieldName, user.FieldBot)
 		}).
 		WithBlackUser(func(uq *ent.UserQuery) {
-			uq.Select(user.FieldID, user.FieldName)
+			uq.Select(user.FieldID, user.FieldName, user.FieldBot)
 		}).
 		Where(
 			chess.Or(
@@ -48,19 +45,45 @@ func (g *GameRepository) Games(userID uuid.UUID) ([]*dto.GameHistory, error) {
 			),
 		).
 		Order(chess.ByCreatedAt(sql.OrderDesc())).
-		Select().Scan(ctx, &gameHistory)
+		All(ctx)
 	if err != nil {
 		g.log.Error(err)
 		return nil, err
 	}
+	gameHistory := make([]*dto.GameHistory, 0, len(games))
+	for _, game := range games {
+		gameHistory = append(gameHistory, &dto.GameHistory{
+			ID:          game.ID,
+			CreatedAt:   game.CreatedAt,
+			UpdatedAt:   game.UpdatedAt,
+			Status:      game.Status,
+			Result:      game.Result,
+			Termination: game.Termination,
+			WhitePlayer: &dto.Player{
+				ID:   game.Edges.WhiteUser.ID,
+				Name: game.Edges.WhiteUser.Name,
+				Bot:  game.Edges.WhiteUser.Bot,
+			},
+			BlackPlayer: &dto.Player{
+				ID:   game.Edges.BlackUser.ID,
+				Name: game.Edges.BlackUser.Name,
+				Bot:  game.Edges.BlackUser.Bot,
+			},
+		})
+	}
 	return gameHistory, nil
 }
 
-func (g *GameRepository) Create(playerID1, playerID2 uuid.UUID) (*ent.Chess, error) {
-	ctx := context.Background()
+func (g *GameRepository) Create(
+	ctx context.Context,
+	playerID1, playerID2 uuid.UUID,
+) (*ent.Chess, error) {
+	ctx, cancel := g.writeContext(ctx)
+	defer cancel()
 	game, err := g.client.Chess.Create().
 		SetWhiteUserID(playerID1).
 		SetBlackUserID(playerID2).
+		SetStatus(chess.StatusInProgress).
 		Save(ctx)
 	if err != nil {
 		g.log.Error(err)
@@ -69,14 +92,20 @@ func (g *GameRepository) Create(playerID1, playerID2 uuid.UUID) (*ent.Chess, err
 	return game, nil
 }
 
-func (g *GameRepository) GameById(gameId uuid.UUID) (*dto.Match, error) {
-	ctx := context.Background()
+func (g *GameRepository) GameById(ctx context.Context, gameId uuid.UUID) (*dto.Match, error) {
+	ctx, cancel := g.readContext(ctx)
+	defer cancel()
 	game, err := g.client.Chess.Query().
 		WithBlackUser().
 		WithWhiteUser().
-		WithMoves().
+		WithMoves(func(hq *ent.GameHistoryQuery) {
+			hq.Order(gamehistory.ByNum())
+		}).
 		Where(chess.ID(gameId)).
 		Only(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrGameNotFound
+	}
 	if err != nil {
 		g.log.Error(err)
 		return nil, err
@@ -92,27 +121,45 @@ func (g *GameRepository) GameById(gameId uuid.UUID) (*dto.Match, error) {
 		})
 	}
 	return &dto.Match{
-		ID:        game.ID,
-		CreatedAt: game.CreatedAt,
-		Status:    game.Status,
-		Result:    game.Result,
-		BlackUser: &dto.GetUser{
-			ID:    game.Edges.BlackUser.ID,
-			Name:  game.Edges.BlackUser.Name,
-			Email: game.Edges.BlackUser.Email,
+		ID:          game.ID,
+		CreatedAt:   game.CreatedAt,
+		Status:      game.Status,
+		Result:      game.Result,
+		Termination: game.Termination,
+		BlackUser: &dto.PublicUser{
+			ID:        game.Edges.BlackUser.ID,
+			Name:      game.Edges.BlackUser.Name,
+			Bot:       game.Edges.BlackUser.Bot,
+			CreatedAt: game.Edges.BlackUser.CreatedAt,
 		},
-		WhiteUser: &dto.GetUser{
-			ID:    game.Edges.WhiteUser.ID,
-			Name:  game.Edges.WhiteUser.Name,
-			Email: game.Edges.WhiteUser.Email,
+		WhiteUser: &dto.PublicUser{
+			ID:        game.Edges.WhiteUser.ID,
+			Name:      game.Edges.WhiteUser.Name,
+			Bot:       game.Edges.WhiteUser.Bot,
+			CreatedAt: game.Edges.WhiteUser.CreatedAt,
 		},
 		HistoryMove: moves,
 	}, nil
 }
 
-func (g *GameRepository) Status(GameID uuid.UUID) chess.Status {
+func (g *GameRepository) InProgressGames(ctx context.Context) ([]uuid.UUID, error) {
+	ctx, cancel := g.readContext(ctx)
+	defer cancel()
+	ids, err := g.client.Chess.
+		Query().
+		Where(chess.StatusEQ(chess.StatusInProgress)).
+		IDs(ctx)
+	if err != nil {
+		g.log.Error(err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (g *GameRepository) Status(ctx context.Context, GameID uuid.UUID) chess.Status {
 	var status chess.Status
-	ctx := context.Background()
+	ctx, cancel := g.readContext(ctx)
+	defer cancel()
 	game, err := g.client.Chess.Query().Select(chess.FieldStatus).Where(chess.ID(GameID)).Only(ctx)
 	if err != nil {
 		g.log.Error(err)
@@ -121,13 +168,20 @@ func (g *GameRepository) Status(GameID uuid.UUID) chess.Status {
 	return game.Status
 }
 
+// UpdateGame завершает партию с итогом outcome
 func (g *GameRepository) UpdateGame(
-	GameId uuid.UUID,
-	status chess.Status,
-	result chess.Result,
+	ctx context.Context,
+	GameID uuid.UUID,
+	outcome *dto.GameOutcome,
 ) error {
-	ctx := context.Background()
-	err := g.client.Chess.UpdateOneID(GameId).SetStatus(status).SetResult(result).Exec(ctx)
+	ctx, cancel := g.writeContext(ctx)
+	defer cancel()
+	err := g.withTx(ctx, func(tx *ent.Tx) error {
+		return finishGame(ctx, tx, GameID, outcome)
+	})
+	if ent.IsNotFound(err) {
+		err = errors.ErrGameNotFound
+	}
 	if err != nil {
 		g.log.Error(err)
 		return err
@@ -135,22 +189,44 @@ func (g *GameRepository) UpdateGame(
 	return nil
 }
 
+// SaveMove сохраняет ход и, если партия им завершилась, её итог в одной транзакции
 func (g *GameRepository) SaveMove(
+	ctx context.Context,
 	GameID uuid.UUID,
 	move string,
 	UserID uuid.UUID,
 	numMove int,
-) (*ent.GameHistory, error) {
-	ctx := context.Background()
-	save, err := g.client.GameHistory.Create().
-		SetGameID(GameID).
-		SetMove(move).
-		SetUserID(UserID).
-		SetNum(numMove).
-		Save(ctx)
+	outcome *dto.GameOutcome,
+) error {
+	ctx, cancel := g.writeContext(ctx)
+	defer cancel()
+	err := g.withTx(ctx, func(tx *ent.Tx) error {
+		err := tx.GameHistory.Create().
+			SetGameID(GameID).
+			SetMove(move).
+			SetUserID(UserID).
+			SetNum(numMove).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if outcome == nil {
+			return tx.Chess.UpdateOneID(GameID).SetUpdatedAt(time.Now()).Exec(ctx)
+		}
+		return finishGame(ctx, tx, GameID, outcome)
+	})
 	if err != nil {
 		g.log.Error(err)
-		return nil, err
+		return err
 	}
-	return save, nil
+	return nil
+}
+
+func finishGame(ctx context.Context, tx *ent.Tx, GameID uuid.UUID, outcome *dto.GameOutcome) error {
+	return tx.Chess.UpdateOneID(GameID).
+		SetStatus(outcome.Status).
+		SetResult(outcome.Result).
+		SetTermination(outcome.Termination).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
 }
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/chess"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// memoryGame партия вместе с игроками и ходами
+type memoryGame struct {
+	game  ent.Chess
+	white uuid.UUID
+	black uuid.UUID
+	moves []*dto.Move
+}
+
+// MemoryGameRepository партии в памяти процесса для тестов и демо без базы данных.
+// Игроки берутся из users, как внешние ключи в базе.
+type MemoryGameRepository struct {
+	users *MemoryUserRepository
+	games map[uuid.UUID]*memoryGame
+	mu    sync.RWMutex
+}
+
+func NewMemoryGameRepository(users *MemoryUserRepository) *MemoryGameRepository {
+	return &MemoryGameRepository{
+		users: users,
+		games: make(map[uuid.UUID]*memoryGame),
+	}
+}
+
+// Games возвращает партии игрока, начиная с последней
+func (g *MemoryGameRepository) Games(
+	_ context.Context,
+	userID uuid.UUID,
+) ([]*dto.GameHistory, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	gameHistory := make([]*dto.GameHistory, 0)
+	for _, stored := range g.games {
+		if stored.white != userID && stored.black != userID {
+			continue
+		}
+		white, _ := g.users.player(stored.white)
+		black, _ := g.users.player(stored.black)
+		gameHistory = append(gameHistory, &dto.GameHistory{
+			ID:          stored.game.ID,
+			CreatedAt:   stored.game.CreatedAt,
+			UpdatedAt:   stored.game.UpdatedAt,
+			Status:      stored.game.Status,
+			Result:      stored.game.Result,
+			Termination: copyTermination(stored.game.Termination),
+			WhitePlayer: &dto.Player{ID: white.ID, Name: white.Name, Bot: white.Bot},
+			BlackPlayer: &dto.Player{ID: black.ID, Name: black.Name, Bot: black.Bot},
+		})
+	}
+	slices.SortFunc(gameHistory, func(a, b *dto.GameHistory) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return gameHistory, nil
+}
+
+func (g *MemoryGameRepository) Create(
+	_ context.Context,
+	playerID1, playerID2 uuid.UUID,
+) (*ent.Chess, error) {
+	if _, ok := g.users.player(playerID1); !ok {
+		return nil, errors.ErrUserNotFound
+	}
+	if _, ok := g.users.player(playerID2); !ok {
+		return nil, errors.ErrUserNotFound
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	stored := &memoryGame{
+		game: ent.Chess{
+			ID:        uuid.New(),
+			CreatedAt: now,
+			UpdatedAt: now,
+			Status:    chess.StatusInProgress,
+			Result:    chess.DefaultResult,
+		},
+		white: playerID1,
+		black: playerID2,
+	}
+	g.games[stored.game.ID] = stored
+	game := stored.game
+	return &game, nil
+}
+
+func (g *MemoryGameRepository) GameById(
+	_ context.Context,
+	gameId uuid.UUID,
+) (*dto.Match, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	stored, ok := g.games[gameId]
+	if !ok {
+		return nil, errors.ErrGameNotFound
+	}
+	white, _ := g.users.player(stored.white)
+	black, _ := g.users.player(stored.black)
+	moves := make([]*dto.Move, 0, len(stored.moves))
+	for _, move := range stored.moves {
+		copied := *move
+		moves = append(moves, &copied)
+	}
+	slices.SortFunc(moves, func(a, b *dto.Move) int {
+		return a.Num - b.Num
+	})
+	return &dto.Match{
+		ID:          stored.game.ID,
+		CreatedAt:   stored.game.CreatedAt,
+		Status:      stored.game.Status,
+		Result:      stored.game.Result,
+		Termination: copyTermination(stored.game.Termination),
+		WhiteUser:   white,
+		BlackUser:   black,
+		HistoryMove: moves,
+	}, nil
+}
+
+func (g *MemoryGameRepository) InProgressGames(_ context.Context) ([]uuid.UUID, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	ids := make([]uuid.UUID, 0)
+	for id, stored := range g.games {
+		if stored.game.Status == chess.StatusInProgress {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (g *MemoryGameRepository) Status(_ context.Context, GameID uuid.UUID) chess.Status {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	stored, ok := g.games[GameID]
+	if !ok {
+		return ""
+	}
+	return stored.game.Status
+}
+
+// UpdateGame завершает партию с итогом outcome
+func (g *MemoryGameRepository) UpdateGame(
+	_ context.Context,
+	GameID uuid.UUID,
+	outcome *dto.GameOutcome,
+) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	stored, ok := g.games[GameID]
+	if !ok {
+		return errors.ErrGameNotFound
+	}
+	stored.finish(outcome)
+	return nil
+}
+
+// SaveMove сохраняет ход и, если партия им завершилась, её итог
+func (g *MemoryGameRepository) SaveMove(
+	_ context.Context,
+	GameID uuid.UUID,
+	move string,
+	UserID uuid.UUID,
+	numMove int,
+	outcome *dto.GameOutcome,
+) error {
+	if _, ok := g.users.player(UserID); !ok {
+		return errors.ErrUserNotFound
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	stored, ok := g.games[GameID]
+	if !ok {
+		return errors.ErrGameNotFound
+	}
+	stored.moves = append(stored.moves, &dto.Move{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Num:       numMove,
+		Move:      move,
+		UserID:    UserID,
+	})
+	if outcome == nil {
+		stored.game.UpdatedAt = time.Now()
+		return nil
+	}
+	stored.finish(outcome)
+	return nil
+}
+
+func (m *memoryGame) finish(outcome *dto.GameOutcome) {
+	termination := outcome.Termination
+	m.game.Status = outcome.Status
+	m.game.Result = outcome.Result
+	m.game.Termination = &termination
+	m.game.UpdatedAt = time.Now()
+}
+
+func copyTermination(termination *chess.Termination) *chess.Termination {
+	if termination == nil {
+		return nil
+	}
+	copied := *termination
+	return &copied
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/identity"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+type IdentityRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewIdentityRepository(log interfaces.ILogger, connection *Connection) *IdentityRepository {
+	return &IdentityRepository{log, connection}
+}
+
+// CreateIdentity привязывает пользователя провайдера к аккаунту. Возвращает ErrIdentityTaken,
+// если он уже привязан, и ErrUserNotFound для неизвестного аккаунта.
+func (r *IdentityRepository) CreateIdentity(ctx context.Context, item *dto.Identity) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.Identity.Create().
+		SetID(item.ID).
+		SetUserID(item.UserID).
+		SetProvider(item.Provider).
+		SetSubject(item.Subject).
+		SetEmail(item.Email).
+		SetCreatedAt(item.CreatedAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		err = errors.ErrUserNotFound
+		taken, existErr := r.client.Identity.Query().
+			Where(identity.Provider(item.Provider), identity.Subject(item.Subject)).
+			Exist(ctx)
+		if existErr == nil && taken {
+			err = errors.ErrIdentityTaken
+		}
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// CreateUserWithIdentity создаёт аккаунт с подтверждённой почтой и привязывает к нему пользователя
+// провайдера в одной транзакции. Возвращает ErrEmailTaken, если почта уже занята,
+// и ErrIdentityTaken, если пользователь провайдера уже привязан.
+func (r *IdentityRepository) CreateUserWithIdentity(
+	ctx context.Context,
+	data *dto.CreateUser,
+	item *dto.Identity,
+) (*dto.User, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	var created *ent.User
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.User.Create().
+			SetEmail(data.Email).
+			SetPassword(data.Password).
+			SetName(data.Name).
+			SetEmailVerified(true).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return errors.ErrEmailTaken
+		}
+		if err != nil {
+			return err
+		}
+		err = tx.Identity.Create().
+			SetID(item.ID).
+			SetUserID(created.ID).
+			SetProvider(item.Provider).
+			SetSubject(item.Subject).
+			SetEmail(item.Email).
+			SetCreatedAt(item.CreatedAt).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			return errors.ErrIdentityTaken
+		}
+		return err
+	})
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	item.UserID = created.ID
+	return toUser(created), nil
+}
+
+func (r *IdentityRepository) IdentityUser(
+	ctx context.Context,
+	provider, subject string,
+) (uuid.UUID, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	row, err := r.client.Identity.Query().
+		Where(identity.Provider(provider), identity.Subject(subject)).
+		Select(identity.FieldUserID).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return uuid.Nil, errors.ErrIdentityNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return uuid.Nil, err
+	}
+	return row.UserID, nil
+}
+
+// Identities возвращает привязки аккаунта в порядке создания
+func (r *IdentityRepository) Identities(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*dto.Identity, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	rows, err := r.client.Identity.Query().
+		Where(identity.UserID(userID)).
+		Order(ent.Asc(identity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	items := make([]*dto.Identity, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &dto.Identity{
+			ID:        row.ID,
+			UserID:    row.UserID,
+			Provider:  row.Provider,
+			Subject:   row.Subject,
+			Email:     row.Email,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return items, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// MemoryIdentityRepository привязки к провайдерам в памяти процесса для тестов и демо без базы данных
+type MemoryIdentityRepository struct {
+	users      *MemoryUserRepository
+	identities []*dto.Identity
+	mu         sync.RWMutex
+}
+
+func NewMemoryIdentityRepository(users *MemoryUserRepository) *MemoryIdentityRepository {
+	return &MemoryIdentityRepository{users: users}
+}
+
+func (r *MemoryIdentityRepository) CreateIdentity(_ context.Context, item *dto.Identity) error {
+	if _, ok := r.users.player(item.UserID); !ok {
+		return errors.ErrUserNotFound
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.identities {
+		if stored.Provider == item.Provider && stored.Subject == item.Subject {
+			return errors.ErrIdentityTaken
+		}
+	}
+	stored := *item
+	r.identities = append(r.identities, &stored)
+	return nil
+}
+
+func (r *MemoryIdentityRepository) CreateUserWithIdentity(
+	_ context.Context,
+	data *dto.CreateUser,
+	item *dto.Identity,
+) (*dto.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.identities {
+		if stored.Provider == item.Provider && stored.Subject == item.Subject {
+			return nil, errors.ErrIdentityTaken
+		}
+	}
+	created, err := r.users.createUser(data, true)
+	if err != nil {
+		return nil, err
+	}
+	item.UserID = created.ID
+	stored := *item
+	r.identities = append(r.identities, &stored)
+	return created, nil
+}
+
+func (r *MemoryIdentityRepository) IdentityUser(
+	_ context.Context,
+	provider, subject string,
+) (uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, stored := range r.identities {
+		if stored.Provider == provider && stored.Subject == subject {
+			return stored.UserID, nil
+		}
+	}
+	return uuid.Nil, errors.ErrIdentityNotFound
+}
+
+func (r *MemoryIdentityRepository) Identities(
+	_ context.Context,
+	userID uuid.UUID,
+) ([]*dto.Identity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	items := make([]*dto.Identity, 0)
+	for _, stored := range r.identities {
+		if stored.UserID == userID {
+			item := *stored
+			items = append(items, &item)
+		}
+	}
+	slices.SortStableFunc(items, func(a, b *dto.Identity) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return items, nil
+}
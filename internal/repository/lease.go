@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/gamelease"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+type LeaseRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewLeaseRepository(log interfaces.ILogger, client *Connection) *LeaseRepository {
+	return &LeaseRepository{
+		log:        log,
+		Connection: client,
+	}
+}
+
+// Acquire захватывает или продлевает аренду партии узлом. Аренду, принадлежащую другому узлу,
+// можно захватить только после её истечения.
+func (l *LeaseRepository) Acquire(
+	ctx context.Context,
+	GameID uuid.UUID,
+	nodeID string,
+	expiresAt time.Time,
+) (bool, error) {
+	ctx, cancel := l.writeContext(ctx)
+	defer cancel()
+	updated, err := l.client.GameLease.
+		Update().
+		Where(
+			gamelease.IDEQ(GameID),
+			gamelease.Or(
+				gamelease.NodeIDEQ(nodeID),
+				gamelease.ExpiresAtLT(time.Now()),
+			),
+		).
+		SetNodeID(nodeID).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		l.log.Error(err)
+		return false, err
+	}
+	if updated == 1 {
+		return true, nil
+	}
+
+	err = l.client.GameLease.
+		Create().
+		SetID(GameID).
+		SetNodeID(nodeID).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// Аренда уже принадлежит другому узлу
+		return false, nil
+	}
+	if err != nil {
+		l.log.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Release освобождает аренду партии, если она принадлежит узлу
+func (l *LeaseRepository) Release(ctx context.Context, GameID uuid.UUID, nodeID string) error {
+	ctx, cancel := l.writeContext(ctx)
+	defer cancel()
+	_, err := l.client.GameLease.
+		Delete().
+		Where(gamelease.IDEQ(GameID), gamelease.NodeIDEQ(nodeID)).
+		Exec(ctx)
+	if err != nil {
+		l.log.Error(err)
+		return err
+	}
+	return nil
+}
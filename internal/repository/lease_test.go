@@ -0,0 +1,93 @@
+package repository_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+	"github.com/google/uuid"
+)
+
+// newSQLiteConnection подключение к временной базе SQLite для репозиториев без реализации в памяти
+func newSQLiteConnection(t *testing.T) *repository.Connection {
+	t.Helper()
+	return repository.MustNewConnection(dto.Database{
+		Driver:       "sqlite",
+		Path:         filepath.Join(t.TempDir(), "cluster.db"),
+		MaxOpenConns: 4,
+		MaxIdleConns: 4,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 5 * time.Second,
+	})
+}
+
+func acquire(
+	t *testing.T,
+	leases *repository.LeaseRepository,
+	gameID uuid.UUID,
+	nodeID string,
+	expiresAt time.Time,
+) bool {
+	t.Helper()
+	ok, err := leases.Acquire(context.Background(), gameID, nodeID, expiresAt)
+	if err != nil {
+		t.Fatalf("Acquire(%s): %v", nodeID, err)
+	}
+	return ok
+}
+
+func TestLeaseAcquire(t *testing.T) {
+	leases := repository.NewLeaseRepository(
+		logger.New(dto.Application{Env: "prod"}),
+		newSQLiteConnection(t),
+	)
+	gameID := uuid.New()
+	now := time.Now()
+
+	if !acquire(t, leases, gameID, "node-a", now.Add(time.Minute)) {
+		t.Fatal("free lease was not acquired")
+	}
+	if acquire(t, leases, gameID, "node-b", now.Add(time.Minute)) {
+		t.Fatal("lease of a live node was taken over")
+	}
+	if !acquire(t, leases, gameID, "node-a", now.Add(-time.Second)) {
+		t.Fatal("owner could not renew its lease")
+	}
+	// Аренда node-a истекла, её забирает другой узел
+	if !acquire(t, leases, gameID, "node-b", now.Add(time.Minute)) {
+		t.Fatal("expired lease was not taken over")
+	}
+	if acquire(t, leases, gameID, "node-a", now.Add(time.Minute)) {
+		t.Fatal("previous owner took the lease back")
+	}
+	if !acquire(t, leases, uuid.New(), "node-a", now.Add(time.Minute)) {
+		t.Fatal("lease of another game was not acquired")
+	}
+}
+
+func TestLeaseRelease(t *testing.T) {
+	leases := repository.NewLeaseRepository(
+		logger.New(dto.Application{Env: "prod"}),
+		newSQLiteConnection(t),
+	)
+	gameID := uuid.New()
+	expiresAt := time.Now().Add(time.Minute)
+
+	acquire(t, leases, gameID, "node-a", expiresAt)
+	if err := leases.Release(context.Background(), gameID, "node-b"); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if acquire(t, leases, gameID, "node-b", expiresAt) {
+		t.Fatal("lease was released by a node that does not own it")
+	}
+	if err := leases.Release(context.Background(), gameID, "node-a"); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if !acquire(t, leases, gameID, "node-b", expiresAt) {
+		t.Fatal("released lease was not acquired")
+	}
+}
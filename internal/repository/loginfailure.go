@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/loginfailure"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+)
+
+type LoginFailureRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewLoginFailureRepository(
+	log interfaces.ILogger,
+	connection *Connection,
+) *LoginFailureRepository {
+	return &LoginFailureRepository{log, connection}
+}
+
+// LoginFailures возвращает счётчики по ключам. Ключей без неудачных попыток в ответе нет.
+func (r *LoginFailureRepository) LoginFailures(
+	ctx context.Context,
+	keys ...string,
+) ([]*dto.LoginFailures, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	failures, err := r.client.LoginFailure.Query().
+		Where(loginfailure.IDIn(keys...)).
+		All(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	result := make([]*dto.LoginFailures, 0, len(failures))
+	for _, failure := range failures {
+		result = append(result, toLoginFailures(failure))
+	}
+	return result, nil
+}
+
+// AddLoginFailure увеличивает счётчик атомарным обновлением, чтобы одновременные попытки
+// с разных узлов не терялись. Счётчик, не обновлявшийся дольше window, начинается заново.
+func (r *LoginFailureRepository) AddLoginFailure(
+	ctx context.Context,
+	key string,
+	now time.Time,
+	window time.Duration,
+) (*dto.LoginFailures, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	for {
+		updated, err := r.client.LoginFailure.Update().
+			Where(loginfailure.ID(key), loginfailure.LastFailedAtGTE(now.Add(-window))).
+			AddCount(1).
+			SetLastFailedAt(now).
+			Save(ctx)
+		if err == nil && updated == 0 {
+			updated, err = r.client.LoginFailure.Update().
+				Where(loginfailure.ID(key)).
+				SetCount(1).
+				SetLastFailedAt(now).
+				Save(ctx)
+		}
+		if err == nil && updated == 0 {
+			err = r.client.LoginFailure.Create().
+				SetID(key).
+				SetCount(1).
+				SetLastFailedAt(now).
+				Exec(ctx)
+			if ent.IsConstraintError(err) {
+				// Счётчик создан одновременной попыткой — увеличиваем его
+				continue
+			}
+		}
+		if err != nil {
+			r.log.Error(err)
+			return nil, err
+		}
+		break
+	}
+	failure, err := r.client.LoginFailure.Get(ctx, key)
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	return toLoginFailures(failure), nil
+}
+
+func (r *LoginFailureRepository) ClearLoginFailures(ctx context.Context, key string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	_, err := r.client.LoginFailure.Delete().
+		Where(loginfailure.ID(key)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// PruneLoginFailures удаляет счётчики, не обновлявшиеся с before
+func (r *LoginFailureRepository) PruneLoginFailures(ctx context.Context, before time.Time) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	_, err := r.client.LoginFailure.Delete().
+		Where(loginfailure.LastFailedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func toLoginFailures(failure *ent.LoginFailure) *dto.LoginFailures {
+	return &dto.LoginFailures{
+		Key:          failure.ID,
+		Count:        failure.Count,
+		LastFailedAt: failure.LastFailedAt,
+	}
+}
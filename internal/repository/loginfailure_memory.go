@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+)
+
+// MemoryLoginFailureRepository счётчики неудачных входов в памяти процесса для тестов и демо
+// без базы данных
+type MemoryLoginFailureRepository struct {
+	failures map[string]*dto.LoginFailures
+	mu       sync.Mutex
+}
+
+func NewMemoryLoginFailureRepository() *MemoryLoginFailureRepository {
+	return &MemoryLoginFailureRepository{failures: make(map[string]*dto.LoginFailures)}
+}
+
+func (r *MemoryLoginFailureRepository) LoginFailures(
+	_ context.Context,
+	keys ...string,
+) ([]*dto.LoginFailures, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := make([]*dto.LoginFailures, 0, len(keys))
+	for _, key := range keys {
+		if stored, ok := r.failures[key]; ok {
+			failure := *stored
+			result = append(result, &failure)
+		}
+	}
+	return result, nil
+}
+
+func (r *MemoryLoginFailureRepository) AddLoginFailure(
+	_ context.Context,
+	key string,
+	now time.Time,
+	window time.Duration,
+) (*dto.LoginFailures, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.failures[key]
+	if !ok {
+		stored = &dto.LoginFailures{Key: key}
+		r.failures[key] = stored
+	}
+	if stored.LastFailedAt.Before(now.Add(-window)) {
+		stored.Count = 0
+	}
+	stored.Count++
+	stored.LastFailedAt = now
+	failure := *stored
+	return &failure, nil
+}
+
+func (r *MemoryLoginFailureRepository) ClearLoginFailures(_ context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.failures, key)
+	return nil
+}
+
+func (r *MemoryLoginFailureRepository) PruneLoginFailures(
+	_ context.Context,
+	before time.Time,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, stored := range r.failures {
+		if stored.LastFailedAt.Before(before) {
+			delete(r.failures, key)
+		}
+	}
+	return nil
+}
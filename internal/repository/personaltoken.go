@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/personaltoken"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+type PersonalTokenRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewPersonalTokenRepository(
+	log interfaces.ILogger,
+	connection *Connection,
+) *PersonalTokenRepository {
+	return &PersonalTokenRepository{log, connection}
+}
+
+func (r *PersonalTokenRepository) CreatePersonalToken(
+	ctx context.Context,
+	token *dto.PersonalToken,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	err := r.client.PersonalToken.Create().
+		SetID(token.ID).
+		SetUserID(token.UserID).
+		SetName(token.Name).
+		SetTokenHash(token.TokenHash).
+		SetScopes(scopes).
+		SetCreatedAt(token.CreatedAt).
+		SetNillableExpiresAt(token.ExpiresAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// PersonalTokens возвращает действующие токены пользователя, начиная с новых
+func (r *PersonalTokenRepository) PersonalTokens(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*dto.PersonalToken, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	rows, err := r.client.PersonalToken.Query().
+		Where(
+			personaltoken.UserID(userID),
+			personaltoken.RevokedAtIsNil(),
+			personaltoken.Or(
+				personaltoken.ExpiresAtIsNil(),
+				personaltoken.ExpiresAtGT(time.Now()),
+			),
+		).
+		Order(ent.Desc(personaltoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	tokens := make([]*dto.PersonalToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, toPersonalToken(row))
+	}
+	return tokens, nil
+}
+
+// PersonalTokenByHash возвращает действующий токен по хэшу или ErrTokenInvalid
+func (r *PersonalTokenRepository) PersonalTokenByHash(
+	ctx context.Context,
+	hash string,
+) (*dto.PersonalToken, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	row, err := r.client.PersonalToken.Query().
+		Where(
+			personaltoken.TokenHash(hash),
+			personaltoken.RevokedAtIsNil(),
+			personaltoken.Or(
+				personaltoken.ExpiresAtIsNil(),
+				personaltoken.ExpiresAtGT(time.Now()),
+			),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.ErrTokenInvalid
+	}
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	return toPersonalToken(row), nil
+}
+
+func (r *PersonalTokenRepository) TouchPersonalToken(
+	ctx context.Context,
+	tokenID uuid.UUID,
+	usedAt time.Time,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.PersonalToken.UpdateOneID(tokenID).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *PersonalTokenRepository) RevokePersonalToken(
+	ctx context.Context,
+	userID, tokenID uuid.UUID,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	revoked, err := r.client.PersonalToken.Update().
+		Where(
+			personaltoken.ID(tokenID),
+			personaltoken.UserID(userID),
+			personaltoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	if revoked == 0 {
+		return errors.ErrPersonalTokenNotFound
+	}
+	return nil
+}
+
+// RevokePersonalTokens отзывает все действующие токены пользователя
+func (r *PersonalTokenRepository) RevokePersonalTokens(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.PersonalToken.Update().
+		Where(
+			personaltoken.UserID(userID),
+			personaltoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func toPersonalToken(row *ent.PersonalToken) *dto.PersonalToken {
+	scopes := make([]dto.Scope, 0, len(row.Scopes))
+	for _, scope := range row.Scopes {
+		scopes = append(scopes, dto.Scope(scope))
+	}
+	return &dto.PersonalToken{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		Name:       row.Name,
+		TokenHash:  row.TokenHash,
+		Scopes:     scopes,
+		CreatedAt:  row.CreatedAt,
+		LastUsedAt: row.LastUsedAt,
+		ExpiresAt:  row.ExpiresAt,
+	}
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// memoryPersonalToken персональный токен с отметкой отзыва
+type memoryPersonalToken struct {
+	token   dto.PersonalToken
+	revoked bool
+}
+
+// active проверяет, что токен не отозван и не истёк
+func (t *memoryPersonalToken) active(now time.Time) bool {
+	return !t.revoked && (t.token.ExpiresAt == nil || t.token.ExpiresAt.After(now))
+}
+
+// copy возвращает копию токена, не разделяющую права и время с хранилищем
+func (t *memoryPersonalToken) copy() *dto.PersonalToken {
+	token := t.token
+	token.Scopes = slices.Clone(t.token.Scopes)
+	if t.token.LastUsedAt != nil {
+		lastUsedAt := *t.token.LastUsedAt
+		token.LastUsedAt = &lastUsedAt
+	}
+	if t.token.ExpiresAt != nil {
+		expiresAt := *t.token.ExpiresAt
+		token.ExpiresAt = &expiresAt
+	}
+	return &token
+}
+
+// MemoryPersonalTokenRepository персональные токены в памяти процесса для тестов и демо без базы данных
+type MemoryPersonalTokenRepository struct {
+	users  *MemoryUserRepository
+	tokens map[uuid.UUID]*memoryPersonalToken
+	mu     sync.Mutex
+}
+
+func NewMemoryPersonalTokenRepository(users *MemoryUserRepository) *MemoryPersonalTokenRepository {
+	return &MemoryPersonalTokenRepository{
+		users:  users,
+		tokens: make(map[uuid.UUID]*memoryPersonalToken),
+	}
+}
+
+func (r *MemoryPersonalTokenRepository) CreatePersonalToken(
+	_ context.Context,
+	token *dto.PersonalToken,
+) error {
+	if _, ok := r.users.player(token.UserID); !ok {
+		return errors.ErrUserNotFound
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := (&memoryPersonalToken{token: *token}).copy()
+	r.tokens[token.ID] = &memoryPersonalToken{token: *stored}
+	return nil
+}
+
+func (r *MemoryPersonalTokenRepository) PersonalTokens(
+	_ context.Context,
+	userID uuid.UUID,
+) ([]*dto.PersonalToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	tokens := make([]*dto.PersonalToken, 0)
+	for _, stored := range r.tokens {
+		if stored.token.UserID == userID && stored.active(now) {
+			tokens = append(tokens, stored.copy())
+		}
+	}
+	slices.SortFunc(tokens, func(a, b *dto.PersonalToken) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return tokens, nil
+}
+
+func (r *MemoryPersonalTokenRepository) PersonalTokenByHash(
+	_ context.Context,
+	hash string,
+) (*dto.PersonalToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, stored := range r.tokens {
+		if stored.token.TokenHash == hash && stored.active(now) {
+			return stored.copy(), nil
+		}
+	}
+	return nil, errors.ErrTokenInvalid
+}
+
+func (r *MemoryPersonalTokenRepository) TouchPersonalToken(
+	_ context.Context,
+	tokenID uuid.UUID,
+	usedAt time.Time,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.tokens[tokenID]; ok {
+		stored.token.LastUsedAt = &usedAt
+	}
+	return nil
+}
+
+func (r *MemoryPersonalTokenRepository) RevokePersonalToken(
+	_ context.Context,
+	userID, tokenID uuid.UUID,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.tokens[tokenID]
+	if !ok || stored.token.UserID != userID || stored.revoked {
+		return errors.ErrPersonalTokenNotFound
+	}
+	stored.revoked = true
+	return nil
+}
+
+func (r *MemoryPersonalTokenRepository) RevokePersonalTokens(
+	_ context.Context,
+	userID uuid.UUID,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.tokens {
+		if stored.token.UserID == userID {
+			stored.revoked = true
+		}
+	}
+	return nil
+}
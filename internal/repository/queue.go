@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/queueentry"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QueueRepository очередь поиска соперника в PostgreSQL, общая для всех узлов
+type QueueRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewQueueRepository(log interfaces.ILogger, client *Connection) *QueueRepository {
+	return &QueueRepository{
+		log:        log,
+		Connection: client,
+	}
+}
+
+// Enqueue ставит игрока в очередь. Повторная постановка переносит его запись на новый узел,
+// сохраняя время ожидания. Запись создаётся или переносится одним запросом, поэтому узлы,
+// одновременно ставящие игрока в очередь, не мешают друг другу.
+func (q *QueueRepository) Enqueue(ctx context.Context, entry *dto.QueueEntry) error {
+	ctx, cancel := q.writeContext(ctx)
+	defer cancel()
+	err := q.client.QueueEntry.
+		Create().
+		SetID(entry.UserID).
+		SetNodeID(entry.Node).
+		SetAbortCount(entry.AbortCount).
+		SetJoinedAt(entry.JoinedAt).
+		SetHeartbeatAt(time.Now()).
+		OnConflictColumns(queueentry.FieldID).
+		Update(func(upsert *ent.QueueEntryUpsert) {
+			upsert.UpdateNodeID().UpdateAbortCount().UpdateHeartbeatAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		q.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Heartbeat подтверждает, что игроки узла ещё ищут соперника
+func (q *QueueRepository) Heartbeat(ctx context.Context, nodeID string, at time.Time) error {
+	ctx, cancel := q.writeContext(ctx)
+	defer cancel()
+	err := q.client.QueueEntry.
+		Update().
+		Where(queueentry.NodeIDEQ(nodeID)).
+		SetHeartbeatAt(at).
+		Exec(ctx)
+	if err != nil {
+		q.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// PruneStale удаляет записи, которые узел не подтверждал с момента before: узел упал,
+// не убрав своих игроков из очереди. Возвращает число удалённых записей.
+func (q *QueueRepository) PruneStale(ctx context.Context, before time.Time) (int, error) {
+	ctx, cancel := q.writeContext(ctx)
+	defer cancel()
+	deleted, err := q.client.QueueEntry.
+		Delete().
+		Where(queueentry.HeartbeatAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		q.log.Error(err)
+		return 0, err
+	}
+	return deleted, nil
+}
+
+func (q *QueueRepository) Remove(
+	ctx context.Context,
+	userID uuid.UUID,
+	nodeID string,
+) (bool, error) {
+	ctx, cancel := q.writeContext(ctx)
+	defer cancel()
+	deleted, err := q.client.QueueEntry.
+		Delete().
+		Where(queueentry.IDEQ(userID), queueentry.NodeIDEQ(nodeID)).
+		Exec(ctx)
+	if err != nil {
+		q.log.Error(err)
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// PopPair забирает из очереди пару игроков. Строки, заблокированные другим узлом, пропускаются,
+// поэтому узлы не подбирают одного игрока дважды. Возвращает nil, если свободных игроков меньше двух.
+func (q *QueueRepository) PopPair(ctx context.Context) (*dto.QueueEntry, *dto.QueueEntry, error) {
+	ctx, cancel := q.writeContext(ctx)
+	defer cancel()
+	tx, err := q.client.Tx(ctx)
+	if err != nil {
+		q.log.Error(err)
+		return nil, nil, err
+	}
+	entries, err := tx.QueueEntry.
+		Query().
+		Order(queueentry.ByAbortCount(), queueentry.ByJoinedAt()).
+		Limit(2).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		q.log.Error(err)
+		return nil, nil, err
+	}
+	if len(entries) < 2 {
+		return nil, nil, tx.Rollback()
+	}
+	_, err = tx.QueueEntry.
+		Delete().
+		Where(queueentry.IDIn(entries[0].ID, entries[1].ID)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		q.log.Error(err)
+		return nil, nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		q.log.Error(err)
+		return nil, nil, err
+	}
+	return queueEntryDTO(entries[0]), queueEntryDTO(entries[1]), nil
+}
+
+// Entries возвращает очередь в порядке подбора соперников
+func (q *QueueRepository) Entries(ctx context.Context) ([]*dto.QueueEntry, error) {
+	ctx, cancel := q.readContext(ctx)
+	defer cancel()
+	var entries []*dto.QueueEntry
+	err := q.client.QueueEntry.
+		Query().
+		Order(queueentry.ByAbortCount(), queueentry.ByJoinedAt()).
+		Select(
+			queueentry.FieldID,
+			queueentry.FieldNodeID,
+			queueentry.FieldAbortCount,
+			queueentry.FieldJoinedAt,
+			queueentry.FieldHeartbeatAt,
+		).
+		Scan(ctx, &entries)
+	if err != nil {
+		q.log.Error(err)
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (q *QueueRepository) Len(ctx context.Context) (int, error) {
+	ctx, cancel := q.readContext(ctx)
+	defer cancel()
+	count, err := q.client.QueueEntry.Query().Count(ctx)
+	if err != nil {
+		q.log.Error(err)
+		return 0, err
+	}
+	return count, nil
+}
+
+func queueEntryDTO(entry *ent.QueueEntry) *dto.QueueEntry {
+	return &dto.QueueEntry{
+		UserID:      entry.ID,
+		Node:        entry.NodeID,
+		AbortCount:  entry.AbortCount,
+		JoinedAt:    entry.JoinedAt,
+		HeartbeatAt: entry.HeartbeatAt,
+	}
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"github.com/google/uuid"
+)
+
+// MemoryQueue очередь поиска соперника в памяти процесса для работы на одном узле
+type MemoryQueue struct {
+	entries []*dto.QueueEntry
+	mu      sync.Mutex
+}
+
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{}
+}
+
+// Enqueue ставит игрока в очередь. Повторная постановка переносит его запись на новый узел,
+// сохраняя время ожидания.
+func (q *MemoryQueue) Enqueue(_ context.Context, entry *dto.QueueEntry) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, queued := range q.entries {
+		if queued.UserID == entry.UserID {
+			queued.Node = entry.Node
+			queued.AbortCount = entry.AbortCount
+			queued.HeartbeatAt = time.Now()
+			return nil
+		}
+	}
+	queued := *entry
+	queued.HeartbeatAt = time.Now()
+	q.entries = append(q.entries, &queued)
+	return nil
+}
+
+func (q *MemoryQueue) Remove(_ context.Context, userID uuid.UUID, nodeID string) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, entry := range q.entries {
+		if entry.UserID == userID && entry.Node == nodeID {
+			q.entries = append(q.entries[:i], q.entries[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Heartbeat подтверждает, что игроки узла ещё ищут соперника
+func (q *MemoryQueue) Heartbeat(_ context.Context, nodeID string, at time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, entry := range q.entries {
+		if entry.Node == nodeID {
+			entry.HeartbeatAt = at
+		}
+	}
+	return nil
+}
+
+// PruneStale удаляет записи, которые узел не подтверждал с момента before
+func (q *MemoryQueue) PruneStale(_ context.Context, before time.Time) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	kept := q.entries[:0]
+	for _, entry := range q.entries {
+		if !entry.HeartbeatAt.Before(before) {
+			kept = append(kept, entry)
+		}
+	}
+	pruned := len(q.entries) - len(kept)
+	q.entries = kept
+	return pruned, nil
+}
+
+// PopPair забирает из очереди пару игроков. Возвращает nil, если в очереди меньше двух игроков.
+func (q *MemoryQueue) PopPair(_ context.Context) (*dto.QueueEntry, *dto.QueueEntry, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.entries) < 2 {
+		return nil, nil, nil
+	}
+	q.sort()
+	entry1, entry2 := q.entries[0], q.entries[1]
+	q.entries = q.entries[2:]
+	return entry1, entry2, nil
+}
+
+// Entries возвращает очередь в порядке подбора соперников
+func (q *MemoryQueue) Entries(_ context.Context) ([]*dto.QueueEntry, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.sort()
+	entries := make([]*dto.QueueEntry, 0, len(q.entries))
+	for _, entry := range q.entries {
+		copied := *entry
+		entries = append(entries, &copied)
+	}
+	return entries, nil
+}
+
+func (q *MemoryQueue) Len(_ context.Context) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.entries), nil
+}
+
+// sort упорядочивает очередь: игроки, часто прерывающие партии, подбираются последними
+func (q *MemoryQueue) sort() {
+	sort.SliceStable(q.entries, func(i, j int) bool {
+		if q.entries[i].AbortCount != q.entries[j].AbortCount {
+			return q.entries[i].AbortCount < q.entries[j].AbortCount
+		}
+		return q.entries[i].JoinedAt.Before(q.entries[j].JoinedAt)
+	})
+}
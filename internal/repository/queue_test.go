@@ -0,0 +1,126 @@
+package repository_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+	"github.com/google/uuid"
+)
+
+// queues реализации очереди поиска, которые должны вести себя одинаково
+var queues = map[string]func(t *testing.T) interfaces.IQueueRepo{
+	"memory": func(t *testing.T) interfaces.IQueueRepo {
+		return repository.NewMemoryQueue()
+	},
+	"sqlite": func(t *testing.T) interfaces.IQueueRepo {
+		return repository.NewQueueRepository(
+			logger.New(dto.Application{Env: "prod"}),
+			newSQLiteConnection(t),
+		)
+	},
+}
+
+func TestQueueRepoConformance(t *testing.T) {
+	for name, newQueue := range queues {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Requeue", func(t *testing.T) {
+				testRequeue(t, newQueue(t))
+			})
+			t.Run("ConcurrentEnqueue", func(t *testing.T) {
+				testConcurrentEnqueue(t, newQueue(t))
+			})
+			t.Run("PruneStale", func(t *testing.T) {
+				testPruneStale(t, newQueue(t))
+			})
+		})
+	}
+}
+
+func enqueue(t *testing.T, queue interfaces.IQueueRepo, userID uuid.UUID, node string) {
+	t.Helper()
+	err := queue.Enqueue(context.Background(), &dto.QueueEntry{
+		UserID:   userID,
+		Node:     node,
+		JoinedAt: time.Now().Truncate(time.Millisecond),
+	})
+	if err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+}
+
+func entries(t *testing.T, queue interfaces.IQueueRepo) []*dto.QueueEntry {
+	t.Helper()
+	queued, err := queue.Entries(context.Background())
+	if err != nil {
+		t.Fatalf("Entries: %v", err)
+	}
+	return queued
+}
+
+func testRequeue(t *testing.T, queue interfaces.IQueueRepo) {
+	userID := uuid.New()
+	enqueue(t, queue, userID, "node-a")
+	joinedAt := entries(t, queue)[0].JoinedAt
+
+	time.Sleep(5 * time.Millisecond)
+	enqueue(t, queue, userID, "node-b")
+	queued := entries(t, queue)
+	if len(queued) != 1 || queued[0].Node != "node-b" {
+		t.Fatalf("entries after requeue = %+v, want one on node-b", queued)
+	}
+	if !queued[0].JoinedAt.Equal(joinedAt) {
+		t.Fatalf("joined at = %v, want kept %v", queued[0].JoinedAt, joinedAt)
+	}
+}
+
+func testConcurrentEnqueue(t *testing.T, queue interfaces.IQueueRepo) {
+	userID := uuid.New()
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- queue.Enqueue(context.Background(), &dto.QueueEntry{
+				UserID:   userID,
+				Node:     []string{"node-a", "node-b"}[i%2],
+				JoinedAt: time.Now(),
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent Enqueue: %v", err)
+		}
+	}
+	if queued := entries(t, queue); len(queued) != 1 {
+		t.Fatalf("entries = %d, want 1", len(queued))
+	}
+}
+
+func testPruneStale(t *testing.T, queue interfaces.IQueueRepo) {
+	alive, dead := uuid.New(), uuid.New()
+	enqueue(t, queue, alive, "node-a")
+	enqueue(t, queue, dead, "node-b")
+
+	now := time.Now().Add(time.Minute)
+	if err := queue.Heartbeat(context.Background(), "node-a", now); err != nil {
+		t.Fatalf("Heartbeat: %v", err)
+	}
+	pruned, err := queue.PruneStale(context.Background(), now.Add(-time.Second))
+	if err != nil {
+		t.Fatalf("PruneStale: %v", err)
+	}
+	queued := entries(t, queue)
+	if pruned != 1 || len(queued) != 1 || queued[0].UserID != alive {
+		t.Fatalf("after prune: pruned %d, entries %+v, want only node-a", pruned, queued)
+	}
+}
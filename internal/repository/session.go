@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/session"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+type SessionRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewSessionRepository(log interfaces.ILogger, connection *Connection) *SessionRepository {
+	return &SessionRepository{log, connection}
+}
+
+func (r *SessionRepository) CreateSession(ctx context.Context, s *dto.Session) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.Session.Create().
+		SetID(s.ID).
+		SetUserID(s.UserID).
+		SetRefreshJti(s.RefreshJTI).
+		SetCreatedAt(s.CreatedAt).
+		SetLastUsedAt(s.LastUsedAt).
+		SetExpiresAt(s.ExpiresAt).
+		SetUserAgent(s.UserAgent).
+		SetIP(s.IP).
+		Exec(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// RotateSession обновляет сессию одним условным запросом, поэтому из двух одновременных
+// обновлений одним токеном успешно только одно
+func (r *SessionRepository) RotateSession(
+	ctx context.Context,
+	sessionID, oldJTI, newJTI uuid.UUID,
+	expiresAt time.Time,
+	device dto.Device,
+) (bool, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	now := time.Now()
+	updated, err := r.client.Session.Update().
+		Where(
+			session.ID(sessionID),
+			session.RefreshJti(oldJTI),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(now),
+		).
+		SetRefreshJti(newJTI).
+		SetLastUsedAt(now).
+		SetExpiresAt(expiresAt).
+		SetUserAgent(device.UserAgent).
+		SetIP(device.IP).
+		Save(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return false, err
+	}
+	return updated == 1, nil
+}
+
+func (r *SessionRepository) Sessions(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*dto.Session, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	rows, err := r.client.Session.Query().
+		Where(
+			session.UserID(userID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(session.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	return toSessions(rows), nil
+}
+
+func (r *SessionRepository) RevokedSessions(
+	ctx context.Context,
+	since time.Time,
+) ([]*dto.Session, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	rows, err := r.client.Session.Query().
+		Where(session.RevokedAtGTE(since)).
+		All(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	return toSessions(rows), nil
+}
+
+func (r *SessionRepository) RevokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.Session.Update().
+		Where(session.ID(sessionID), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *SessionRepository) RevokeUserSession(
+	ctx context.Context,
+	userID, sessionID uuid.UUID,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	revoked, err := r.client.Session.Update().
+		Where(
+			session.ID(sessionID),
+			session.UserID(userID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	if revoked == 0 {
+		return errors.ErrSessionNotFound
+	}
+	return nil
+}
+
+func (r *SessionRepository) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.Session.Update().
+		Where(session.UserID(userID), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func toSessions(rows []*ent.Session) []*dto.Session {
+	sessions := make([]*dto.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, &dto.Session{
+			ID:         row.ID,
+			UserID:     row.UserID,
+			RefreshJTI: row.RefreshJti,
+			CreatedAt:  row.CreatedAt,
+			LastUsedAt: row.LastUsedAt,
+			ExpiresAt:  row.ExpiresAt,
+			RevokedAt:  row.RevokedAt,
+			UserAgent:  row.UserAgent,
+			IP:         row.IP,
+		})
+	}
+	return sessions
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// MemorySessionRepository сессии входа в памяти процесса для тестов и демо без базы данных
+type MemorySessionRepository struct {
+	users    *MemoryUserRepository
+	sessions map[uuid.UUID]*dto.Session
+	mu       sync.Mutex
+}
+
+func NewMemorySessionRepository(users *MemoryUserRepository) *MemorySessionRepository {
+	return &MemorySessionRepository{
+		users:    users,
+		sessions: make(map[uuid.UUID]*dto.Session),
+	}
+}
+
+func (r *MemorySessionRepository) CreateSession(_ context.Context, s *dto.Session) error {
+	if _, ok := r.users.player(s.UserID); !ok {
+		return errors.ErrUserNotFound
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *s
+	r.sessions[s.ID] = &stored
+	return nil
+}
+
+func (r *MemorySessionRepository) RotateSession(
+	_ context.Context,
+	sessionID, oldJTI, newJTI uuid.UUID,
+	expiresAt time.Time,
+	device dto.Device,
+) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	stored, ok := r.sessions[sessionID]
+	if !ok || stored.RefreshJTI != oldJTI || stored.RevokedAt != nil ||
+		!stored.ExpiresAt.After(now) {
+		return false, nil
+	}
+	stored.RefreshJTI = newJTI
+	stored.LastUsedAt = now
+	stored.ExpiresAt = expiresAt
+	stored.UserAgent = device.UserAgent
+	stored.IP = device.IP
+	return true, nil
+}
+
+func (r *MemorySessionRepository) Sessions(
+	_ context.Context,
+	userID uuid.UUID,
+) ([]*dto.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	sessions := make([]*dto.Session, 0)
+	for _, stored := range r.sessions {
+		if stored.UserID == userID && stored.RevokedAt == nil && stored.ExpiresAt.After(now) {
+			copied := *stored
+			sessions = append(sessions, &copied)
+		}
+	}
+	slices.SortFunc(sessions, func(a, b *dto.Session) int {
+		return b.LastUsedAt.Compare(a.LastUsedAt)
+	})
+	return sessions, nil
+}
+
+func (r *MemorySessionRepository) RevokedSessions(
+	_ context.Context,
+	since time.Time,
+) ([]*dto.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sessions := make([]*dto.Session, 0)
+	for _, stored := range r.sessions {
+		if stored.RevokedAt != nil && !stored.RevokedAt.Before(since) {
+			copied := *stored
+			sessions = append(sessions, &copied)
+		}
+	}
+	return sessions, nil
+}
+
+func (r *MemorySessionRepository) RevokeSession(_ context.Context, sessionID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.sessions[sessionID]; ok && stored.RevokedAt == nil {
+		now := time.Now()
+		stored.RevokedAt = &now
+	}
+	return nil
+}
+
+func (r *MemorySessionRepository) RevokeUserSession(
+	_ context.Context,
+	userID, sessionID uuid.UUID,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	stored, ok := r.sessions[sessionID]
+	if !ok || stored.UserID != userID || stored.RevokedAt != nil || !stored.ExpiresAt.After(now) {
+		return errors.ErrSessionNotFound
+	}
+	stored.RevokedAt = &now
+	return nil
+}
+
+func (r *MemorySessionRepository) RevokeUserSessions(_ context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, stored := range r.sessions {
+		if stored.UserID == userID && stored.RevokedAt == nil {
+			stored.RevokedAt = &now
+		}
+	}
+	return nil
+}
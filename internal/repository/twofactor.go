@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/recoverycode"
+	"GopherChessParty/ent/user"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+type TwoFactorRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewTwoFactorRepository(log interfaces.ILogger, connection *Connection) *TwoFactorRepository {
+	return &TwoFactorRepository{log, connection}
+}
+
+func (r *TwoFactorRepository) StartTOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	secret string,
+	recoveryHashes []string,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		updated, err := tx.User.Update().
+			Where(user.ID(userID), user.TotpEnabled(false)).
+			SetTotpSecret(secret).
+			SetTotpLastStep(0).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			exists, err := tx.User.Query().Where(user.ID(userID)).Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return errors.ErrUserNotFound
+			}
+			return errors.ErrTOTPEnabled
+		}
+		_, err = tx.RecoveryCode.Delete().Where(recoverycode.UserID(userID)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		codes := make([]*ent.RecoveryCodeCreate, 0, len(recoveryHashes))
+		for _, hash := range recoveryHashes {
+			codes = append(codes, tx.RecoveryCode.Create().SetUserID(userID).SetCodeHash(hash))
+		}
+		return tx.RecoveryCode.CreateBulk(codes...).Exec(ctx)
+	})
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *TwoFactorRepository) TOTP(ctx context.Context, userID uuid.UUID) (*dto.TOTP, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+	userDB, err := r.client.User.Query().
+		Where(user.ID(userID)).
+		Select(user.FieldTotpSecret, user.FieldTotpEnabled, user.FieldTotpLastStep).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	totp := &dto.TOTP{Enabled: userDB.TotpEnabled, LastStep: userDB.TotpLastStep}
+	if userDB.TotpSecret != nil {
+		totp.Secret = *userDB.TotpSecret
+	}
+	return totp, nil
+}
+
+func (r *TwoFactorRepository) ConfirmTOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	step int64,
+) (bool, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	updated, err := r.client.User.Update().
+		Where(user.ID(userID), user.TotpEnabled(false), user.TotpSecretNotNil()).
+		SetTotpEnabled(true).
+		SetTotpLastStep(step).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return false, err
+	}
+	return updated == 1, nil
+}
+
+// UseTOTPStep сравнивает и обновляет шаг одним запросом, поэтому один код не примут два
+// одновременных входа
+func (r *TwoFactorRepository) UseTOTPStep(
+	ctx context.Context,
+	userID uuid.UUID,
+	step int64,
+) (bool, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	updated, err := r.client.User.Update().
+		Where(user.ID(userID), user.TotpEnabled(true), user.TotpLastStepLT(step)).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return false, err
+	}
+	return updated == 1, nil
+}
+
+func (r *TwoFactorRepository) UseRecoveryCode(
+	ctx context.Context,
+	userID uuid.UUID,
+	hash string,
+) (bool, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	updated, err := r.client.RecoveryCode.Update().
+		Where(
+			recoverycode.UserID(userID),
+			recoverycode.CodeHash(hash),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return false, err
+	}
+	return updated == 1, nil
+}
+
+func (r *TwoFactorRepository) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		err := tx.User.Update().
+			Where(user.ID(userID)).
+			ClearTotpSecret().
+			SetTotpEnabled(false).
+			SetTotpLastStep(0).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.RecoveryCode.Delete().Where(recoverycode.UserID(userID)).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// memoryTwoFactor 2FA пользователя и хэши его кодов восстановления с отметкой погашения
+type memoryTwoFactor struct {
+	totp  dto.TOTP
+	codes map[string]bool
+}
+
+// MemoryTwoFactorRepository 2FA в памяти процесса для тестов и демо без базы данных
+type MemoryTwoFactorRepository struct {
+	users   *MemoryUserRepository
+	factors map[uuid.UUID]*memoryTwoFactor
+	mu      sync.Mutex
+}
+
+func NewMemoryTwoFactorRepository(users *MemoryUserRepository) *MemoryTwoFactorRepository {
+	return &MemoryTwoFactorRepository{
+		users:   users,
+		factors: make(map[uuid.UUID]*memoryTwoFactor),
+	}
+}
+
+func (r *MemoryTwoFactorRepository) StartTOTP(
+	_ context.Context,
+	userID uuid.UUID,
+	secret string,
+	recoveryHashes []string,
+) error {
+	if _, ok := r.users.player(userID); !ok {
+		return errors.ErrUserNotFound
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.factors[userID]; ok && stored.totp.Enabled {
+		return errors.ErrTOTPEnabled
+	}
+	codes := make(map[string]bool, len(recoveryHashes))
+	for _, hash := range recoveryHashes {
+		codes[hash] = false
+	}
+	r.factors[userID] = &memoryTwoFactor{totp: dto.TOTP{Secret: secret}, codes: codes}
+	return nil
+}
+
+func (r *MemoryTwoFactorRepository) TOTP(_ context.Context, userID uuid.UUID) (*dto.TOTP, error) {
+	if _, ok := r.users.player(userID); !ok {
+		return nil, errors.ErrUserNotFound
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.factors[userID]
+	if !ok {
+		return &dto.TOTP{}, nil
+	}
+	totp := stored.totp
+	return &totp, nil
+}
+
+func (r *MemoryTwoFactorRepository) ConfirmTOTP(
+	_ context.Context,
+	userID uuid.UUID,
+	step int64,
+) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.factors[userID]
+	if !ok || stored.totp.Enabled || stored.totp.Secret == "" {
+		return false, nil
+	}
+	stored.totp.Enabled = true
+	stored.totp.LastStep = step
+	return true, nil
+}
+
+func (r *MemoryTwoFactorRepository) UseTOTPStep(
+	_ context.Context,
+	userID uuid.UUID,
+	step int64,
+) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.factors[userID]
+	if !ok || !stored.totp.Enabled || stored.totp.LastStep >= step {
+		return false, nil
+	}
+	stored.totp.LastStep = step
+	return true, nil
+}
+
+func (r *MemoryTwoFactorRepository) UseRecoveryCode(
+	_ context.Context,
+	userID uuid.UUID,
+	hash string,
+) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.factors[userID]
+	if !ok {
+		return false, nil
+	}
+	used, ok := stored.codes[hash]
+	if !ok || used {
+		return false, nil
+	}
+	stored.codes[hash] = true
+	return true, nil
+}
+
+func (r *MemoryTwoFactorRepository) DisableTOTP(_ context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.factors, userID)
+	return nil
+}
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"context"
+	"time"
 
+	"GopherChessParty/ent"
 	"GopherChessParty/ent/user"
 	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
 	"GopherChessParty/internal/interfaces"
 	"github.com/google/uuid"
 )
@@ -18,34 +21,55 @@ func NewUserRepository(log interfaces.ILogger, repo *Connection) *UserRepository
 	return &UserRepository{log, repo}
 }
 
-func (r *UserRepository) CreateUser(user *dto.CreateUser) (*dto.User, error) {
-	ctx := context.Background()
+func (r *UserRepository) CreateUser(ctx context.Context, user *dto.CreateUser) (*dto.User, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
 
 	newUser, err := r.client.User.Create().
 		SetEmail(user.Email).
 		SetPassword(user.Password).
 		SetName(user.Name).
 		Save(ctx)
+	if ent.IsConstraintError(err) {
+		err = errors.ErrEmailTaken
+	}
 	if err != nil {
 		r.log.Error(err)
 		return nil, err
 	}
 	return &dto.User{
-		ID:        newUser.ID,
-		Email:     newUser.Email,
-		CreatedAt: newUser.CreatedAt,
-		UpdatedAt: newUser.UpdatedAt,
-		Name:      newUser.Name,
+		ID:            newUser.ID,
+		Email:         newUser.Email,
+		AutoQueen:     newUser.AutoQueen,
+		AbortCount:    newUser.AbortCount,
+		EmailVerified: newUser.EmailVerified,
+		Bot:           newUser.Bot,
+		Role:          newUser.Role,
+		CreatedAt:     newUser.CreatedAt,
+		UpdatedAt:     newUser.UpdatedAt,
+		Name:          newUser.Name,
 	}, nil
 }
 
-func (r *UserRepository) Users() ([]*dto.User, error) {
-	ctx := context.Background()
+func (r *UserRepository) Users(ctx context.Context) ([]*dto.User, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	var users []*dto.User
 	err := r.client.User.
 		Query().
-		Select(user.FieldID, user.FieldName, user.FieldEmail, user.FieldCreatedAt, user.FieldUpdatedAt).
+		Select(
+			user.FieldID,
+			user.FieldName,
+			user.FieldEmail,
+			user.FieldAutoQueen,
+			user.FieldAbortCount,
+			user.FieldEmailVerified,
+			user.FieldBot,
+			user.FieldRole,
+			user.FieldCreatedAt,
+			user.FieldUpdatedAt,
+		).
 		Scan(ctx, &users)
 	if err != nil {
 		r.log.Error(err)
@@ -54,35 +78,57 @@ func (r *UserRepository) Users() ([]*dto.User, error) {
 	return users, nil
 }
 
-func (r *UserRepository) UserByID(UserID uuid.UUID) (*dto.User, error) {
-	ctx := context.Background()
+func (r *UserRepository) UserByID(ctx context.Context, UserID uuid.UUID) (*dto.User, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	userDB, err := r.client.User.
 		Query().
 		Select(
-			user.FieldID, user.FieldName, user.FieldEmail, user.FieldCreatedAt, user.FieldUpdatedAt,
+			user.FieldID,
+			user.FieldName,
+			user.FieldEmail,
+			user.FieldAutoQueen,
+			user.FieldAbortCount,
+			user.FieldEmailVerified,
+			user.FieldBot,
+			user.FieldRole,
+			user.FieldCreatedAt,
+			user.FieldUpdatedAt,
 		).Where(user.ID(UserID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
 	if err != nil {
 		r.log.Error(err)
 		return nil, err
 	}
 	return &dto.User{
-		ID:        userDB.ID,
-		Email:     userDB.Email,
-		CreatedAt: userDB.CreatedAt,
-		UpdatedAt: userDB.UpdatedAt,
-		Name:      userDB.Name,
+		ID:            userDB.ID,
+		Email:         userDB.Email,
+		AutoQueen:     userDB.AutoQueen,
+		AbortCount:    userDB.AbortCount,
+		EmailVerified: userDB.EmailVerified,
+		Bot:           userDB.Bot,
+		Role:          userDB.Role,
+		CreatedAt:     userDB.CreatedAt,
+		UpdatedAt:     userDB.UpdatedAt,
+		Name:          userDB.Name,
 	}, nil
 }
 
-func (r *UserRepository) UserPassword(email string) (*dto.AuthUser, error) {
-	ctx := context.Background()
+func (r *UserRepository) UserPassword(ctx context.Context, email string) (*dto.AuthUser, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	authUser, err := r.client.User.
 		Query().
 		Where(user.Email(email)).
 		Select(user.FieldPassword, user.FieldID).
 		First(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
 	if err != nil {
 		r.log.Error(err)
 		return nil, err
@@ -90,3 +136,158 @@ func (r *UserRepository) UserPassword(email string) (*dto.AuthUser, error) {
 
 	return &dto.AuthUser{UserID: authUser.ID, HashedPassword: authUser.Password}, nil
 }
+
+// UserByEmail ищет аккаунт по почте без учёта регистра. Если адрес зарегистрирован в разных
+// регистрах, первым находится аккаунт с подтверждённой почтой.
+func (r *UserRepository) UserByEmail(ctx context.Context, email string) (*dto.User, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	userDB, err := r.client.User.
+		Query().
+		Where(user.EmailEqualFold(email)).
+		Order(ent.Desc(user.FieldEmailVerified), ent.Asc(user.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return nil, err
+	}
+	return toUser(userDB), nil
+}
+
+func (r *UserRepository) SetAutoQueen(ctx context.Context, UserID uuid.UUID, autoQueen bool) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	err := r.client.User.
+		UpdateOneID(UserID).
+		SetAutoQueen(autoQueen).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *UserRepository) IncrementAborts(ctx context.Context, UserID uuid.UUID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	err := r.client.User.
+		UpdateOneID(UserID).
+		AddAbortCount(1).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *UserRepository) SetEmailVerified(ctx context.Context, UserID uuid.UUID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	err := r.client.User.
+		UpdateOneID(UserID).
+		SetEmailVerified(true).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *UserRepository) SetPassword(
+	ctx context.Context,
+	UserID uuid.UUID,
+	hashedPassword string,
+) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	err := r.client.User.
+		UpdateOneID(UserID).
+		SetPassword(hashedPassword).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// SetBot переводит аккаунт в боты
+func (r *UserRepository) SetBot(ctx context.Context, UserID uuid.UUID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	err := r.client.User.
+		UpdateOneID(UserID).
+		SetBot(true).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// SetRole назначает пользователю роль
+func (r *UserRepository) SetRole(ctx context.Context, UserID uuid.UUID, role user.Role) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	err := r.client.User.
+		UpdateOneID(UserID).
+		SetRole(role).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func toUser(row *ent.User) *dto.User {
+	return &dto.User{
+		ID:            row.ID,
+		Email:         row.Email,
+		AutoQueen:     row.AutoQueen,
+		AbortCount:    row.AbortCount,
+		EmailVerified: row.EmailVerified,
+		Bot:           row.Bot,
+		Role:          row.Role,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+		Name:          row.Name,
+	}
+}
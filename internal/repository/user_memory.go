@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"GopherChessParty/ent/user"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// memoryUser пользователь вместе с хэшем пароля
+type memoryUser struct {
+	user     dto.User
+	password string
+}
+
+// MemoryUserRepository пользователи в памяти процесса для тестов и демо без базы данных
+type MemoryUserRepository struct {
+	users map[uuid.UUID]*memoryUser
+	mu    sync.RWMutex
+}
+
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{users: make(map[uuid.UUID]*memoryUser)}
+}
+
+func (r *MemoryUserRepository) CreateUser(
+	_ context.Context,
+	data *dto.CreateUser,
+) (*dto.User, error) {
+	return r.createUser(data, false)
+}
+
+// createUser создаёт аккаунт, сразу с подтверждённой почтой, если verified
+func (r *MemoryUserRepository) createUser(data *dto.CreateUser, verified bool) (*dto.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, stored := range r.users {
+		if stored.user.Email == data.Email {
+			return nil, errors.ErrEmailTaken
+		}
+	}
+	now := time.Now()
+	stored := &memoryUser{
+		user: dto.User{
+			ID:            uuid.New(),
+			Name:          data.Name,
+			Email:         data.Email,
+			AutoQueen:     true,
+			EmailVerified: verified,
+			Role:          user.RoleUser,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		},
+		password: data.Password,
+	}
+	r.users[stored.user.ID] = stored
+	created := stored.user
+	return &created, nil
+}
+
+func (r *MemoryUserRepository) Users(_ context.Context) ([]*dto.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	users := make([]*dto.User, 0, len(r.users))
+	for _, stored := range r.users {
+		user := stored.user
+		users = append(users, &user)
+	}
+	return users, nil
+}
+
+func (r *MemoryUserRepository) UserPassword(
+	_ context.Context,
+	email string,
+) (*dto.AuthUser, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, stored := range r.users {
+		if stored.user.Email == email {
+			return &dto.AuthUser{UserID: stored.user.ID, HashedPassword: stored.password}, nil
+		}
+	}
+	return nil, errors.ErrUserNotFound
+}
+
+func (r *MemoryUserRepository) UserByEmail(_ context.Context, email string) (*dto.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var found *dto.User
+	for _, stored := range r.users {
+		if !strings.EqualFold(stored.user.Email, email) {
+			continue
+		}
+		if found == nil || stored.user.EmailVerified && !found.EmailVerified {
+			user := stored.user
+			found = &user
+		}
+	}
+	if found == nil {
+		return nil, errors.ErrUserNotFound
+	}
+	return found, nil
+}
+
+func (r *MemoryUserRepository) UserByID(_ context.Context, UserID uuid.UUID) (*dto.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return nil, errors.ErrUserNotFound
+	}
+	user := stored.user
+	return &user, nil
+}
+
+func (r *MemoryUserRepository) SetAutoQueen(
+	_ context.Context,
+	UserID uuid.UUID,
+	autoQueen bool,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return errors.ErrUserNotFound
+	}
+	stored.user.AutoQueen = autoQueen
+	stored.user.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *MemoryUserRepository) IncrementAborts(_ context.Context, UserID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return errors.ErrUserNotFound
+	}
+	stored.user.AbortCount++
+	return nil
+}
+
+// player возвращает имя, почту и признак бота пользователя для карточек партий
+func (r *MemoryUserRepository) player(UserID uuid.UUID) (*dto.PublicUser, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return nil, false
+	}
+	player := stored.user.Public()
+	return &player, true
+}
+
+func (r *MemoryUserRepository) SetEmailVerified(_ context.Context, UserID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return errors.ErrUserNotFound
+	}
+	stored.user.EmailVerified = true
+	stored.user.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *MemoryUserRepository) SetPassword(
+	_ context.Context,
+	UserID uuid.UUID,
+	hashedPassword string,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return errors.ErrUserNotFound
+	}
+	stored.password = hashedPassword
+	stored.user.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *MemoryUserRepository) SetBot(_ context.Context, UserID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return errors.ErrUserNotFound
+	}
+	stored.user.Bot = true
+	stored.user.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *MemoryUserRepository) SetRole(_ context.Context, UserID uuid.UUID, role user.Role) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.users[UserID]
+	if !ok {
+		return errors.ErrUserNotFound
+	}
+	stored.user.Role = role
+	stored.user.UpdatedAt = time.Now()
+	return nil
+}
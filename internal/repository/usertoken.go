@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	exc "errors"
+	"time"
+
+	"GopherChessParty/ent"
+	"GopherChessParty/ent/usertoken"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+type UserTokenRepository struct {
+	log interfaces.ILogger
+	*Connection
+}
+
+func NewUserTokenRepository(log interfaces.ILogger, connection *Connection) *UserTokenRepository {
+	return &UserTokenRepository{log, connection}
+}
+
+func (r *UserTokenRepository) CreateUserToken(ctx context.Context, token *dto.UserToken) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	err := r.client.UserToken.Create().
+		SetID(token.ID).
+		SetUserID(token.UserID).
+		SetPurpose(token.Purpose).
+		SetTokenHash(token.TokenHash).
+		SetCreatedAt(token.CreatedAt).
+		SetExpiresAt(token.ExpiresAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		err = errors.ErrUserNotFound
+	}
+	if err != nil {
+		r.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// ConsumeUserToken гасит токен условным обновлением, поэтому из двух одновременных
+// запросов с одним токеном успешен только один
+func (r *UserTokenRepository) ConsumeUserToken(
+	ctx context.Context,
+	purpose usertoken.Purpose,
+	hash string,
+) (uuid.UUID, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+	var userID uuid.UUID
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		now := time.Now()
+		token, err := tx.UserToken.Query().
+			Where(
+				usertoken.TokenHash(hash),
+				usertoken.PurposeEQ(purpose),
+				usertoken.UsedAtIsNil(),
+				usertoken.ExpiresAtGT(now),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return errors.ErrTokenInvalid
+		}
+		if err != nil {
+			return err
+		}
+		used, err := tx.UserToken.Update().
+			Where(usertoken.ID(token.ID), usertoken.UsedAtIsNil()).
+			SetUsedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if used == 0 {
+			return errors.ErrTokenInvalid
+		}
+		userID = token.UserID
+		return tx.UserToken.Update().
+			Where(
+				usertoken.UserID(token.UserID),
+				usertoken.PurposeEQ(purpose),
+				usertoken.UsedAtIsNil(),
+			).
+			SetUsedAt(now).
+			Exec(ctx)
+	})
+	if err != nil {
+		if !exc.Is(err, errors.ErrTokenInvalid) {
+			r.log.Error(err)
+		}
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"GopherChessParty/ent/usertoken"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"github.com/google/uuid"
+)
+
+// memoryUserToken токен из письма с отметкой погашения
+type memoryUserToken struct {
+	token dto.UserToken
+	used  bool
+}
+
+// MemoryUserTokenRepository токены из писем в памяти процесса для тестов и демо без базы данных
+type MemoryUserTokenRepository struct {
+	users  *MemoryUserRepository
+	tokens map[string]*memoryUserToken // По хэшу токена
+	mu     sync.Mutex
+}
+
+func NewMemoryUserTokenRepository(users *MemoryUserRepository) *MemoryUserTokenRepository {
+	return &MemoryUserTokenRepository{
+		users:  users,
+		tokens: make(map[string]*memoryUserToken),
+	}
+}
+
+func (r *MemoryUserTokenRepository) CreateUserToken(_ context.Context, token *dto.UserToken) error {
+	if _, ok := r.users.player(token.UserID); !ok {
+		return errors.ErrUserNotFound
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[token.TokenHash] = &memoryUserToken{token: *token}
+	return nil
+}
+
+func (r *MemoryUserTokenRepository) ConsumeUserToken(
+	_ context.Context,
+	purpose usertoken.Purpose,
+	hash string,
+) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.tokens[hash]
+	if !ok || stored.used || stored.token.Purpose != purpose ||
+		!stored.token.ExpiresAt.After(time.Now()) {
+		return uuid.Nil, errors.ErrTokenInvalid
+	}
+	for _, other := range r.tokens {
+		if other.token.UserID == stored.token.UserID && other.token.Purpose == purpose {
+			other.used = true
+		}
+	}
+	return stored.token.UserID, nil
+}
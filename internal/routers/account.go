@@ -0,0 +1,74 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func addAccountRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	// Повторная отправка письма подтверждения почты
+	verifyRequest := rg.Group("/email/verify/request")
+	verifyRequest.Use(middleware.JWTAuthMiddleware(service))
+	verifyRequest.POST("", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if err := service.RequestEmailVerification(c.Request.Context(), userID); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusAccepted)
+	})
+
+	rg.POST("/email/verify", func(c *gin.Context) {
+		data, err := BindJSON[dto.EmailToken](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		if err := service.VerifyEmail(c.Request.Context(), data.Token); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	// Ответ не зависит от того, зарегистрирована ли почта
+	rg.POST("/password/reset/request", func(c *gin.Context) {
+		data, err := BindJSON[dto.PasswordResetRequest](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		err = service.RequestPasswordReset(c.Request.Context(), data.Email)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusAccepted)
+	})
+
+	// Новый пароль по токену из письма. Все сессии пользователя завершаются.
+	rg.POST("/password/reset", func(c *gin.Context) {
+		data, err := BindJSON[dto.PasswordReset](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		if err := service.ResetPassword(c.Request.Context(), data); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,72 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Маршруты модераторов и администраторов. Права проверяются по роли из токена сессии,
+// персональные токены сюда не допускаются.
+func addAdminRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	admin := rg.Group("/admin")
+	admin.Use(middleware.JWTAuthMiddleware(service))
+
+	admin.GET(
+		"/users/:id",
+		middleware.RequirePermission(dto.PermissionUsersView),
+		func(c *gin.Context) {
+			userID, err := uuid.Parse(c.Param("id"))
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			service := GetService(c)
+			user, err := service.UserByID(c.Request.Context(), userID)
+			if err != nil {
+				c.JSON(
+					errorStatus(err, http.StatusInternalServerError),
+					gin.H{"error": err.Error()},
+				)
+				return
+			}
+			c.JSON(http.StatusOK, gin.H{"item": user})
+		},
+	)
+
+	admin.PUT(
+		"/users/:id/role",
+		middleware.RequirePermission(dto.PermissionRolesManage),
+		func(c *gin.Context) {
+			userID, err := uuid.Parse(c.Param("id"))
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			data, err := BindJSON[dto.SetUserRole](c)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			service := GetService(c)
+			actorID, err := middleware.GetUserID(c)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+			err = service.SetUserRole(c.Request.Context(), actorID, userID, data.Role)
+			if err != nil {
+				c.JSON(
+					errorStatus(err, http.StatusInternalServerError),
+					gin.H{"error": err.Error()},
+				)
+				return
+			}
+			c.Status(http.StatusNoContent)
+		},
+	)
+}
@@ -0,0 +1,40 @@
+package routers_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRoleChangeRevokesSessions(t *testing.T) {
+	a := newApp(t)
+	a.register(t, "admin@example.com", true)
+	moderator := a.register(t, "moderator@example.com", true)
+	a.service.BootstrapAdmins(context.Background(), []string{"admin@example.com"})
+	adminToken := a.login(t, "admin@example.com")
+	role := "/v1/admin/users/" + moderator["id"].(string) + "/role"
+	profile := "/v1/admin/users/" + moderator["id"].(string)
+
+	status, body := a.do(t, http.MethodPut, role, adminToken, map[string]string{"role": "moderator"})
+	if status != http.StatusNoContent {
+		t.Fatalf("promote status = %d, body %v", status, body)
+	}
+	moderatorToken := a.login(t, "moderator@example.com")
+	if status, body := a.do(t, http.MethodGet, profile, moderatorToken, nil); status != http.StatusOK {
+		t.Fatalf("moderator profile status = %d, body %v", status, body)
+	}
+
+	// Снятая роль перестаёт действовать сразу, а не после истечения access-токена
+	status, body = a.do(t, http.MethodPut, role, adminToken, map[string]string{"role": "user"})
+	if status != http.StatusNoContent {
+		t.Fatalf("demote status = %d, body %v", status, body)
+	}
+	status, _ = a.do(t, http.MethodGet, profile, moderatorToken, nil)
+	if status != http.StatusUnauthorized {
+		t.Fatalf("demoted token status = %d, want 401", status)
+	}
+	status, _ = a.do(t, http.MethodGet, profile, a.login(t, "moderator@example.com"), nil)
+	if status != http.StatusForbidden {
+		t.Fatalf("profile after demotion = %d, want 403", status)
+	}
+}
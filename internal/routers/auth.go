@@ -1,32 +1,54 @@
 package routers
 
 import (
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
-func addAuthRoutes(rg *gin.RouterGroup) {
+func addAuthRoutes(rg *gin.RouterGroup, service interfaces.IService) {
 	// Публичный маршрут для логина
 	rg.POST("/login", func(c *gin.Context) {
 		data, err := BindJSON[dto.AuthenticateUser](c)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err})
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
 
 		service := GetService(c)
 
-		userId, ok := service.ValidPassword(*data)
-		if !ok {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Not Valid Password"})
+		userId, retryAfter, err := service.Authenticate(c.Request.Context(), *data, c.ClientIP())
+		if err != nil {
+			loginError(c, err, retryAfter, http.StatusInternalServerError)
 			return
 		}
 
-		tokens, err := service.GenerateTokenPair(*userId)
+		completeLogin(c, service, userId)
+	})
+
+	// Второй шаг входа: код из приложения или код восстановления
+	rg.POST("/login/2fa", func(c *gin.Context) {
+		data, err := BindJSON[dto.TwoFactorLogin](c)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		service := GetService(c)
+		tokens, retryAfter, err := service.LoginTwoFactor(
+			c.Request.Context(),
+			data,
+			clientDevice(c),
+		)
+		if err != nil {
+			loginError(c, err, retryAfter, http.StatusUnauthorized)
 			return
 		}
 
@@ -46,13 +68,13 @@ func addAuthRoutes(rg *gin.RouterGroup) {
 
 		service := GetService(c)
 
-		user, err := service.RegisterUser(data)
+		user, err := service.RegisterUser(c.Request.Context(), data)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 			return
 		}
 
-		tokens, err := service.GenerateTokenPair(user.ID)
+		tokens, err := service.GenerateTokenPair(c.Request.Context(), user.ID, clientDevice(c))
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -76,9 +98,16 @@ func addAuthRoutes(rg *gin.RouterGroup) {
 		}
 
 		service := GetService(c)
-		tokens, err := service.RefreshAccessToken(data.RefreshToken)
+		tokens, err := service.RefreshAccessToken(
+			c.Request.Context(),
+			data.RefreshToken,
+			clientDevice(c),
+		)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+			c.JSON(
+				errorStatus(err, http.StatusUnauthorized),
+				gin.H{"error": "Invalid refresh token"},
+			)
 			return
 		}
 
@@ -87,4 +116,84 @@ func addAuthRoutes(rg *gin.RouterGroup) {
 			"refresh_token": tokens.RefreshToken,
 		})
 	})
+
+	// Выход из текущей сессии
+	logout := rg.Group("/logout")
+	logout.Use(middleware.JWTAuthMiddleware(service))
+	logout.POST("", func(c *gin.Context) {
+		service := GetService(c)
+		sessionID, err := middleware.GetSessionID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if err := service.Logout(c.Request.Context(), sessionID); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	// Выход на всех устройствах, персональные токены тоже отзываются
+	logout.POST("/all", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if err := service.LogoutEverywhere(c.Request.Context(), userID); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+}
+
+// loginError отвечает на неудачный вход. Пока попытки ограничены, клиент получает 429
+// и время до следующей попытки.
+func loginError(c *gin.Context, err error, retryAfter time.Duration, status int) {
+	if retryAfter > 0 {
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		c.Header("Retry-After", strconv.Itoa(seconds))
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"error":       err.Error(),
+			"retry_after": seconds,
+		})
+		return
+	}
+	c.JSON(errorStatus(err, status), gin.H{"error": err.Error()})
+}
+
+// completeLogin открывает сессию пользователя, подтвердившего личность. С включённой 2FA вместо
+// токенов выдаётся токен второго шага, и вход завершается на /login/2fa.
+func completeLogin(c *gin.Context, service interfaces.IService, userId uuid.UUID) {
+	twoFactor, err := service.TwoFactorEnabled(c.Request.Context(), userId)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	if twoFactor {
+		challenge, err := service.GenerateChallenge(userId)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"two_factor_required": true,
+			"challenge_token":     challenge,
+		})
+		return
+	}
+
+	tokens, err := service.GenerateTokenPair(c.Request.Context(), userId, clientDevice(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token":  tokens.AccessToken,
+		"refresh_token": tokens.RefreshToken,
+	})
 }
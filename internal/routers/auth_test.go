@@ -0,0 +1,62 @@
+package routers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// loginFrom входит с адреса соединения httptest и заголовком X-Forwarded-For и возвращает адрес
+// открытой сессии
+func loginFrom(t *testing.T, a *app, router *gin.Engine, email, forwardedFor string) string {
+	t.Helper()
+	payload, err := json.Marshal(dto.AuthenticateUser{Email: email, Password: "password123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/v1/login", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Forwarded-For", forwardedFor)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	body := map[string]any{}
+	_ = json.Unmarshal(rec.Body.Bytes(), &body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login status = %d, body %v", rec.Code, body)
+	}
+
+	status, sessions := a.do(t, http.MethodGet, "/v1/sessions", body["access_token"].(string), nil)
+	items, _ := sessions["items"].([]any)
+	for _, item := range items {
+		if session := item.(map[string]any); session["current"] == true {
+			return session["ip"].(string)
+		}
+	}
+	t.Fatalf("sessions = %d %v, want the current one", status, sessions)
+	return ""
+}
+
+func TestClientIPIgnoresUntrustedProxies(t *testing.T) {
+	a := newApp(t)
+	a.register(t, "proxy@example.com", false)
+
+	if ip := loginFrom(t, a, a.router, "proxy@example.com", "203.0.113.7"); ip != "192.0.2.1" {
+		t.Fatalf("session ip = %s, want the connection address 192.0.2.1", ip)
+	}
+
+	trusted := routers.New(
+		a.service,
+		logger.New(dto.Application{Env: "prod"}),
+		dto.Application{TrustedProxies: []string{"192.0.2.0/24"}},
+	)
+	if ip := loginFrom(t, a, trusted, "proxy@example.com", "203.0.113.7"); ip != "203.0.113.7" {
+		t.Fatalf("session ip behind a trusted proxy = %s, want 203.0.113.7", ip)
+	}
+}
@@ -0,0 +1,110 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// API ботов: партия читается из потока NDJSON, ходы и действия отправляются по REST.
+// Персональному токену нужно право bot:play.
+func addBotRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	bot := rg.Group("/bot")
+	bot.Use(middleware.JWTAuthMiddleware(service, dto.ScopeBotPlay))
+
+	bot.POST("/account/upgrade", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if err := service.UpgradeToBot(c.Request.Context(), userID); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	// Поток партии: то же состояние, что получает сокет партии, по строке на сообщение
+	bot.GET("/game/stream/:game_id", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		gameID, err := uuid.Parse(c.Param("game_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx := c.Request.Context()
+		if err := service.RequireBot(ctx, userID); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		stream := dto.NewEventStream()
+		player := &dto.PlayerConn{UserID: userID, Stream: stream}
+		if err := service.SetConnGame(ctx, gameID, player); err != nil {
+			c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+			return
+		}
+		defer func() {
+			service.LeaveGame(gameID, player)
+			_ = service.CloseConnection(player)
+		}()
+		writeStream(c, stream)
+	})
+
+	bot.POST("/game/:game_id/move/:move", func(c *gin.Context) {
+		botGameAction(c, &dto.GameAction{Move: c.Param("move")})
+	})
+	bot.POST("/game/:game_id/resign", func(c *gin.Context) {
+		botGameAction(c, &dto.GameAction{Action: dto.ActionResign})
+	})
+	bot.POST("/game/:game_id/abort", func(c *gin.Context) {
+		botGameAction(c, &dto.GameAction{Action: dto.ActionAbort})
+	})
+	bot.POST("/game/:game_id/draw/offer", func(c *gin.Context) {
+		botGameAction(c, &dto.GameAction{Action: dto.ActionOfferDraw})
+	})
+	bot.POST("/game/:game_id/draw/decline", func(c *gin.Context) {
+		botGameAction(c, &dto.GameAction{Action: dto.ActionDeclineDraw})
+	})
+}
+
+// botGameAction выполняет действие бота. Если партией владеет другой узел, действие принимается
+// к исполнению с 202, а его результат приходит в поток партии.
+func botGameAction(c *gin.Context, action *dto.GameAction) {
+	service := GetService(c)
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	gameID, err := uuid.Parse(c.Param("game_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	applied, err := service.BotGameAction(c.Request.Context(), gameID, userID, action)
+	if err != nil {
+		response := gin.H{"error": err.Error()}
+		if action.Action == "" {
+			response["reason"] = errors.MoveRejectReason(err)
+		}
+		c.JSON(errorStatus(err, http.StatusBadRequest), response)
+		return
+	}
+	if !applied {
+		c.JSON(http.StatusAccepted, gin.H{"ok": true})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"ok": true})
+}
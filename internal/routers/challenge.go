@@ -0,0 +1,89 @@
+package routers
+
+import (
+	"context"
+	"net/http"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Вызовы на партию. Отвечают на вызовы и боты, поэтому персональному токену подходит любое
+// из прав challenges:write и bot:play.
+func addChallengeRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	challenges := rg.Group("/challenges")
+	challenges.Use(
+		middleware.JWTAuthMiddleware(service, dto.ScopeChallengesWrite, dto.ScopeBotPlay),
+	)
+
+	challenges.GET("", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		items, err := service.PendingChallenges(c.Request.Context(), userID)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": items})
+	})
+
+	challenges.POST("", func(c *gin.Context) {
+		data, err := BindJSON[dto.CreateChallenge](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		item, err := service.SendChallenge(c.Request.Context(), userID, data)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"item": item})
+	})
+
+	challenges.POST("/:id/accept", func(c *gin.Context) {
+		answerChallenge(c, GetService(c).AcceptChallenge)
+	})
+	challenges.POST("/:id/decline", func(c *gin.Context) {
+		answerChallenge(c, GetService(c).DeclineChallenge)
+	})
+	challenges.POST("/:id/cancel", func(c *gin.Context) {
+		answerChallenge(c, GetService(c).CancelChallenge)
+	})
+}
+
+// answerChallenge отвечает на вызов из пути запроса
+func answerChallenge(
+	c *gin.Context,
+	answer func(ctx context.Context, userID, challengeID uuid.UUID) (*dto.Challenge, error),
+) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	challengeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	item, err := answer(c.Request.Context(), userID, challengeID)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"item": item})
+}
@@ -0,0 +1,83 @@
+package routers_test
+
+import (
+	"net/http"
+	"testing"
+)
+
+// challenge вызывает dest на партию и возвращает статус ответа и вызов
+func (a *app) challenge(t *testing.T, token, dest string) (int, map[string]any) {
+	t.Helper()
+	status, body := a.do(t, http.MethodPost, "/v1/challenges", token, map[string]string{
+		"dest_user_id": dest,
+		"color":        "white",
+	})
+	item, _ := body["item"].(map[string]any)
+	return status, item
+}
+
+func TestChallengeRequiresVerifiedEmail(t *testing.T) {
+	a := newApp(t)
+	alice := a.register(t, "alice@example.com", true)
+	bob := a.register(t, "bob@example.com", false)
+	bot := a.register(t, "bot@example.com", false)
+	aliceToken, botToken := a.login(t, "alice@example.com"), a.login(t, "bot@example.com")
+
+	if status, _ := a.challenge(t, aliceToken, bob["id"].(string)); status != http.StatusForbidden {
+		t.Fatalf("challenge to unverified user = %d, want 403", status)
+	}
+	bobToken := a.login(t, "bob@example.com")
+	if status, _ := a.challenge(t, bobToken, alice["id"].(string)); status != http.StatusForbidden {
+		t.Fatalf("challenge from unverified user = %d, want 403", status)
+	}
+
+	// Ботам подтверждённая почта не нужна
+	status, _ := a.do(t, http.MethodPost, "/v1/bot/account/upgrade", botToken, nil)
+	if status != http.StatusNoContent {
+		t.Fatalf("upgrade status = %d", status)
+	}
+	status, item := a.challenge(t, aliceToken, bot["id"].(string))
+	if status != http.StatusCreated {
+		t.Fatalf("challenge to bot = %d, want 201", status)
+	}
+	accept := "/v1/challenges/" + item["id"].(string) + "/accept"
+	status, body := a.do(t, http.MethodPost, accept, botToken, nil)
+	if status != http.StatusOK {
+		t.Fatalf("accept status = %d, body %v", status, body)
+	}
+}
+
+func TestChallengeLimit(t *testing.T) {
+	a := newApp(t)
+	alice := a.register(t, "alice@example.com", true)
+	bob := a.register(t, "bob@example.com", true)
+	aliceToken, bobToken := a.login(t, "alice@example.com"), a.login(t, "bob@example.com")
+
+	var first map[string]any
+	for i := range 10 {
+		status, item := a.challenge(t, aliceToken, bob["id"].(string))
+		if status != http.StatusCreated {
+			t.Fatalf("challenge %d status = %d, want 201", i, status)
+		}
+		if first == nil {
+			first = item
+		}
+	}
+	status, _ := a.challenge(t, aliceToken, bob["id"].(string))
+	if status != http.StatusTooManyRequests {
+		t.Fatalf("challenge over the limit = %d, want 429", status)
+	}
+
+	// Входящие вызовы в лимит не входят, а ответ освобождает место
+	if status, _ := a.challenge(t, bobToken, alice["id"].(string)); status != http.StatusCreated {
+		t.Fatalf("challenge with incoming challenges = %d, want 201", status)
+	}
+	accept := "/v1/challenges/" + first["id"].(string) + "/accept"
+	status, body := a.do(t, http.MethodPost, accept, bobToken, nil)
+	if status != http.StatusOK {
+		t.Fatalf("accept status = %d, body %v", status, body)
+	}
+	if status, _ := a.challenge(t, aliceToken, bob["id"].(string)); status != http.StatusCreated {
+		t.Fatalf("challenge after accept = %d, want 201", status)
+	}
+}
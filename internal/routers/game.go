@@ -3,6 +3,7 @@ package routers
 import (
 	"net/http"
 
+	"GopherChessParty/internal/dto"
 	"GopherChessParty/internal/interfaces"
 	"GopherChessParty/internal/middleware"
 	"github.com/gin-gonic/gin"
@@ -11,7 +12,7 @@ import (
 
 func addChessRoute(rg *gin.RouterGroup, service interfaces.IService) {
 	users := rg.Group("/chess")
-	users.Use(middleware.JWTAuthMiddleware(service))
+	users.Use(middleware.JWTAuthMiddleware(service, dto.ScopeGamesRead))
 	users.GET("/", func(c *gin.Context) {
 		service := GetService(c)
 		userId, err := middleware.GetUserID(c)
@@ -20,9 +21,9 @@ func addChessRoute(rg *gin.RouterGroup, service interfaces.IService) {
 			return
 		}
 
-		games, err := service.GamesByUserID(userId)
+		games, err := service.GamesByUserID(c.Request.Context(), userId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 			return
 		}
 		c.JSON(http.StatusOK, gin.H{"items": games})
@@ -39,9 +40,9 @@ func addChessRoute(rg *gin.RouterGroup, service interfaces.IService) {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		games, err := service.GameByID(gameID)
+		games, err := service.GameByID(c.Request.Context(), gameID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 			return
 		}
 		c.JSON(http.StatusOK, games)
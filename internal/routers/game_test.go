@@ -0,0 +1,38 @@
+package routers_test
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGameHidesPlayerEmails(t *testing.T) {
+	a := newApp(t)
+	a.register(t, "alice@example.com", true)
+	bob := a.register(t, "bob@example.com", true)
+	aliceToken, bobToken := a.login(t, "alice@example.com"), a.login(t, "bob@example.com")
+
+	status, item := a.challenge(t, aliceToken, bob["id"].(string))
+	if status != http.StatusCreated {
+		t.Fatalf("challenge status = %d, want 201", status)
+	}
+	accept := "/v1/challenges/" + item["id"].(string) + "/accept"
+	status, body := a.do(t, http.MethodPost, accept, bobToken, nil)
+	if status != http.StatusOK {
+		t.Fatalf("accept status = %d, body %v", status, body)
+	}
+	gameID := body["item"].(map[string]any)["game_id"].(string)
+
+	status, game := a.do(t, http.MethodGet, "/v1/chess/"+gameID, aliceToken, nil)
+	if status != http.StatusOK {
+		t.Fatalf("game status = %d, body %v", status, game)
+	}
+	for _, color := range []string{"white_user", "black_user"} {
+		player := game[color].(map[string]any)
+		if player["id"] == nil {
+			t.Fatalf("%s = %v, want a player", color, player)
+		}
+		if _, ok := player["email"]; ok {
+			t.Fatalf("%s exposes email: %v", color, player)
+		}
+	}
+}
@@ -1,23 +1,42 @@
 package routers
 
 import (
+	"GopherChessParty/internal/dto"
 	"GopherChessParty/internal/interfaces"
 	"GopherChessParty/internal/middleware"
 	"github.com/gin-gonic/gin"
 )
 
-func New(service interfaces.IService, log interfaces.ILogger) *gin.Engine {
+func New(service interfaces.IService, log interfaces.ILogger, cfg dto.Application) *gin.Engine {
 	router := gin.Default()
+	// Без доверенных прокси адрес клиента берётся из соединения: иначе X-Forwarded-For подменяет
+	// адрес в ограничении попыток входа, письмах о блокировке и сессиях
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		panic(err)
+	}
 
 	// Добавляем CORS middleware
 	router.Use(middleware.CORSMiddleware())
 	// Применение middleware для добавления сервиса в контекст
 	router.Use(middleware.ServiceMiddleware(service))
 
+	addInternalRoutes(&router.RouterGroup, cfg.InternalNetworks)
+	addWellKnownRoutes(&router.RouterGroup)
+
 	v1 := router.Group("/v1")
-	addAuthRoutes(v1)
+	addAuthRoutes(v1, service)
+	addOIDCRoutes(v1, service)
+	addAccountRoutes(v1, service)
+	addSessionRoutes(v1, service)
+	addPersonalTokenRoutes(v1, service)
+	addTwoFactorRoutes(v1, service)
 	addUserRoutes(v1, service)
+	addAdminRoutes(v1, service)
 	addChessRoute(v1, service)
+	addChallengeRoutes(v1, service)
+	addStreamRoutes(v1, service)
+	addBotRoutes(v1, service)
+	// Middleware сокетов добавляются ко всей группе v1, поэтому сокеты регистрируются последними
 	AddWebSocket(v1, service, log)
 	return router
 }
@@ -0,0 +1,19 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// addInternalRoutes служебные эндпоинты для наблюдения за узлом. Доступны только из сетей
+// networks: сбор статистики останавливает процесс, поэтому наружу эндпоинты не отдаются.
+func addInternalRoutes(rg *gin.RouterGroup, networks []string) {
+	internal := rg.Group("/internal")
+	internal.Use(middleware.AllowNetworks(networks))
+	internal.GET("/stats", func(c *gin.Context) {
+		service := GetService(c)
+		c.JSON(http.StatusOK, service.Stats())
+	})
+}
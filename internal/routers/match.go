@@ -1,6 +1,9 @@
 package routers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 
 	"GopherChessParty/internal/dto"
@@ -39,16 +42,27 @@ func SearchMatchHandler(logger interfaces.ILogger) gin.HandlerFunc {
 		player := &dto.PlayerConn{UserID: userId, Conn: conn}
 
 		service := GetService(c)
-		err = service.AddUser(player)
+		err = service.JoinSearch(c.Request.Context(), player)
 		if err != nil {
+			sendSocketError(conn, err)
 			_ = conn.Close()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		player.Conn.SetCloseHandler(func(code int, text string) error {
-			service.ExitPlayerAdd(player.UserID)
-			return nil
-		})
+
+		// Читаем сокет: чтение продлевает heartbeat и принимает отмену поиска
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				// Сокет закрыт или heartbeat не пришёл вовремя — убираем игрока из очереди
+				_ = service.PlayerExit(player)
+				return
+			}
+			var action dto.GameAction
+			if json.Unmarshal(message, &action) == nil && action.Action == dto.ActionCancelSearch {
+				_ = service.CancelSearch(player)
+				return
+			}
+		}
 	}
 }
 
@@ -76,64 +90,55 @@ func MoveGame(logger interfaces.ILogger) gin.HandlerFunc {
 		}
 		player := &dto.PlayerConn{UserID: userID, Conn: conn}
 
+		// Контекст сессии отменяется, когда сокет закрывается и обработчик завершается
+		ctx := c.Request.Context()
 		service := GetService(c)
-		errConn := service.SetConnGame(gameID, player)
+		errConn := service.SetConnGame(ctx, gameID, player)
 		if errConn != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			sendSocketError(conn, errConn)
+			_ = conn.Close()
 			return
 		}
+		defer func() {
+			service.LeaveGame(gameID, player)
+			err := service.CloseConnection(player)
+			if err != nil {
+				logger.Error(err)
+			}
+		}()
 
-		// Запускаем горутину для чтения сообщений
-		go func() {
-			defer func() {
-				err := conn.Close()
-				if err != nil {
+		// Читаем сообщения, пока сокет открыт
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				if websocket.IsUnexpectedCloseError(
+					err,
+					websocket.CloseGoingAway,
+					websocket.CloseAbnormalClosure,
+				) {
 					logger.Error(err)
-					return
-				}
-			}()
-
-			for {
-				messageType, message, err := conn.ReadMessage()
-				if err != nil {
-					if websocket.IsUnexpectedCloseError(
-						err,
-						websocket.CloseGoingAway,
-						websocket.CloseAbnormalClosure,
-					) {
-						logger.Error(err)
-					}
-					return
-				}
-
-				switch messageType {
-				case websocket.TextMessage:
-					// Обрабатываем ход
-					move := string(message)
-					ok := service.MoveGameStr(gameID, move, player)
-					response, _ := service.GetGameInfoMemory(gameID, ok, "")
-					_ = service.SendMessage(player, response)
-				case websocket.PingMessage:
-					err := conn.WriteMessage(websocket.PongMessage, message)
-					if err != nil {
-						logger.Error(err)
-						return
-					}
 				}
+				return
 			}
-		}()
 
-		// Отправляем начальное состояние игры
-		response, err := service.GetGameInfoMemory(gameID, true, "")
-		if err != nil {
-			return
-		}
-		errSend := service.SendMessage(player, response)
-		if errSend != nil {
-			return
+			// Ping обрабатывает обработчик gorilla по умолчанию, ReadMessage управляющие кадры не возвращает
+			if messageType != websocket.TextMessage {
+				continue
+			}
+			// Сообщение обрабатывает узел, владеющий партией
+			err = service.HandleGameMessage(ctx, gameID, player, message)
+			if err != nil {
+				logger.Error(err)
+			}
 		}
+	}
+}
 
-		// Держим соединение открытым
-		select {}
+// sendSocketError отправляет в сокет ошибку, после того как соединение уже переключено на WebSocket
+func sendSocketError(conn *websocket.Conn, err error) {
+	message := gin.H{"error": err.Error()}
+	if errors.Is(err, context.DeadlineExceeded) {
+		message["reason"] = "db_timeout"
 	}
+	_ = conn.WriteJSON(message)
 }
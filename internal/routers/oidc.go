@@ -0,0 +1,64 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Вход через внешних провайдеров OpenID Connect: код авторизации с PKCE
+func addOIDCRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	oidc := rg.Group("/oidc")
+
+	// Публичный список провайдеров для кнопок входа
+	oidc.GET("/providers", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"items": GetService(c).OIDCProviders()})
+	})
+
+	// Начало входа: адрес страницы провайдера и flow-токен, который клиент хранит до возврата
+	oidc.POST("/:provider/start", func(c *gin.Context) {
+		service := GetService(c)
+		start, err := service.StartOIDCLogin(c.Request.Context(), c.Param("provider"))
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, start)
+	})
+
+	// Завершение входа кодом и state, которые провайдер вернул на RedirectURL
+	oidc.POST("/:provider/callback", func(c *gin.Context) {
+		data, err := BindJSON[dto.OIDCCallback](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		userId, err := service.AuthenticateOIDC(c.Request.Context(), c.Param("provider"), data)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		completeLogin(c, service, userId)
+	})
+
+	identities := oidc.Group("/identities")
+	identities.Use(middleware.JWTAuthMiddleware(service))
+	identities.GET("", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		items, err := service.Identities(c.Request.Context(), userID)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": items})
+	})
+}
@@ -0,0 +1,397 @@
+package routers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/eventbus"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/mailer"
+	"GopherChessParty/internal/oidc"
+	"GopherChessParty/internal/oidc/oidctest"
+	"GopherChessParty/internal/repository"
+	"GopherChessParty/internal/routers"
+	"GopherChessParty/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+const redirectURL = "http://localhost:3000/oidc/callback"
+
+// app сервер с репозиториями в памяти и входом через локальный провайдер
+type app struct {
+	router   *gin.Engine
+	service  *services.Service
+	provider *oidctest.Server
+}
+
+func newApp(t *testing.T) *app {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	provider := oidctest.NewServer("gopher-chess", "client-secret")
+	t.Cleanup(provider.Close)
+
+	log := logger.New(dto.Application{Env: "prod"})
+	users := repository.NewMemoryUserRepository()
+	cluster := services.NewClusterService(
+		log,
+		eventbus.NewMemoryBus(log),
+		nil,
+		dto.Cluster{NodeID: "test"},
+	)
+	authCfg := dto.AuthConfig{
+		SecretAccess:  "access",
+		SecretRefresh: "refresh",
+		ExpAccess:     time.Hour,
+		ExpRefresh:    24 * time.Hour,
+	}
+	mailCfg := dto.Mailer{Driver: "log"}
+	service := services.NewService(
+		services.NewUserService(log, users),
+		services.NewGameService(
+			log,
+			repository.NewMemoryGameRepository(users),
+			dto.GameConfig{TimeControl: 10 * time.Minute, FirstMoveTimeout: 30 * time.Second},
+		),
+		services.NewAuthService(
+			log,
+			authCfg,
+			repository.NewMemorySessionRepository(users),
+			users,
+			nil,
+		),
+		services.NewMatchService(log, repository.NewMemoryQueue(), cluster.NodeID()),
+		cluster,
+		services.NewTwoFactorService(log, repository.NewMemoryTwoFactorRepository(users)),
+		services.NewAccountService(
+			log,
+			repository.NewMemoryUserTokenRepository(users),
+			mailer.MustNew(log, mailCfg, "local"),
+			mailCfg,
+		),
+		services.NewLoginThrottleService(log, repository.NewMemoryLoginFailureRepository()),
+		services.NewPersonalTokenService(
+			log,
+			repository.NewMemoryPersonalTokenRepository(users),
+		),
+		services.NewChallengeService(log, repository.NewMemoryChallengeRepository(users)),
+		services.NewOIDCService(
+			log,
+			repository.NewMemoryIdentityRepository(users),
+			[]interfaces.IIdentityProvider{
+				oidc.New(provider.Provider("mock", redirectURL), http.DefaultClient),
+			},
+		),
+		log,
+	)
+	return &app{
+		router:   routers.New(service, log, dto.Application{}),
+		service:  service,
+		provider: provider,
+	}
+}
+
+func (a *app) do(t *testing.T, method, path, token string, body any) (int, map[string]any) {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &payload)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	a.router.ServeHTTP(rec, req)
+	result := map[string]any{}
+	_ = json.Unmarshal(rec.Body.Bytes(), &result)
+	return rec.Code, result
+}
+
+// oidcLogin проходит вход через провайдер за пользователя user и возвращает ответ callback
+func (a *app) oidcLogin(t *testing.T, user oidctest.User) (int, map[string]any) {
+	t.Helper()
+	return a.do(t, http.MethodPost, "/v1/oidc/mock/callback", "", a.oidcAuthorize(t, user))
+}
+
+// oidcAuthorize входит у провайдера за пользователя user и возвращает запрос callback
+func (a *app) oidcAuthorize(t *testing.T, user oidctest.User) dto.OIDCCallback {
+	t.Helper()
+	a.provider.Login(user)
+	status, start := a.do(t, http.MethodPost, "/v1/oidc/mock/start", "", nil)
+	if status != http.StatusOK {
+		t.Fatalf("start status = %d, body %v", status, start)
+	}
+	code, state, err := a.provider.Authorize(start["authorization_url"].(string))
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	return dto.OIDCCallback{Code: code, State: state, FlowToken: start["flow_token"].(string)}
+}
+
+// me возвращает аккаунт по access-токену
+func (a *app) me(t *testing.T, token string) map[string]any {
+	t.Helper()
+	status, body := a.do(t, http.MethodGet, "/v1/users/me", token, nil)
+	if status != http.StatusOK {
+		t.Fatalf("me status = %d, body %v", status, body)
+	}
+	return body["item"].(map[string]any)
+}
+
+func (a *app) register(t *testing.T, email string, verified bool) map[string]any {
+	t.Helper()
+	status, body := a.do(t, http.MethodPost, "/v1/register", "", dto.CreateUser{
+		Name:     "local",
+		Email:    email,
+		Password: "password123",
+	})
+	if status != http.StatusOK {
+		t.Fatalf("register status = %d, body %v", status, body)
+	}
+	user := a.me(t, body["access_token"].(string))
+	if verified {
+		id := user["id"].(string)
+		account, err := a.service.UserPassword(context.Background(), email)
+		if err != nil || account.UserID.String() != id {
+			t.Fatalf("UserPassword = %+v, %v", account, err)
+		}
+		if err := a.service.SetEmailVerified(context.Background(), account.UserID); err != nil {
+			t.Fatalf("SetEmailVerified: %v", err)
+		}
+	}
+	return user
+}
+
+// login входит по паролю, заданному в register, и возвращает access-токен
+func (a *app) login(t *testing.T, email string) string {
+	t.Helper()
+	status, body := a.do(t, http.MethodPost, "/v1/login", "", dto.AuthenticateUser{
+		Email:    email,
+		Password: "password123",
+	})
+	if status != http.StatusOK {
+		t.Fatalf("login status = %d, body %v", status, body)
+	}
+	return body["access_token"].(string)
+}
+
+func TestOIDCProviders(t *testing.T) {
+	a := newApp(t)
+	status, body := a.do(t, http.MethodGet, "/v1/oidc/providers", "", nil)
+	items, _ := body["items"].([]any)
+	if status != http.StatusOK || len(items) != 1 || items[0] != "mock" {
+		t.Fatalf("providers = %d %v, want [mock]", status, body)
+	}
+	status, _ = a.do(t, http.MethodPost, "/v1/oidc/unknown/start", "", nil)
+	if status != http.StatusNotFound {
+		t.Fatalf("unknown provider status = %d, want 404", status)
+	}
+}
+
+func TestOIDCCreatesAccount(t *testing.T) {
+	a := newApp(t)
+	bob := oidctest.User{
+		Subject:       "bob-sub",
+		Email:         "bob@example.com",
+		EmailVerified: true,
+		Name:          "Bob",
+	}
+	status, body := a.oidcLogin(t, bob)
+	if status != http.StatusOK {
+		t.Fatalf("callback status = %d, body %v", status, body)
+	}
+	user := a.me(t, body["access_token"].(string))
+	if user["email"] != bob.Email || user["name"] != bob.Name || user["email_verified"] != true {
+		t.Fatalf("created user = %v", user)
+	}
+	status, identities := a.do(
+		t,
+		http.MethodGet,
+		"/v1/oidc/identities",
+		body["access_token"].(string),
+		nil,
+	)
+	items, _ := identities["items"].([]any)
+	if status != http.StatusOK || len(items) != 1 {
+		t.Fatalf("identities = %d %v, want one", status, identities)
+	}
+
+	// Следующий вход находит аккаунт по sub, даже если почта у провайдера сменилась
+	bob.Email = "bob@new.example.com"
+	status, body = a.oidcLogin(t, bob)
+	if status != http.StatusOK {
+		t.Fatalf("second callback status = %d, body %v", status, body)
+	}
+	if again := a.me(t, body["access_token"].(string)); again["id"] != user["id"] {
+		t.Fatalf("second login user = %v, want %v", again["id"], user["id"])
+	}
+
+	// Refresh выдаёт новую пару токенов той же сессии
+	status, refreshed := a.do(t, http.MethodPost, "/v1/refresh", "", map[string]string{
+		"refresh_token": body["refresh_token"].(string),
+	})
+	if status != http.StatusOK || refreshed["access_token"] == nil {
+		t.Fatalf("refresh = %d %v", status, refreshed)
+	}
+}
+
+func TestOIDCLinksVerifiedAccount(t *testing.T) {
+	a := newApp(t)
+	local := a.register(t, "alice@example.com", true)
+	status, body := a.oidcLogin(t, oidctest.User{
+		Subject:       "alice-sub",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Name:          "Alice",
+	})
+	if status != http.StatusOK {
+		t.Fatalf("callback status = %d, body %v", status, body)
+	}
+	if user := a.me(t, body["access_token"].(string)); user["id"] != local["id"] {
+		t.Fatalf("linked user = %v, want %v", user["id"], local["id"])
+	}
+}
+
+func TestOIDCRejectsUnverifiedAccount(t *testing.T) {
+	a := newApp(t)
+	a.register(t, "carol@example.com", false)
+	status, body := a.oidcLogin(t, oidctest.User{
+		Subject:       "carol-sub",
+		Email:         "carol@example.com",
+		EmailVerified: true,
+	})
+	if status != http.StatusForbidden {
+		t.Fatalf("callback status = %d, body %v, want 403", status, body)
+	}
+}
+
+func TestOIDCRejectsUnverifiedProviderEmail(t *testing.T) {
+	a := newApp(t)
+	status, body := a.oidcLogin(t, oidctest.User{
+		Subject: "dave-sub",
+		Email:   "dave@example.com",
+	})
+	if status != http.StatusForbidden {
+		t.Fatalf("callback status = %d, body %v, want 403", status, body)
+	}
+	if _, err := a.service.UserPassword(context.Background(), "dave@example.com"); err == nil {
+		t.Fatal("account created for unverified provider email")
+	}
+}
+
+func TestOIDCRejectsStateMismatch(t *testing.T) {
+	a := newApp(t)
+	a.provider.Login(
+		oidctest.User{Subject: "eve-sub", Email: "eve@example.com", EmailVerified: true},
+	)
+	_, start := a.do(t, http.MethodPost, "/v1/oidc/mock/start", "", nil)
+	code, _, err := a.provider.Authorize(start["authorization_url"].(string))
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	// Код из чужого входа не подходит к flow-токену с другим state
+	_, other := a.do(t, http.MethodPost, "/v1/oidc/mock/start", "", nil)
+	status, body := a.do(t, http.MethodPost, "/v1/oidc/mock/callback", "", dto.OIDCCallback{
+		Code:      code,
+		State:     "forged-state",
+		FlowToken: other["flow_token"].(string),
+	})
+	if status != http.StatusBadRequest {
+		t.Fatalf("callback status = %d, body %v, want 400", status, body)
+	}
+}
+
+func TestOIDCMatchesEmailIgnoringCase(t *testing.T) {
+	a := newApp(t)
+	local := a.register(t, "Frank@Example.com", true)
+	status, body := a.oidcLogin(t, oidctest.User{
+		Subject:       "frank-sub",
+		Email:         " frank@EXAMPLE.com",
+		EmailVerified: true,
+	})
+	if status != http.StatusOK {
+		t.Fatalf("callback status = %d, body %v", status, body)
+	}
+	if user := a.me(t, body["access_token"].(string)); user["id"] != local["id"] {
+		t.Fatalf("linked user = %v, want %v", user["id"], local["id"])
+	}
+}
+
+func TestOIDCConcurrentFirstLogin(t *testing.T) {
+	a := newApp(t)
+	// Первый вход с одной почтой через разных пользователей провайдера и повторные входы одного
+	callbacks := make([]dto.OIDCCallback, 0, 6)
+	for _, subject := range []string{"grace-a", "grace-a", "grace-a", "grace-b", "grace-b", "grace-c"} {
+		callbacks = append(callbacks, a.oidcAuthorize(t, oidctest.User{
+			Subject:       subject,
+			Email:         "grace@example.com",
+			EmailVerified: true,
+		}))
+	}
+	var wg sync.WaitGroup
+	tokens := make([]string, len(callbacks))
+	for i, callback := range callbacks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, body := a.do(t, http.MethodPost, "/v1/oidc/mock/callback", "", callback)
+			if status != http.StatusOK {
+				t.Errorf("callback status = %d, body %v", status, body)
+				return
+			}
+			tokens[i] = body["access_token"].(string)
+		}()
+	}
+	wg.Wait()
+	if t.Failed() {
+		return
+	}
+
+	user := a.me(t, tokens[0])
+	for _, token := range tokens[1:] {
+		if other := a.me(t, token); other["id"] != user["id"] {
+			t.Fatalf("user = %v, want %v", other["id"], user["id"])
+		}
+	}
+	_, identities := a.do(t, http.MethodGet, "/v1/oidc/identities", tokens[0], nil)
+	if items, _ := identities["items"].([]any); len(items) != 3 {
+		t.Fatalf("identities = %v, want 3", identities)
+	}
+}
+
+func TestOIDCLongEmail(t *testing.T) {
+	a := newApp(t)
+	long := strings.Repeat("h", 120) + "@example.com"
+	status, body := a.oidcLogin(t, oidctest.User{
+		Subject:       "heidi-sub",
+		Email:         long,
+		EmailVerified: true,
+	})
+	if status != http.StatusOK {
+		t.Fatalf("callback status = %d, body %v", status, body)
+	}
+	if user := a.me(t, body["access_token"].(string)); user["email"] != long {
+		t.Fatalf("created user = %v", user)
+	}
+
+	status, body = a.oidcLogin(t, oidctest.User{
+		Subject:       "ivan-sub",
+		Email:         strings.Repeat("i", 250) + "@example.com",
+		EmailVerified: true,
+	})
+	if status != http.StatusBadRequest {
+		t.Fatalf("callback status = %d, body %v, want 400", status, body)
+	}
+}
@@ -0,0 +1,72 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Персональными токенами управляют только из сессии: токен не может выпустить или отозвать токен
+func addPersonalTokenRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	tokens := rg.Group("/tokens")
+	tokens.Use(middleware.JWTAuthMiddleware(service))
+
+	tokens.GET("", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		items, err := service.PersonalTokens(c.Request.Context(), userID)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": items})
+	})
+
+	// Токен возвращается в ответе один раз, потом его не узнать
+	tokens.POST("", func(c *gin.Context) {
+		data, err := BindJSON[dto.CreatePersonalToken](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		token, err := service.CreatePersonalToken(c.Request.Context(), userID, data)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{"item": token})
+	})
+
+	tokens.DELETE("/:id", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		tokenID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := service.RevokePersonalToken(c.Request.Context(), userID, tokenID); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+}
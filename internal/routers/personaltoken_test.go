@@ -0,0 +1,67 @@
+package routers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"GopherChessParty/internal/dto"
+)
+
+// personalToken входит под email и выпускает персональный токен с правами scopes
+func (a *app) personalToken(t *testing.T, email string, scopes ...dto.Scope) (string, string) {
+	t.Helper()
+	access := a.login(t, email)
+	status, body := a.do(t, http.MethodPost, "/v1/tokens", access, dto.CreatePersonalToken{
+		Name:   "script",
+		Scopes: scopes,
+	})
+	if status != http.StatusCreated {
+		t.Fatalf("create token status = %d, body %v", status, body)
+	}
+	return access, body["item"].(map[string]any)["token"].(string)
+}
+
+func TestPersonalTokenScopes(t *testing.T) {
+	a := newApp(t)
+	a.register(t, "frank@example.com", true)
+	access, games := a.personalToken(t, "frank@example.com", dto.ScopeGamesRead)
+	_, bot := a.personalToken(t, "frank@example.com", dto.ScopeBotPlay)
+	unknown := dto.PersonalTokenPrefix + "unknown"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		{"matching scope", http.MethodGet, "/v1/chess/", games, http.StatusOK},
+		{"other scope", http.MethodGet, "/v1/chess/", bot, http.StatusForbidden},
+		{"group without scopes", http.MethodGet, "/v1/users/me", games, http.StatusForbidden},
+		{"token management", http.MethodGet, "/v1/tokens", games, http.StatusForbidden},
+		{"session token", http.MethodGet, "/v1/users/me", access, http.StatusOK},
+		{"unknown token", http.MethodGet, "/v1/chess/", unknown, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, body := a.do(t, tt.method, tt.path, tt.token, nil); status != tt.want {
+				t.Fatalf("%s %s = %d, body %v, want %d", tt.method, tt.path, status, body, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogoutAllRevokesPersonalTokens(t *testing.T) {
+	a := newApp(t)
+	a.register(t, "grace@example.com", true)
+	access, token := a.personalToken(t, "grace@example.com", dto.ScopeGamesRead)
+
+	status, body := a.do(t, http.MethodPost, "/v1/logout/all", access, nil)
+	if status != http.StatusNoContent {
+		t.Fatalf("logout all status = %d, body %v", status, body)
+	}
+	status, _ = a.do(t, http.MethodGet, "/v1/chess/", token, nil)
+	if status != http.StatusUnauthorized {
+		t.Fatalf("revoked token status = %d, want 401", status)
+	}
+}
@@ -0,0 +1,56 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func addSessionRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	sessions := rg.Group("/sessions")
+	sessions.Use(middleware.JWTAuthMiddleware(service))
+
+	// Действующие сессии пользователя: где и когда выполнен вход
+	sessions.GET("", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		sessionID, err := middleware.GetSessionID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		items, err := service.Sessions(c.Request.Context(), userID, sessionID)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": items})
+	})
+
+	// Выход на одном из устройств
+	sessions.DELETE("/:id", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		sessionID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid session id"})
+			return
+		}
+		if err := service.RevokeSession(c.Request.Context(), userID, sessionID); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+}
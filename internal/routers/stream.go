@@ -0,0 +1,81 @@
+package routers
+
+import (
+	"net/http"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// streamKeepAlive период пустых строк в потоке, по которым клиент и прокси видят, что поток жив
+const streamKeepAlive = 7 * time.Second
+
+// Поток событий пользователя: входящие вызовы и начало партий
+func addStreamRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	stream := rg.Group("/stream")
+	stream.Use(middleware.JWTAuthMiddleware(service, dto.ScopeBotPlay, dto.ScopeChallengesWrite))
+
+	stream.GET("/event", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		events, err := service.OpenEventStream(c.Request.Context(), userID)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		defer service.CloseEventStream(userID, events)
+		writeStream(c, events)
+	})
+}
+
+// writeStream пишет сообщения потока клиенту в формате NDJSON, пока клиент или сервер его не закроют.
+// Сообщения, пришедшие до закрытия потока сервером, дописываются.
+func writeStream(c *gin.Context, stream *dto.EventStream) {
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(streamKeepAlive)
+	defer ticker.Stop()
+	write := func(message []byte) bool {
+		if _, err := c.Writer.Write(append(message, '\n')); err != nil {
+			return false
+		}
+		c.Writer.Flush()
+		return true
+	}
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-stream.Done():
+			for {
+				select {
+				case message := <-stream.Messages():
+					if !write(message) {
+						return
+					}
+				default:
+					return
+				}
+			}
+		case message := <-stream.Messages():
+			if !write(message) {
+				return
+			}
+		case <-ticker.C:
+			if !write(nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package routers
+
+import (
+	"net/http"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"GopherChessParty/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func addTwoFactorRoutes(rg *gin.RouterGroup, service interfaces.IService) {
+	twoFactor := rg.Group("/users/me/2fa")
+	twoFactor.Use(middleware.JWTAuthMiddleware(service))
+
+	twoFactor.GET("", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		enabled, err := service.TwoFactorEnabled(c.Request.Context(), userID)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"enabled": enabled})
+	})
+
+	// Новый секрет и коды восстановления. 2FA включается после подтверждения кодом.
+	twoFactor.POST("", func(c *gin.Context) {
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		enrollment, err := service.EnrollTwoFactor(c.Request.Context(), userID)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"item": enrollment})
+	})
+
+	twoFactor.POST("/confirm", func(c *gin.Context) {
+		data, err := BindJSON[dto.TwoFactorCode](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if err := service.ConfirmTOTP(c.Request.Context(), userID, data.Code); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	twoFactor.POST("/disable", func(c *gin.Context) {
+		data, err := BindJSON[dto.DisableTwoFactor](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		service := GetService(c)
+		userID, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		retryAfter, err := service.DisableTwoFactor(c.Request.Context(), userID, data)
+		if err != nil {
+			loginError(c, err, retryAfter, http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+}
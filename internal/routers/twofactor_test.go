@@ -0,0 +1,30 @@
+package routers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"GopherChessParty/internal/dto"
+)
+
+func TestDisableTwoFactorThrottled(t *testing.T) {
+	a := newApp(t)
+	a.register(t, "heidi@example.com", true)
+	access := a.login(t, "heidi@example.com")
+	data := dto.DisableTwoFactor{Password: "wrong-password", Code: "000000"}
+
+	// Первые попытки проходят без пауз, следующая после них ограничена
+	for range 4 {
+		status, body := a.do(t, http.MethodPost, "/v1/users/me/2fa/disable", access, data)
+		if status != http.StatusUnauthorized {
+			t.Fatalf("wrong password status = %d, body %v, want 401", status, body)
+		}
+	}
+	status, body := a.do(t, http.MethodPost, "/v1/users/me/2fa/disable", access, data)
+	if status != http.StatusTooManyRequests {
+		t.Fatalf("throttled status = %d, body %v, want 429", status, body)
+	}
+	if body["retry_after"] == nil {
+		t.Fatalf("throttled body %v has no retry_after", body)
+	}
+}
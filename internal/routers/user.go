@@ -20,23 +20,36 @@ func addUserRoutes(rg *gin.RouterGroup, service interfaces.IService) {
 
 		service := GetService(c)
 
-		user, err := service.CreateUser(data)
+		user, err := service.CreateUser(c.Request.Context(), data)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "not create user"})
+			c.JSON(
+				errorStatus(err, http.StatusInternalServerError),
+				gin.H{"error": "not create user"},
+			)
+			return
 		}
 
 		c.JSON(http.StatusOK, gin.H{"item": user})
 	})
 	users.Use(middleware.JWTAuthMiddleware(service))
+	// Полный список с почтой видят только администраторы, остальные — публичные профили
 	users.GET("/", func(c *gin.Context) {
 		service := GetService(c)
-		users, err := service.Users()
+		users, err := service.Users(c.Request.Context())
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		if middleware.HasPermission(c, dto.PermissionUsersList) {
+			c.JSON(http.StatusOK, gin.H{"items": users})
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"items": users})
+		profiles := make([]dto.PublicUser, 0, len(users))
+		for _, user := range users {
+			profiles = append(profiles, user.Public())
+		}
+		c.JSON(http.StatusOK, gin.H{"items": profiles})
 	})
 	users.GET("/me", func(c *gin.Context) {
 		service := GetService(c)
@@ -45,12 +58,32 @@ func addUserRoutes(rg *gin.RouterGroup, service interfaces.IService) {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 			return
 		}
-		user, err := service.UserByID(userId)
+		user, err := service.UserByID(c.Request.Context(), userId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusOK, gin.H{"item": user})
 	})
+	users.PUT("/me/preferences", func(c *gin.Context) {
+		service := GetService(c)
+		userId, err := middleware.GetUserID(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		data, err := BindJSON[dto.UserPreferences](c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		err = service.SetAutoQueen(c.Request.Context(), userId, *data.AutoQueen)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"item": data})
+	})
 }
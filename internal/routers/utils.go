@@ -1,9 +1,13 @@
 package routers
 
 import (
+	"context"
+	exc "errors"
 	"net/http"
 	"time"
 
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
 	"GopherChessParty/internal/interfaces"
 	"GopherChessParty/internal/services"
 	"github.com/gin-gonic/gin"
@@ -11,8 +15,8 @@ import (
 )
 
 const (
-	writeWait      = 5 * time.Minute
-	pongWait       = 1 * time.Second
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
 	pingPeriod     = (pongWait * 9) / 10
 	maxMessageSize = 512
 )
@@ -35,6 +39,58 @@ func GetService(c *gin.Context) interfaces.IService {
 	return svc.(*services.Service)
 }
 
+// errorStatus возвращает HTTP-статус для известной ошибки сервиса, иначе status
+func errorStatus(err error, status int) int {
+	switch {
+	case exc.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case exc.Is(err, errors.ErrEmailTaken),
+		exc.Is(err, errors.ErrEmailVerified),
+		exc.Is(err, errors.ErrTOTPEnabled),
+		exc.Is(err, errors.ErrTOTPNotEnabled),
+		exc.Is(err, errors.ErrAlreadyBot),
+		exc.Is(err, errors.ErrBotHasGames),
+		exc.Is(err, errors.ErrOwnRole):
+		return http.StatusConflict
+	case exc.Is(err, errors.ErrSessionNotFound),
+		exc.Is(err, errors.ErrPersonalTokenNotFound),
+		exc.Is(err, errors.ErrChallengeNotFound),
+		exc.Is(err, errors.ErrProviderNotFound):
+		return http.StatusNotFound
+	case exc.Is(err, errors.ErrBadCredentials),
+		exc.Is(err, errors.ErrBadSecondFactor),
+		exc.Is(err, errors.ErrInvalidPassword),
+		exc.Is(err, errors.ErrInvalidOTP),
+		exc.Is(err, errors.ErrOIDCExchange),
+		exc.Is(err, errors.ErrOIDCToken):
+		return http.StatusUnauthorized
+	case exc.Is(err, errors.ErrLoginThrottled),
+		exc.Is(err, errors.ErrChallengeExhausted),
+		exc.Is(err, errors.ErrTooManyChallenges):
+		return http.StatusTooManyRequests
+	case exc.Is(err, errors.ErrTokenInvalid),
+		exc.Is(err, errors.ErrOIDCState),
+		exc.Is(err, errors.ErrOIDCEmailTooLong):
+		return http.StatusBadRequest
+	case exc.Is(err, errors.ErrEmailUnverified),
+		exc.Is(err, errors.ErrNotBot),
+		exc.Is(err, errors.ErrBotSearch),
+		exc.Is(err, errors.ErrOIDCEmailUnverified):
+		return http.StatusForbidden
+	case exc.Is(err, errors.ErrProviderUnavailable):
+		return http.StatusBadGateway
+	}
+	return status
+}
+
+// clientDevice возвращает устройство, с которого пришёл запрос
+func clientDevice(c *gin.Context) dto.Device {
+	return dto.Device{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	}
+}
+
 func BindJSON[T any](c *gin.Context) (*T, error) {
 	var data T
 	if err := c.BindJSON(&data); err != nil {
@@ -70,7 +126,9 @@ func CreateWebSocket(c *gin.Context) (*websocket.Conn, error) {
 		ticker := time.NewTicker(pingPeriod)
 		defer ticker.Stop()
 		for range ticker.C {
-			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			// WriteControl безопасно вызывать параллельно с отправкой сообщений
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+			if err != nil {
 				return // соединение закрыто или ошибка
 			}
 		}
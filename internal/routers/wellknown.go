@@ -0,0 +1,20 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// addWellKnownRoutes публичные эндпоинты для других сервисов
+func addWellKnownRoutes(rg *gin.RouterGroup) {
+	wellKnown := rg.Group("/.well-known")
+
+	// Открытые ключи для проверки токенов без обращения к серверу. Сервис, встретивший
+	// неизвестный kid, должен запросить набор заново.
+	wellKnown.GET("/jwks.json", func(c *gin.Context) {
+		service := GetService(c)
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, service.JWKS())
+	})
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"GopherChessParty/ent/usertoken"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+const (
+	verifyEmailTTL   = 24 * time.Hour
+	resetPasswordTTL = time.Hour
+	userTokenBytes   = 32
+	mailSendTimeout  = 30 * time.Second // Время на отправку письма в фоне
+)
+
+// AccountService выдаёт одноразовые токены подтверждения почты и сброса пароля и рассылает их письмами.
+// В базе хранится только sha256 токена.
+type AccountService struct {
+	log    interfaces.ILogger
+	tokens interfaces.IUserTokenRepo
+	mailer interfaces.IMailer
+	cfg    dto.Mailer
+}
+
+func NewAccountService(
+	log interfaces.ILogger,
+	tokens interfaces.IUserTokenRepo,
+	mailer interfaces.IMailer,
+	cfg dto.Mailer,
+) interfaces.IAccountService {
+	return &AccountService{log: log, tokens: tokens, mailer: mailer, cfg: cfg}
+}
+
+// SendVerification отправляет письмо со ссылкой подтверждения почты
+func (s *AccountService) SendVerification(ctx context.Context, user *dto.User) error {
+	token, err := s.issue(ctx, user.ID, usertoken.PurposeVerifyEmail, verifyEmailTTL)
+	if err != nil {
+		return err
+	}
+	s.send(&dto.Mail{
+		To:      user.Email,
+		Subject: "Подтверждение почты",
+		Body: fmt.Sprintf(
+			"Здравствуйте, %s!\n\nЧтобы подтвердить почту, перейдите по ссылке:\n%s\n\n"+
+				"Ссылка действует %d ч. Если вы не регистрировались, проигнорируйте письмо.\n",
+			user.Name,
+			s.link("/verify-email", token),
+			int(verifyEmailTTL.Hours()),
+		),
+	})
+	return nil
+}
+
+// SendPasswordReset отправляет письмо со ссылкой сброса пароля
+func (s *AccountService) SendPasswordReset(ctx context.Context, user *dto.User) error {
+	token, err := s.issue(ctx, user.ID, usertoken.PurposeResetPassword, resetPasswordTTL)
+	if err != nil {
+		return err
+	}
+	s.send(&dto.Mail{
+		To:      user.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf(
+			"Здравствуйте, %s!\n\nЧтобы задать новый пароль, перейдите по ссылке:\n%s\n\n"+
+				"Ссылка действует %d ч. После сброса все открытые сессии будут завершены.\n"+
+				"Если вы не запрашивали сброс, проигнорируйте письмо.\n",
+			user.Name,
+			s.link("/reset-password", token),
+			int(resetPasswordTTL.Hours()),
+		),
+	})
+	return nil
+}
+
+// SendLockoutNotice предупреждает владельца аккаунта, что вход заблокирован после серии неудачных попыток
+func (s *AccountService) SendLockoutNotice(user *dto.User, ip string) {
+	s.send(&dto.Mail{
+		To:      user.Email,
+		Subject: "Вход в аккаунт заблокирован",
+		Body: fmt.Sprintf(
+			"Здравствуйте, %s!\n\nПосле нескольких неудачных попыток входа с адреса %s "+
+				"вход в аккаунт заблокирован на %d мин.\n\n"+
+				"Если это были не вы, смените пароль через восстановление пароля.\n",
+			user.Name,
+			ip,
+			int(loginLockout.Minutes()),
+		),
+	})
+}
+
+// ConsumeVerification гасит токен подтверждения почты и возвращает его владельца
+func (s *AccountService) ConsumeVerification(ctx context.Context, token string) (uuid.UUID, error) {
+	return s.tokens.ConsumeUserToken(ctx, usertoken.PurposeVerifyEmail, hashUserToken(token))
+}
+
+// ConsumePasswordReset гасит токен сброса пароля и возвращает его владельца
+func (s *AccountService) ConsumePasswordReset(
+	ctx context.Context,
+	token string,
+) (uuid.UUID, error) {
+	return s.tokens.ConsumeUserToken(ctx, usertoken.PurposeResetPassword, hashUserToken(token))
+}
+
+// issue создаёт случайный токен и сохраняет его хэш
+func (s *AccountService) issue(
+	ctx context.Context,
+	userID uuid.UUID,
+	purpose usertoken.Purpose,
+	ttl time.Duration,
+) (string, error) {
+	raw := make([]byte, userTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	now := time.Now()
+	err := s.tokens.CreateUserToken(ctx, &dto.UserToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: hashUserToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// send отправляет письмо в фоне, чтобы медленный SMTP не задерживал ответ. Ошибки пишутся в лог.
+func (s *AccountService) send(mail *dto.Mail) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mailSendTimeout)
+		defer cancel()
+		if err := s.mailer.Send(ctx, mail); err != nil {
+			s.log.ErrorWithMsg("send mail", err)
+		}
+	}()
+}
+
+func (s *AccountService) link(path, token string) string {
+	return strings.TrimRight(s.cfg.BaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
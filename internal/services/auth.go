@@ -1,8 +1,12 @@
 package services
 
 import (
+	"context"
+	"strings"
+	"sync"
 	"time"
 
+	"GopherChessParty/ent/user"
 	"GopherChessParty/internal/dto"
 	"GopherChessParty/internal/errors"
 	"GopherChessParty/internal/interfaces"
@@ -11,71 +15,234 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	// revocationOverlap запас на расхождение часов узлов и долгие транзакции при загрузке отзывов
+	revocationOverlap = 5 * time.Second
+	maxUserAgentLen   = 512
+	maxIPLen          = 64
+)
+
+// Типы токенов. С ключами из каталога оба токена подписываются одним ключом и различаются claim typ.
+const (
+	tokenAccess    = "access"
+	tokenRefresh   = "refresh"
+	tokenChallenge = "2fa"
+	tokenOIDCFlow  = "oidc"
+)
+
+const (
+	// challengeTTL время на ввод кода 2FA после проверки пароля
+	challengeTTL = 5 * time.Minute
+	// oidcFlowTTL время на вход на странице провайдера OpenID Connect
+	oidcFlowTTL = 10 * time.Minute
+)
+
+// AuthService выдаёт и проверяет токены. С keys токены подписываются асимметричным ключом из каталога,
+// без них — общими секретами HS256 из конфига.
 type AuthService struct {
-	log interfaces.ILogger
+	log      interfaces.ILogger
+	sessions interfaces.ISessionRepo
+	users    interfaces.IUserRepo
+	keys     interfaces.IKeyring
 	dto.AuthConfig
+	// Недавно отозванные сессии и время отзыва. Запись нужна, пока не истекут access-токены сессии.
+	revoked   map[uuid.UUID]time.Time
+	syncedAt  time.Time
+	revokedMu sync.RWMutex
 }
 
-func NewAuthService(log interfaces.ILogger, cfg dto.AuthConfig) interfaces.IAuthService {
+func NewAuthService(
+	log interfaces.ILogger,
+	cfg dto.AuthConfig,
+	sessions interfaces.ISessionRepo,
+	users interfaces.IUserRepo,
+	keys interfaces.IKeyring,
+) interfaces.IAuthService {
 	return &AuthService{
-		log,
-		cfg,
+		log:        log,
+		sessions:   sessions,
+		users:      users,
+		keys:       keys,
+		AuthConfig: cfg,
+		revoked:    make(map[uuid.UUID]time.Time),
 	}
 }
 
-func (s *AuthService) GetUserIDFromRefresh(refreshToken string) (uuid.UUID, error) {
+// refreshClaims возвращает пользователя, сессию и идентификатор refresh-токена
+func (s *AuthService) refreshClaims(refreshToken string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
 	token, err := s.ValidateRefresh(refreshToken)
 	if err != nil {
-		return uuid.Nil, err
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
 	}
 	if !token.Valid {
-		return uuid.Nil, errors.ErrValidateToken
+		return uuid.Nil, uuid.Nil, uuid.Nil, errors.ErrValidateToken
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return uuid.Nil, errors.ErrValidateToken
+		return uuid.Nil, uuid.Nil, uuid.Nil, errors.ErrValidateToken
 	}
+	userID, err := claimUUID(claims, "id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	sessionID, err := claimUUID(claims, "sid")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	jti, err := claimUUID(claims, "jti")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	return userID, sessionID, jti, nil
+}
 
-	idRaw, ok := claims["id"].(string)
+func claimUUID(claims jwt.MapClaims, name string) (uuid.UUID, error) {
+	raw, ok := claims[name].(string)
 	if !ok {
 		return uuid.Nil, errors.ErrValidateToken
 	}
-	userID, err := uuid.Parse(idRaw)
+	return uuid.Parse(raw)
+}
+
+func (s *AuthService) generateAccess(userId, sessionID uuid.UUID, role user.Role) (string, error) {
+	return s.sign(jwt.MapClaims{
+		"id":   userId,
+		"sid":  sessionID,
+		"role": role,
+		"typ":  tokenAccess,
+		"exp":  time.Now().Add(s.ExpAccess).Unix(),
+	}, s.SecretAccess)
+}
+
+func (s *AuthService) generateRefresh(userId, sessionID, jti uuid.UUID) (string, error) {
+	return s.sign(jwt.MapClaims{
+		"id":  userId,
+		"sid": sessionID,
+		"jti": jti,
+		"typ": tokenRefresh,
+		"exp": time.Now().Add(s.ExpRefresh).Unix(),
+	}, s.SecretRefresh)
+}
+
+// GenerateChallenge выдаёт токен второго шага входа для пользователя, прошедшего проверку пароля
+func (s *AuthService) GenerateChallenge(userId uuid.UUID) (string, error) {
+	return s.sign(jwt.MapClaims{
+		"id":  userId,
+		"jti": uuid.New(),
+		"typ": tokenChallenge,
+		"exp": time.Now().Add(challengeTTL).Unix(),
+	}, s.SecretAccess)
+}
+
+// ValidateChallenge возвращает пользователя и идентификатор токена второго шага входа
+func (s *AuthService) ValidateChallenge(challenge string) (uuid.UUID, uuid.UUID, error) {
+	token, err := s.parse(challenge, tokenChallenge, s.SecretAccess)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	userID, err := claimUUID(claims, "id")
 	if err != nil {
-		return uuid.Nil, err
+		return uuid.Nil, uuid.Nil, err
 	}
+	challengeID, err := claimUUID(claims, "jti")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return userID, challengeID, nil
+}
 
-	return userID, nil
+// GenerateOIDCFlow упаковывает незавершённый вход через провайдера в подписанный flow-токен
+func (s *AuthService) GenerateOIDCFlow(flow *dto.OIDCFlow) (string, error) {
+	return s.sign(jwt.MapClaims{
+		"provider": flow.Provider,
+		"state":    flow.State,
+		"nonce":    flow.Nonce,
+		"verifier": flow.Verifier,
+		"typ":      tokenOIDCFlow,
+		"exp":      time.Now().Add(oidcFlowTTL).Unix(),
+	}, s.SecretAccess)
 }
 
-func (s *AuthService) generateAccess(userId uuid.UUID) (string, error) {
-	// Создаем новый токен с методом подписи HS256
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"id":  userId,
-		"exp": time.Now().Add(s.ExpAccess).Unix(),
-	})
+// ValidateOIDCFlow возвращает вход через провайдера из flow-токена
+func (s *AuthService) ValidateOIDCFlow(flowToken string) (*dto.OIDCFlow, error) {
+	token, err := s.parse(flowToken, tokenOIDCFlow, s.SecretAccess)
+	if err != nil {
+		return nil, errors.ErrOIDCState
+	}
+	claims := token.Claims.(jwt.MapClaims)
+	flow := &dto.OIDCFlow{}
+	flow.Provider, _ = claims["provider"].(string)
+	flow.State, _ = claims["state"].(string)
+	flow.Nonce, _ = claims["nonce"].(string)
+	flow.Verifier, _ = claims["verifier"].(string)
+	if flow.Provider == "" || flow.State == "" || flow.Nonce == "" || flow.Verifier == "" {
+		return nil, errors.ErrOIDCState
+	}
+	return flow, nil
+}
 
-	// Подписываем токен секретным ключом
-	return token.SignedString([]byte(s.SecretAccess))
+// sign подписывает токен текущим ключом из каталога с его kid в заголовке, без ключей — секретом HS256
+func (s *AuthService) sign(claims jwt.MapClaims, secret string) (string, error) {
+	if s.keys == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	}
+	kid, method, key, err := s.keys.SigningKey()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
 }
 
-func (s *AuthService) generateRefresh(userId uuid.UUID) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"id":  userId,
-		"exp": time.Now().Add(s.ExpRefresh).Unix(),
+// parse проверяет подпись токена ключом из его kid, без ключей — секретом HS256, и тип токена
+func (s *AuthService) parse(raw, typ, secret string) (*jwt.Token, error) {
+	token, err := jwt.Parse(raw, func(token *jwt.Token) (interface{}, error) {
+		if s.keys == nil {
+			// Проверяем, что метод подписи соответствует ожиданиям
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				s.log.Error(errors.ErrValidateToken)
+				return nil, errors.ErrValidateToken
+			}
+			return []byte(secret), nil
+		}
+		kid, _ := token.Header["kid"].(string)
+		method, public, ok := s.keys.VerificationKey(kid)
+		if !ok {
+			return nil, errors.ErrUnknownKey
+		}
+		if token.Method.Alg() != method.Alg() {
+			s.log.Error(errors.ErrValidateToken)
+			return nil, errors.ErrValidateToken
+		}
+		return public, nil
 	})
-
-	// Подписываем токен секретным ключом
-	return token.SignedString([]byte(s.SecretRefresh))
+	if err != nil {
+		return nil, err
+	}
+	if claims, ok := token.Claims.(jwt.MapClaims); !ok || claims["typ"] != typ {
+		return nil, errors.ErrValidateToken
+	}
+	return token, nil
 }
 
-func (s *AuthService) GenerateTokenPair(userId uuid.UUID) (*dto.TokenPair, error) {
-	accessToken, err := s.generateAccess(userId)
+// tokenPair выдаёт пару токенов сессии. Роль читается из базы, поэтому новая роль попадает
+// в токен при следующем обновлении.
+func (s *AuthService) tokenPair(
+	ctx context.Context,
+	userId, sessionID, jti uuid.UUID,
+) (*dto.TokenPair, error) {
+	account, err := s.users.UserByID(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := s.generateAccess(userId, sessionID, account.Role)
 	if err != nil {
 		return nil, err
 	}
-	refreshToken, err := s.generateRefresh(userId)
+	refreshToken, err := s.generateRefresh(userId, sessionID, jti)
 	if err != nil {
 		return nil, err
 	}
@@ -83,36 +250,219 @@ func (s *AuthService) GenerateTokenPair(userId uuid.UUID) (*dto.TokenPair, error
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 	}, nil
+}
+
+// GenerateTokenPair открывает новую сессию входа и выдаёт её первую пару токенов
+func (s *AuthService) GenerateTokenPair(
+	ctx context.Context,
+	userId uuid.UUID,
+	device dto.Device,
+) (*dto.TokenPair, error) {
+	now := time.Now()
+	device = trimDevice(device)
+	session := &dto.Session{
+		ID:         uuid.New(),
+		UserID:     userId,
+		RefreshJTI: uuid.New(),
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(s.ExpRefresh),
+		UserAgent:  device.UserAgent,
+		IP:         device.IP,
+	}
+	if err := s.sessions.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+	return s.tokenPair(ctx, userId, session.ID, session.RefreshJTI)
+}
+
+// RefreshAccessToken ротирует refresh-токен сессии. Повторное предъявление уже ротированного токена
+// действующей сессии означает, что он украден, поэтому сессия отзывается целиком. Для истёкшей
+// или завершённой сессии возвращается ErrSessionEnded.
+func (s *AuthService) RefreshAccessToken(
+	ctx context.Context,
+	refreshToken string,
+	device dto.Device,
+) (*dto.TokenPair, error) {
+	userID, sessionID, jti, err := s.refreshClaims(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	newJTI := uuid.New()
+	rotated, err := s.sessions.RotateSession(
+		ctx,
+		sessionID,
+		jti,
+		newJTI,
+		time.Now().Add(s.ExpRefresh),
+		trimDevice(device),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		live, err := s.liveSession(ctx, userID, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		if !live {
+			return nil, errors.ErrSessionEnded
+		}
+		s.log.Info("refresh token reuse detected", "user", userID, "session", sessionID)
+		if err := s.sessions.RevokeSession(ctx, sessionID); err != nil {
+			return nil, err
+		}
+		s.markRevoked(sessionID)
+		return nil, errors.ErrRefreshReused
+	}
+	return s.tokenPair(ctx, userID, sessionID, newJTI)
+}
+
+// liveSession проверяет, что сессия пользователя не отозвана и не истекла
+func (s *AuthService) liveSession(ctx context.Context, userID, sessionID uuid.UUID) (bool, error) {
+	sessions, err := s.sessions.Sessions(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, session := range sessions {
+		if session.ID == sessionID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Logout отзывает сессию входа
+func (s *AuthService) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.sessions.RevokeSession(ctx, sessionID); err != nil {
+		return err
+	}
+	s.markRevoked(sessionID)
+	return nil
+}
 
+// LogoutAll отзывает все сессии пользователя
+func (s *AuthService) LogoutAll(ctx context.Context, userID uuid.UUID) error {
+	sessions, err := s.sessions.Sessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.sessions.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		s.markRevoked(session.ID)
+	}
+	return nil
 }
 
-func (s *AuthService) RefreshAccessToken(refreshToken string) (*dto.TokenPair, error) {
-	userID, err := s.GetUserIDFromRefresh(refreshToken)
+// Sessions возвращает действующие сессии пользователя и отмечает среди них текущую
+func (s *AuthService) Sessions(
+	ctx context.Context,
+	userID, currentID uuid.UUID,
+) ([]*dto.Session, error) {
+	sessions, err := s.sessions.Sessions(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
-	return s.GenerateTokenPair(userID)
+	for _, session := range sessions {
+		session.Current = session.ID == currentID
+	}
+	return sessions, nil
 }
 
-func (s *AuthService) ValidateAccess(access string) (*jwt.Token, error) {
-	return jwt.Parse(access, func(token *jwt.Token) (interface{}, error) {
-		// Проверяем, что метод подписи соответствует ожиданиям
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			s.log.Error(errors.ErrValidateToken)
-			return nil, errors.ErrValidateToken
+// RevokeSession отзывает одну из сессий пользователя
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	if err := s.sessions.RevokeUserSession(ctx, userID, sessionID); err != nil {
+		return err
+	}
+	s.markRevoked(sessionID)
+	return nil
+}
+
+// IsSessionRevoked сообщает, отозвана ли сессия. Отзывы на других узлах видны
+// после очередного SyncRevocations.
+func (s *AuthService) IsSessionRevoked(sessionID uuid.UUID) bool {
+	s.revokedMu.RLock()
+	defer s.revokedMu.RUnlock()
+	_, ok := s.revoked[sessionID]
+	return ok
+}
+
+// SyncRevocations загружает сессии, отозванные с прошлой синхронизации, и забывает те,
+// чьи access-токены уже истекли
+func (s *AuthService) SyncRevocations(ctx context.Context) error {
+	now := time.Now()
+	s.revokedMu.RLock()
+	since := s.syncedAt.Add(-revocationOverlap)
+	if s.syncedAt.IsZero() {
+		since = now.Add(-s.ExpAccess - revocationOverlap)
+	}
+	s.revokedMu.RUnlock()
+
+	sessions, err := s.sessions.RevokedSessions(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	s.revokedMu.Lock()
+	defer s.revokedMu.Unlock()
+	for _, session := range sessions {
+		s.revoked[session.ID] = *session.RevokedAt
+	}
+	for sessionID, revokedAt := range s.revoked {
+		if now.Sub(revokedAt) > s.ExpAccess+revocationOverlap {
+			delete(s.revoked, sessionID)
 		}
-		return []byte(s.SecretAccess), nil
-	})
+	}
+	s.syncedAt = now
+	return nil
+}
+
+// markRevoked сразу запоминает сессию, отозванную на этом узле
+func (s *AuthService) markRevoked(sessionID uuid.UUID) {
+	s.revokedMu.Lock()
+	defer s.revokedMu.Unlock()
+	s.revoked[sessionID] = time.Now()
+}
+
+// trimDevice обрезает данные устройства до размеров колонок
+func trimDevice(device dto.Device) dto.Device {
+	return dto.Device{
+		UserAgent: truncate(device.UserAgent, maxUserAgentLen),
+		IP:        truncate(device.IP, maxIPLen),
+	}
 }
+
+func truncate(value string, size int) string {
+	if len(value) <= size {
+		return value
+	}
+	return strings.ToValidUTF8(value[:size], "")
+}
+
+func (s *AuthService) ValidateAccess(access string) (*jwt.Token, error) {
+	return s.parse(access, tokenAccess, s.SecretAccess)
+}
+
 func (s *AuthService) ValidateRefresh(refresh string) (*jwt.Token, error) {
-	return jwt.Parse(refresh, func(token *jwt.Token) (interface{}, error) {
-		// Проверяем, что метод подписи соответствует ожиданиям
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			s.log.Error(errors.ErrValidateToken)
-			return nil, errors.ErrValidateToken
-		}
-		return []byte(s.SecretRefresh), nil
-	})
+	return s.parse(refresh, tokenRefresh, s.SecretRefresh)
+}
+
+// JWKS возвращает открытые ключи проверки токенов. С секретами HS256 набор пуст.
+func (s *AuthService) JWKS() *dto.JWKS {
+	if s.keys == nil {
+		return &dto.JWKS{Keys: []dto.JWK{}}
+	}
+	return s.keys.JWKS()
+}
+
+// ReloadKeys перечитывает каталог ключей подписи
+func (s *AuthService) ReloadKeys() error {
+	if s.keys == nil {
+		return nil
+	}
+	return s.keys.Reload()
 }
 
 func (s *AuthService) IsValidPassword(hashedPassword string, plainPassword string) bool {
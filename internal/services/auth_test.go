@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	exc "errors"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+func newAuthService(t *testing.T) (*AuthService, uuid.UUID) {
+	t.Helper()
+	users := repository.NewMemoryUserRepository()
+	user, err := users.CreateUser(context.Background(), &dto.CreateUser{
+		Name:     "gopher",
+		Email:    "gopher@example.com",
+		Password: "hash",
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	auth := NewAuthService(
+		logger.New(dto.Application{Env: "prod"}),
+		dto.AuthConfig{
+			SecretAccess:  "access",
+			SecretRefresh: "refresh",
+			ExpAccess:     time.Hour,
+			ExpRefresh:    24 * time.Hour,
+		},
+		repository.NewMemorySessionRepository(users),
+		users,
+		nil,
+	).(*AuthService)
+	return auth, user.ID
+}
+
+// sessionID сессия, на которую выдан access-токен
+func sessionID(t *testing.T, auth *AuthService, access string) uuid.UUID {
+	t.Helper()
+	token, err := auth.ValidateAccess(access)
+	if err != nil {
+		t.Fatalf("ValidateAccess: %v", err)
+	}
+	id, err := uuid.Parse(token.Claims.(jwt.MapClaims)["sid"].(string))
+	if err != nil {
+		t.Fatalf("sid: %v", err)
+	}
+	return id
+}
+
+func TestRefreshRotation(t *testing.T) {
+	ctx := context.Background()
+	auth, userID := newAuthService(t)
+	first, err := auth.GenerateTokenPair(ctx, userID, dto.Device{UserAgent: "firefox"})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair: %v", err)
+	}
+	second, err := auth.RefreshAccessToken(ctx, first.RefreshToken, dto.Device{})
+	if err != nil {
+		t.Fatalf("RefreshAccessToken: %v", err)
+	}
+	third, err := auth.RefreshAccessToken(ctx, second.RefreshToken, dto.Device{})
+	if err != nil {
+		t.Fatalf("RefreshAccessToken of the rotated token: %v", err)
+	}
+	if sessionID(t, auth, third.AccessToken) != sessionID(t, auth, first.AccessToken) {
+		t.Fatal("rotation opened a new session")
+	}
+}
+
+func TestRefreshReuseRevokesSession(t *testing.T) {
+	ctx := context.Background()
+	auth, userID := newAuthService(t)
+	first, err := auth.GenerateTokenPair(ctx, userID, dto.Device{})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair: %v", err)
+	}
+	other, err := auth.GenerateTokenPair(ctx, userID, dto.Device{})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair: %v", err)
+	}
+	second, err := auth.RefreshAccessToken(ctx, first.RefreshToken, dto.Device{})
+	if err != nil {
+		t.Fatalf("RefreshAccessToken: %v", err)
+	}
+
+	_, err = auth.RefreshAccessToken(ctx, first.RefreshToken, dto.Device{})
+	if !exc.Is(err, errors.ErrRefreshReused) {
+		t.Fatalf("reused refresh error = %v, want %v", err, errors.ErrRefreshReused)
+	}
+	// Вместе с украденным токеном отзывается и последний выданный в той же сессии
+	if _, err := auth.RefreshAccessToken(ctx, second.RefreshToken, dto.Device{}); err == nil {
+		t.Fatal("latest refresh token of a revoked session was accepted")
+	}
+	if !auth.IsSessionRevoked(sessionID(t, auth, second.AccessToken)) {
+		t.Fatal("access tokens of a revoked session are still accepted")
+	}
+	// Другие сессии пользователя продолжают работать
+	if _, err := auth.RefreshAccessToken(ctx, other.RefreshToken, dto.Device{}); err != nil {
+		t.Fatalf("RefreshAccessToken of another session: %v", err)
+	}
+}
+
+func TestRefreshEndedSession(t *testing.T) {
+	ctx := context.Background()
+	auth, userID := newAuthService(t)
+	pair, err := auth.GenerateTokenPair(ctx, userID, dto.Device{})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair: %v", err)
+	}
+	if err := auth.Logout(ctx, sessionID(t, auth, pair.AccessToken)); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	// Токен завершённой сессии не считается украденным
+	_, err = auth.RefreshAccessToken(ctx, pair.RefreshToken, dto.Device{})
+	if !exc.Is(err, errors.ErrSessionEnded) {
+		t.Fatalf("refresh after logout error = %v, want %v", err, errors.ErrSessionEnded)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	ctx := context.Background()
+	auth, userID := newAuthService(t)
+	current, err := auth.GenerateTokenPair(ctx, userID, dto.Device{UserAgent: "firefox"})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair: %v", err)
+	}
+	other, err := auth.GenerateTokenPair(ctx, userID, dto.Device{UserAgent: "phone"})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair: %v", err)
+	}
+	currentID, otherID := sessionID(
+		t,
+		auth,
+		current.AccessToken,
+	), sessionID(
+		t,
+		auth,
+		other.AccessToken,
+	)
+
+	sessions, err := auth.Sessions(ctx, userID, currentID)
+	if err != nil {
+		t.Fatalf("Sessions: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("sessions = %d, want 2", len(sessions))
+	}
+	for _, session := range sessions {
+		if session.Current != (session.ID == currentID) {
+			t.Fatalf("session %s current = %v", session.ID, session.Current)
+		}
+	}
+
+	if err := auth.RevokeSession(ctx, uuid.New(), otherID); err == nil {
+		t.Fatal("session was revoked by another user")
+	}
+	if err := auth.RevokeSession(ctx, userID, otherID); err != nil {
+		t.Fatalf("RevokeSession: %v", err)
+	}
+	if !auth.IsSessionRevoked(otherID) || auth.IsSessionRevoked(currentID) {
+		t.Fatal("revocation did not reach exactly the revoked session")
+	}
+	if _, err := auth.RefreshAccessToken(ctx, other.RefreshToken, dto.Device{}); err == nil {
+		t.Fatal("refresh token of a revoked session was accepted")
+	}
+
+	if err := auth.LogoutAll(ctx, userID); err != nil {
+		t.Fatalf("LogoutAll: %v", err)
+	}
+	if sessions, _ := auth.Sessions(ctx, userID, currentID); len(sessions) != 0 {
+		t.Fatalf("sessions after LogoutAll = %d, want 0", len(sessions))
+	}
+	if !auth.IsSessionRevoked(currentID) {
+		t.Fatal("access tokens survived LogoutAll")
+	}
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+const (
+	// gameChallengeTTL время, за которое на вызов нужно ответить
+	gameChallengeTTL = 10 * time.Minute
+	// maxPendingChallenges сколько вызовов игрок может ждать одновременно, чтобы им нельзя было
+	// засыпать других игроков
+	maxPendingChallenges = 10
+)
+
+// ChallengeService вызовы на партию конкретному игроку. Партию по принятому вызову создаёт Service.
+type ChallengeService struct {
+	log        interfaces.ILogger
+	repository interfaces.IChallengeRepo
+}
+
+func NewChallengeService(
+	log interfaces.ILogger,
+	repository interfaces.IChallengeRepo,
+) interfaces.IChallengeService {
+	return &ChallengeService{log: log, repository: repository}
+}
+
+func (s *ChallengeService) CreateChallenge(
+	ctx context.Context,
+	challengerID uuid.UUID,
+	data *dto.CreateChallenge,
+) (*dto.Challenge, error) {
+	if data.DestUserID == challengerID {
+		return nil, errors.ErrChallengeSelf
+	}
+	pending, err := s.PendingChallenges(ctx, challengerID)
+	if err != nil {
+		return nil, err
+	}
+	outgoing := 0
+	for _, item := range pending {
+		if item.ChallengerID == challengerID {
+			outgoing++
+		}
+	}
+	if outgoing >= maxPendingChallenges {
+		return nil, errors.ErrTooManyChallenges
+	}
+	item := &dto.Challenge{
+		ID:           uuid.New(),
+		ChallengerID: challengerID,
+		DestUserID:   data.DestUserID,
+		Color:        data.Color,
+		Status:       challenge.StatusCreated,
+		CreatedAt:    time.Now(),
+	}
+	if item.Color == "" {
+		item.Color = challenge.DefaultColor
+	}
+	if err := s.repository.CreateChallenge(ctx, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// PendingChallenges возвращает вызовы пользователя, на которые ещё можно ответить
+func (s *ChallengeService) PendingChallenges(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*dto.Challenge, error) {
+	return s.repository.PendingChallenges(ctx, userID, time.Now().Add(-gameChallengeTTL))
+}
+
+func (s *ChallengeService) Challenge(
+	ctx context.Context,
+	challengeID uuid.UUID,
+) (*dto.Challenge, error) {
+	return s.repository.Challenge(ctx, challengeID)
+}
+
+// AnswerChallenge принимает, отклоняет или отменяет ожидающий вызов. Принять и отклонить вызов
+// может только вызванный, отменить — только вызвавший. Чужой и просроченный вызов не находится.
+func (s *ChallengeService) AnswerChallenge(
+	ctx context.Context,
+	userID, challengeID uuid.UUID,
+	status challenge.Status,
+) (*dto.Challenge, error) {
+	item, err := s.repository.Challenge(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	answerer := item.DestUserID
+	if status == challenge.StatusCanceled {
+		answerer = item.ChallengerID
+	}
+	if answerer != userID || time.Since(item.CreatedAt) > gameChallengeTTL {
+		return nil, errors.ErrChallengeNotFound
+	}
+	if err := s.repository.FinishChallenge(ctx, challengeID, status); err != nil {
+		return nil, err
+	}
+	item.Status = status
+	return item, nil
+}
+
+func (s *ChallengeService) SetChallengeGame(
+	ctx context.Context,
+	challengeID, gameID uuid.UUID,
+) error {
+	return s.repository.SetChallengeGame(ctx, challengeID, gameID)
+}
+
+// challengePlayers возвращает белого и чёрного игрока партии по вызову
+func challengePlayers(item *dto.Challenge) (white, black uuid.UUID) {
+	color := item.Color
+	if color == challenge.ColorRandom {
+		color = challenge.ColorWhite
+		if n, err := rand.Int(rand.Reader, big.NewInt(2)); err == nil && n.Int64() == 1 {
+			color = challenge.ColorBlack
+		}
+	}
+	if color == challenge.ColorWhite {
+		return item.ChallengerID, item.DestUserID
+	}
+	return item.DestUserID, item.ChallengerID
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// clusterChannel канал шины, в котором узлы обмениваются событиями партий
+const clusterChannel = "chess_events"
+
+// ClusterService координирует узлы: владение партиями через аренды и обмен событиями через шину.
+// Без репозитория аренд узел считается единственным и владеет всеми партиями.
+type ClusterService struct {
+	log    interfaces.ILogger
+	bus    interfaces.IEventBus
+	leases interfaces.ILeaseRepo
+	nodeID string
+	ttl    time.Duration
+	owned  map[uuid.UUID]time.Time // Срок действия аренд, принадлежащих узлу
+	mu     sync.Mutex
+}
+
+func NewClusterService(
+	log interfaces.ILogger,
+	bus interfaces.IEventBus,
+	leases interfaces.ILeaseRepo,
+	cfg dto.Cluster,
+) interfaces.IClusterService {
+	nodeID := cfg.NodeID
+	if nodeID == "" {
+		nodeID = generateNodeID()
+	}
+	return &ClusterService{
+		log:    log,
+		bus:    bus,
+		leases: leases,
+		nodeID: nodeID,
+		ttl:    cfg.LeaseTTL,
+		owned:  make(map[uuid.UUID]time.Time),
+	}
+}
+
+// generateNodeID идентификатор узла из имени хоста и случайного суффикса
+func generateNodeID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "node"
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return host + "-" + hex.EncodeToString(suffix)
+}
+
+func (c *ClusterService) NodeID() string {
+	return c.nodeID
+}
+
+// AcquireGame захватывает аренду партии или продлевает её, когда прошла половина срока.
+// Возвращает false, если партией владеет другой узел.
+func (c *ClusterService) AcquireGame(ctx context.Context, GameID uuid.UUID) bool {
+	if c.leases == nil {
+		return true
+	}
+	now := time.Now()
+	c.mu.Lock()
+	expiresAt, ok := c.owned[GameID]
+	c.mu.Unlock()
+	if ok && expiresAt.Sub(now) > c.ttl/2 {
+		return true
+	}
+
+	expiresAt = now.Add(c.ttl)
+	acquired, err := c.leases.Acquire(ctx, GameID, c.nodeID, expiresAt)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil || !acquired {
+		delete(c.owned, GameID)
+		return false
+	}
+	c.owned[GameID] = expiresAt
+	return true
+}
+
+// OwnsGame проверяет, что узел владеет партией, не обращаясь к базе
+func (c *ClusterService) OwnsGame(GameID uuid.UUID) bool {
+	if c.leases == nil {
+		return true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt, ok := c.owned[GameID]
+	return ok && time.Now().Before(expiresAt)
+}
+
+func (c *ClusterService) ReleaseGame(ctx context.Context, GameID uuid.UUID) {
+	if c.leases == nil {
+		return
+	}
+	c.mu.Lock()
+	delete(c.owned, GameID)
+	c.mu.Unlock()
+	_ = c.leases.Release(ctx, GameID, c.nodeID)
+}
+
+func (c *ClusterService) PublishEvent(event *dto.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		c.log.Error(err)
+		return err
+	}
+	return c.bus.Publish(clusterChannel, payload)
+}
+
+func (c *ClusterService) SubscribeEvents(handler func(event *dto.Event)) error {
+	return c.bus.Subscribe(clusterChannel, func(payload []byte) {
+		var event dto.Event
+		if err := json.Unmarshal(payload, &event); err != nil {
+			c.log.Error(err)
+			return
+		}
+		handler(&event)
+	})
+}
@@ -1,6 +1,11 @@
 package services
 
 import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
 	"GopherChessParty/ent"
 	"GopherChessParty/ent/chess"
 	"GopherChessParty/internal/dto"
@@ -8,29 +13,90 @@ import (
 	"GopherChessParty/internal/interfaces"
 	chesslib "github.com/corentings/chess/v2"
 	"github.com/google/uuid"
-	"time"
 )
 
 // GameService логика для работы с игрой
 type GameService struct {
 	log        interfaces.ILogger
 	repository interfaces.IGameRepo
+	cfg        dto.GameConfig
 	games      map[uuid.UUID]*dto.Game
+	gamesMu    sync.RWMutex // Мьютекс для доступа к партиям в памяти
+	premoveMu  sync.Mutex   // Мьютекс для очередей предходов
+	evicted    uint64       // Число выгруженных из памяти партий
+	locks      map[uuid.UUID]*gameLock
+	locksMu    sync.Mutex // Мьютекс для блокировок партий
+}
+
+// gameLock блокировка партии. Живёт, пока её кто-то держит или ждёт.
+type gameLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// drawMethods способы ничьей, которые игрок может потребовать
+var drawMethods = map[string]chesslib.Method{
+	"threefold_repetition": chesslib.ThreefoldRepetition,
+	"fifty_move_rule":      chesslib.FiftyMoveRule,
+}
+
+// terminationByMethod причины завершения партии по способу, определённому шахматной библиотекой
+var terminationByMethod = map[chesslib.Method]chess.Termination{
+	chesslib.Checkmate:            chess.TerminationCheckmate,
+	chesslib.Resignation:          chess.TerminationResignation,
+	chesslib.DrawOffer:            chess.TerminationAgreement,
+	chesslib.Stalemate:            chess.TerminationStalemate,
+	chesslib.ThreefoldRepetition:  chess.TerminationRepetition,
+	chesslib.FivefoldRepetition:   chess.TerminationRepetition,
+	chesslib.FiftyMoveRule:        chess.TerminationFiftyMove,
+	chesslib.SeventyFiveMoveRule:  chess.TerminationFiftyMove,
+	chesslib.InsufficientMaterial: chess.TerminationInsufficientMaterial,
 }
 
 func NewGameService(
 	log interfaces.ILogger,
 	repository interfaces.IGameRepo,
+	cfg dto.GameConfig,
 ) interfaces.IGameService {
 	return &GameService{
 		log:        log,
 		repository: repository,
+		cfg:        cfg,
 		games:      make(map[uuid.UUID]*dto.Game),
+		locks:      make(map[uuid.UUID]*gameLock),
 	}
 }
 
-func (m *GameService) CreateGame(playerID1, playerID2 uuid.UUID) (*ent.Chess, error) {
-	game, err := m.repository.Create(playerID1, playerID2)
+// lockGame блокирует партию и возвращает функцию снятия блокировки. Всё, что меняет партию,
+// проверяет, записывает и применяет изменение под ней: ходы и действия из сокета, REST бота,
+// шины и часов узла идут параллельно.
+func (m *GameService) lockGame(GameID uuid.UUID) func() {
+	m.locksMu.Lock()
+	lock, ok := m.locks[GameID]
+	if !ok {
+		lock = &gameLock{}
+		m.locks[GameID] = lock
+	}
+	lock.refs++
+	m.locksMu.Unlock()
+
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+		m.locksMu.Lock()
+		defer m.locksMu.Unlock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(m.locks, GameID)
+		}
+	}
+}
+
+func (m *GameService) CreateGame(
+	ctx context.Context,
+	playerID1, playerID2 uuid.UUID,
+) (*ent.Chess, error) {
+	game, err := m.repository.Create(ctx, playerID1, playerID2)
 	if err != nil {
 		return nil, err
 	}
@@ -38,15 +104,21 @@ func (m *GameService) CreateGame(playerID1, playerID2 uuid.UUID) (*ent.Chess, er
 	return game, nil
 }
 
-func (m *GameService) GamesByUserID(userID uuid.UUID) ([]*dto.GameHistory, error) {
-	return m.repository.Games(userID)
+func (m *GameService) GamesByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*dto.GameHistory, error) {
+	return m.repository.Games(ctx, userID)
 }
 
-func (m *GameService) GameByID(gameID uuid.UUID) (*dto.Match, error) {
-	return m.repository.GameById(gameID)
+func (m *GameService) GameByID(ctx context.Context, gameID uuid.UUID) (*dto.Match, error) {
+	return m.repository.GameById(ctx, gameID)
 }
 
 func (m *GameService) startGame(GameID uuid.UUID, whiteUserID, blackUserID uuid.UUID) {
+	m.gamesMu.Lock()
+	defer m.gamesMu.Unlock()
+	m.evictOverflowLocked()
 	m.games[GameID] = &dto.Game{
 		Match:         chesslib.NewGame(),
 		CreatedAt:     time.Now(),
@@ -56,143 +128,780 @@ func (m *GameService) startGame(GameID uuid.UUID, whiteUserID, blackUserID uuid.
 		BlackPlayer:   &dto.PlayerConn{UserID: blackUserID},
 		HistoryMove:   make([]string, 0),
 		Status:        chess.StatusInProgress,
-		Result:        chess.Result00,
+		Result:        chess.ResultInProgress,
+		Clock:         dto.NewClock(m.cfg.TimeControl, m.cfg.Increment),
+		Premoves:      make(map[uuid.UUID][]string),
 	}
 }
 
-func (m *GameService) StatusGame(GameID uuid.UUID) chess.Status {
-	return m.repository.Status(GameID)
+func (m *GameService) StatusGame(ctx context.Context, GameID uuid.UUID) chess.Status {
+	return m.repository.Status(ctx, GameID)
 }
 
-func (m *GameService) Game(GameID uuid.UUID) (*dto.Game, error) {
-	return m.GameDB(GameID)
+func (m *GameService) Game(ctx context.Context, GameID uuid.UUID) (*dto.Game, error) {
+	return m.GameDB(ctx, GameID)
 }
 
+// UpdateStatus завершает партию с результатом parse и причиной termination.
+// Партия без результата считается прерванной. Состояние в памяти меняется только после записи в базу.
+// Вызывается под блокировкой партии.
 func (m *GameService) UpdateStatus(
+	ctx context.Context,
 	gameID uuid.UUID,
 	parse chesslib.Outcome,
+	termination chess.Termination,
 ) error {
-	status := chess.StatusFinished
-	var result chess.Result
+	game, err := m.Game(ctx, gameID)
+	if err != nil {
+		m.log.Error(err)
+		return err
+	}
+	outcome := gameOutcome(parse, termination)
+	err = m.repository.UpdateGame(ctx, gameID, outcome)
+	if err != nil {
+		return err
+	}
+	applyOutcome(game, outcome)
+	return nil
+}
+
+// gameOutcome переводит исход партии шахматной библиотеки в статус и результат для базы
+func gameOutcome(parse chesslib.Outcome, termination chess.Termination) *dto.GameOutcome {
+	outcome := &dto.GameOutcome{Status: chess.StatusFinished, Termination: termination}
 	switch parse {
 	case chesslib.BlackWon:
-		result = chess.Result01
+		outcome.Result = chess.ResultBlackWon
 	case chesslib.WhiteWon:
-		result = chess.Result10
+		outcome.Result = chess.ResultWhiteWon
 	case chesslib.Draw:
-		result = chess.Result11
+		outcome.Result = chess.ResultDraw
 	default:
-		status = chess.StatusAborted
-		result = chess.Result00
+		outcome.Status = chess.StatusAborted
+		outcome.Result = chess.ResultInProgress
 	}
-	game, err := m.Game(gameID)
+	return outcome
+}
+
+func applyOutcome(game *dto.Game, outcome *dto.GameOutcome) {
+	termination := outcome.Termination
+	game.Status = outcome.Status
+	game.Result = outcome.Result
+	game.Termination = &termination
+	game.FinishedAt = time.Now()
+}
+
+// NormalizeMove проверяет ход в нотации UCI, SAN или LAN и возвращает его в канонической форме UCI
+func (m *GameService) NormalizeMove(
+	ctx context.Context,
+	GameID uuid.UUID,
+	move string,
+	autoQueen bool,
+) (string, error) {
+	game, err := m.Game(ctx, GameID)
 	if err != nil {
-		m.log.Error(err)
+		return "", err
+	}
+	if game.Status != chess.StatusInProgress {
+		return "", errors.ErrGameNotActive
+	}
+	parsed, err := ParseMove(game.Match.Position(), move, autoQueen)
+	if err != nil {
+		return "", err
+	}
+	return CanonicalMove(parsed), nil
+}
+
+func (m *GameService) MoveGame(
+	ctx context.Context,
+	GameID uuid.UUID,
+	move string,
+	player *dto.PlayerConn,
+) error {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.Game(ctx, GameID)
+	if err != nil {
+		return err
+	}
+
+	if game.Status != chess.StatusInProgress {
+		return errors.ErrGameNotActive
+	}
+
+	currentMotionUser := game.GetCurrentUser()
+	if currentMotionUser.UserID != player.UserID {
+		m.log.Error(errors.ErrCurrentUserMotion)
+		return errors.ErrCurrentUserMotion
+	}
+	// Позиция могла измениться после NormalizeMove, поэтому ход проверяется ещё раз
+	if _, err := ParseMove(game.Match.Position(), move, false); err != nil {
 		return err
 	}
-	game.Status = status
-	game.Result = result
-	return m.repository.UpdateGame(gameID, status, result)
+
+	var elapsed time.Duration
+	if !game.Clock.LastMoveAt.IsZero() {
+		elapsed = time.Since(game.Clock.LastMoveAt)
+	}
+	return m.playMove(ctx, game, move, player, elapsed)
 }
 
-func (m *GameService) MoveValid(GameID uuid.UUID, move string) error {
-	if move == "" {
-		return errors.ErrInvalidMove
+// playMove применяет ход и списывает с часов игрока elapsed.
+// Часы начинают идти только после первых ходов обеих сторон. Вызывается под блокировкой партии.
+func (m *GameService) playMove(
+	ctx context.Context,
+	game *dto.Game,
+	move string,
+	player *dto.PlayerConn,
+	elapsed time.Duration,
+) error {
+	if game.NumMove < 2 {
+		elapsed = 0
+	}
+	clock := *game.Clock
+	if !clock.Charge(game.CurrentMotion, elapsed, time.Now()) {
+		err := m.flagFall(ctx, game, chess.TerminationTimeout)
+		if err != nil {
+			m.log.Error(err)
+			return err
+		}
+		*game.Clock = clock
+		return errors.ErrTimeOut
 	}
-	game, err := m.Game(GameID)
+
+	match, err := nextMatch(game, move)
 	if err != nil {
+		m.log.Error(err)
 		return err
 	}
-	wanted, err := chesslib.UCINotation{}.Decode(game.Match.Position(), move)
+	var outcome *dto.GameOutcome
+	if match.Outcome() != chesslib.NoOutcome {
+		outcome = gameOutcome(match.Outcome(), terminationByMethod[match.Method()])
+	}
+	// Ход и итог партии сохраняются в одной транзакции, память меняется только после неё
+	err = m.repository.SaveMove(ctx, game.ID, move, player.UserID, game.NumMove+1, outcome)
 	if err != nil {
-		m.log.Error(err)
 		return err
 	}
-	for _, move := range game.Match.ValidMoves() {
-		if wanted.S1() == move.S1() && wanted.S2() == move.S2() {
-			return nil
+
+	game.Match = match
+	*game.Clock = clock
+	game.SetMove(move)
+	// Ход соперника отклоняет предложение ничьей, свой ход его не снимает
+	if game.DrawOffer != player.UserID {
+		game.DrawOffer = uuid.Nil
+	}
+	game.Resumed = false
+	if outcome != nil {
+		applyOutcome(game, outcome)
+		return errors.ErrGameEnd
+	}
+	return nil
+}
+
+// nextMatch возвращает партию после хода, не изменяя текущую. Копия делит с партией дерево ходов,
+// поэтому ход становится главной линией, даже если у позиции остался неприменённый ход.
+func nextMatch(game *dto.Game, move string) (*chesslib.Game, error) {
+	match := game.Match.Clone()
+	err := match.PushNotationMove(
+		move,
+		chesslib.UCINotation{},
+		&chesslib.PushMoveOptions{ForceMainline: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// flagFall завершает партию поражением стороны, чей ход. Если у соперника не хватает материала
+// для мата, партия завершается вничью.
+func (m *GameService) flagFall(
+	ctx context.Context,
+	game *dto.Game,
+	termination chess.Termination,
+) error {
+	outcome, winner := chesslib.BlackWon, chesslib.Black
+	if game.CurrentMotion == dto.BlackMotion {
+		outcome, winner = chesslib.WhiteWon, chesslib.White
+	}
+	if !hasMatingMaterial(game.Match.Position().Board(), winner) {
+		outcome = chesslib.Draw
+	}
+	return m.UpdateStatus(ctx, game.ID, outcome, termination)
+}
+
+// hasMatingMaterial проверяет, может ли сторона color поставить мат хоть какой-то
+// последовательностью ходов (правило FIDE 6.9). Учитываются фигуры обеих сторон: одинокий конь
+// матует, только если соперник может запереть своего короля своими фигурами, а слоны на полях
+// одного цвета — только при коне или пешке соперника.
+func hasMatingMaterial(board *chesslib.Board, color chesslib.Color) bool {
+	knights, bishops := 0, 0
+	opponentBlocks, opponentKnightOrPawn := false, false
+	var bishopSquares [2]bool
+	for square, piece := range board.SquareMap() {
+		if piece.Type() == chesslib.Bishop {
+			bishopSquares[(int(square.File())+int(square.Rank()))%2] = true
+		}
+		if piece.Color() == color {
+			switch piece.Type() {
+			case chesslib.Pawn, chesslib.Rook, chesslib.Queen:
+				return true
+			case chesslib.Knight:
+				knights++
+			case chesslib.Bishop:
+				bishops++
+			}
+			continue
 		}
+		switch piece.Type() {
+		case chesslib.Pawn, chesslib.Knight:
+			opponentKnightOrPawn = true
+			opponentBlocks = true
+		case chesslib.Rook, chesslib.Bishop:
+			opponentBlocks = true
+		}
+	}
+	switch {
+	case knights > 0 && bishops > 0:
+		return true
+	case knights > 0:
+		return knights > 1 || opponentBlocks
+	case bishops > 0:
+		return bishopSquares[0] && bishopSquares[1] || opponentKnightOrPawn
+	}
+	return false
+}
+
+// FlagExpired завершает партии, в которых у стороны, чей ход, истекло время.
+// Возвращает идентификаторы завершённых партий.
+func (m *GameService) FlagExpired() []uuid.UUID {
+	m.gamesMu.RLock()
+	running := make([]*dto.Game, 0, len(m.games))
+	for _, game := range m.games {
+		if game.Status == chess.StatusInProgress && game.NumMove >= 2 {
+			running = append(running, game)
+		}
+	}
+	m.gamesMu.RUnlock()
+
+	ctx := context.Background()
+	flagged := make([]uuid.UUID, 0)
+	for _, game := range running {
+		if m.flagExpired(ctx, game) {
+			flagged = append(flagged, game.ID)
+		}
+	}
+	return flagged
+}
+
+// flagExpired завершает партию, если у стороны, чей ход, истекло время. Состояние проверяется
+// заново под блокировкой: пока партия ждала её, мог пройти ход или партия могла завершиться.
+func (m *GameService) flagExpired(ctx context.Context, game *dto.Game) bool {
+	unlock := m.lockGame(game.ID)
+	defer unlock()
+	if game.Status != chess.StatusInProgress ||
+		time.Since(game.Clock.LastMoveAt) < game.Clock.Remaining(game.CurrentMotion) {
+		return false
+	}
+	clock := *game.Clock
+	clock.Charge(game.CurrentMotion, clock.Remaining(game.CurrentMotion), time.Now())
+	if err := m.flagFall(ctx, game, chess.TerminationTimeout); err != nil {
+		m.log.Error(err)
+		return false
 	}
-	return errors.ErrInvalidMove
+	*game.Clock = clock
+	return true
 }
 
-func (m *GameService) MoveGame(GameID uuid.UUID, move string, player *dto.PlayerConn) error {
-	game, err := m.Game(GameID)
+// Abort прерывает партию по просьбе игрока. Прервать можно, пока обе стороны не сделали первый ход.
+func (m *GameService) Abort(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.Game(ctx, GameID)
 	if err != nil {
 		return err
 	}
+	if game.Status != chess.StatusInProgress {
+		return errors.ErrGameNotActive
+	}
+	if game.Player(player.UserID) == nil {
+		return errors.ErrPlayerNotFound
+	}
+	if game.NumMove >= 2 {
+		return errors.ErrAbortNotAllowed
+	}
+	return m.UpdateStatus(ctx, GameID, chesslib.NoOutcome, chess.TerminationAborted)
+}
 
-	currentMotionUser := game.GetCurrentUser()
-	if currentMotionUser.UserID != player.UserID {
-		m.log.Error(errors.ErrCurrentUserMotion)
-		return errors.ErrCurrentUserMotion
+// Resign завершает партию поражением сдавшегося игрока
+func (m *GameService) Resign(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.Game(ctx, GameID)
+	if err != nil {
+		return err
+	}
+	if game.Status != chess.StatusInProgress {
+		return errors.ErrGameNotActive
+	}
+	if game.Player(player.UserID) == nil {
+		return errors.ErrPlayerNotFound
+	}
+	color, outcome := chesslib.White, chesslib.BlackWon
+	if game.BlackPlayer.UserID == player.UserID {
+		color, outcome = chesslib.Black, chesslib.WhiteWon
+	}
+	err = m.UpdateStatus(ctx, GameID, outcome, chess.TerminationResignation)
+	if err != nil {
+		return err
 	}
+	game.Match.Resign(color)
+	return nil
+}
 
-	err = game.Match.PushNotationMove(
-		move,
-		chesslib.UCINotation{},
-		&chesslib.PushMoveOptions{},
-	)
+// OfferDraw предлагает сопернику ничью. Если соперник уже предложил ничью, партия завершается
+// вничью по соглашению. Возвращает true, если партия завершена.
+func (m *GameService) OfferDraw(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+) (bool, error) {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.Game(ctx, GameID)
+	if err != nil {
+		return false, err
+	}
+	if game.Status != chess.StatusInProgress {
+		return false, errors.ErrGameNotActive
+	}
+	if game.Player(player.UserID) == nil {
+		return false, errors.ErrPlayerNotFound
+	}
+	if game.DrawOffer != game.OpponentOf(player.UserID).UserID {
+		game.DrawOffer = player.UserID
+		return false, nil
+	}
+	err = m.UpdateStatus(ctx, GameID, chesslib.Draw, chess.TerminationAgreement)
 	if err != nil {
+		return false, err
+	}
+	game.DrawOffer = uuid.Nil
+	if err := game.Match.Draw(chesslib.DrawOffer); err != nil {
 		m.log.Error(err)
+	}
+	return true, nil
+}
+
+// DeclineDraw отклоняет предложение ничьей соперника
+func (m *GameService) DeclineDraw(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+) error {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.Game(ctx, GameID)
+	if err != nil {
 		return err
 	}
-	game.SetMove(move)
-	_, err = m.repository.SaveMove(GameID, move, player.UserID, game.NumMove)
+	if game.Status != chess.StatusInProgress {
+		return errors.ErrGameNotActive
+	}
+	if game.Player(player.UserID) == nil {
+		return errors.ErrPlayerNotFound
+	}
+	if game.DrawOffer != game.OpponentOf(player.UserID).UserID {
+		return errors.ErrNoDrawOffer
+	}
+	game.DrawOffer = uuid.Nil
+	return nil
+}
+
+// AbortUnstarted прерывает партии, в которых сторона не сделала первый ход за отведённое время.
+// Виноватым считается игрок, чей сейчас ход. Возвращает идентификаторы прерванных партий.
+func (m *GameService) AbortUnstarted() []uuid.UUID {
+	m.gamesMu.RLock()
+	unstarted := make([]*dto.Game, 0)
+	for _, game := range m.games {
+		if game.Status == chess.StatusInProgress && game.NumMove < 2 {
+			unstarted = append(unstarted, game)
+		}
+	}
+	m.gamesMu.RUnlock()
+
+	ctx := context.Background()
+	aborted := make([]uuid.UUID, 0)
+	for _, game := range unstarted {
+		if m.abortUnstarted(ctx, game) {
+			aborted = append(aborted, game.ID)
+		}
+	}
+	return aborted
+}
+
+// abortUnstarted прерывает партию, если сторона не сделала первый ход за отведённое время.
+// Состояние проверяется заново под блокировкой партии.
+func (m *GameService) abortUnstarted(ctx context.Context, game *dto.Game) bool {
+	unlock := m.lockGame(game.ID)
+	defer unlock()
+	if game.Status != chess.StatusInProgress || game.NumMove >= 2 {
+		return false
+	}
+	waitingSince := game.CreatedAt
+	if game.NumMove == 1 {
+		waitingSince = game.Clock.LastMoveAt
+	}
+	if time.Since(waitingSince) < m.cfg.FirstMoveTimeout {
+		return false
+	}
+	err := m.UpdateStatus(ctx, game.ID, chesslib.NoOutcome, chess.TerminationAborted)
+	if err != nil {
+		m.log.Error(err)
+		return false
+	}
+	return true
+}
+
+// EligibleDraws возвращает способы ничьей, которые можно потребовать в текущей позиции
+func (m *GameService) EligibleDraws(GameID uuid.UUID) []string {
+	game := m.GameMemory(GameID)
+	if game == nil || game.Status != chess.StatusInProgress {
+		return []string{}
+	}
+	eligible := make([]string, 0)
+	for _, method := range game.Match.EligibleDraws() {
+		for name, drawMethod := range drawMethods {
+			if method == drawMethod {
+				eligible = append(eligible, name)
+			}
+		}
+	}
+	return eligible
+}
+
+// ClaimDraw завершает партию вничью по требованию игрока.
+// Если способ не указан, используется первый доступный.
+func (m *GameService) ClaimDraw(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+	method string,
+) error {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.Game(ctx, GameID)
 	if err != nil {
 		return err
 	}
-	if game.Match.Outcome() != chesslib.NoOutcome {
-		err := m.UpdateStatus(GameID, game.Match.Outcome())
-		if err != nil {
-			m.log.Error(err)
-			return err
+	if game.Status != chess.StatusInProgress {
+		return errors.ErrGameNotActive
+	}
+	if game.Player(player.UserID) == nil {
+		return errors.ErrPlayerNotFound
+	}
+	if method == "" {
+		eligible := m.EligibleDraws(GameID)
+		if len(eligible) == 0 {
+			return errors.ErrDrawNotEligible
 		}
+		method = eligible[0]
+	}
+	drawMethod, ok := drawMethods[method]
+	if !ok || !slices.Contains(game.Match.EligibleDraws(), drawMethod) {
+		return errors.ErrDrawNotEligible
+	}
+	// Ничья фиксируется на копии до записи в базу, чтобы партия в памяти не разошлась с базой
+	match := game.Match.Clone()
+	if err := match.Draw(drawMethod); err != nil {
+		return errors.ErrDrawNotEligible
+	}
+	err = m.UpdateStatus(ctx, GameID, chesslib.Draw, terminationByMethod[drawMethod])
+	if err != nil {
+		return err
+	}
+	game.Match = match
+	return nil
+}
+
+// AddPremove ставит предход игрока в очередь. Предход допустим только во время хода соперника.
+func (m *GameService) AddPremove(
+	ctx context.Context,
+	GameID uuid.UUID,
+	move string,
+	player *dto.PlayerConn,
+) error {
+	game, err := m.Game(ctx, GameID)
+	if err != nil {
+		return err
+	}
+	if game.Status != chess.StatusInProgress {
 		return errors.ErrGameEnd
 	}
+	if game.Player(player.UserID) == nil {
+		return errors.ErrPlayerNotFound
+	}
+	if game.GetCurrentUser().UserID == player.UserID {
+		return errors.ErrCurrentUserMotion
+	}
+	if !IsMoveSyntax(move) {
+		return errors.ErrMalformedMove
+	}
+
+	m.premoveMu.Lock()
+	defer m.premoveMu.Unlock()
+	if len(game.Premoves[player.UserID]) >= m.cfg.MaxPremoves {
+		return errors.ErrPremoveQueueFull
+	}
+	game.Premoves[player.UserID] = append(game.Premoves[player.UserID], move)
 	return nil
 }
 
-func (m *GameService) SetPlayer(GameID uuid.UUID, player *dto.PlayerConn) error {
-	game, err := m.GameDB(GameID)
+// CancelPremoves очищает очередь предходов игрока
+func (m *GameService) CancelPremoves(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+) error {
+	game, err := m.Game(ctx, GameID)
 	if err != nil {
 		return err
 	}
-	switch player.UserID {
-	case game.WhitePlayer.UserID:
-		game.WhitePlayer.Conn = player.Conn
-	case game.BlackPlayer.UserID:
-		game.BlackPlayer.Conn = player.Conn
-	default:
+	m.premoveMu.Lock()
+	defer m.premoveMu.Unlock()
+	delete(game.Premoves, player.UserID)
+	return nil
+}
+
+// Premoves возвращает очередь предходов игрока
+func (m *GameService) Premoves(GameID uuid.UUID, userID uuid.UUID) []string {
+	game := m.GameMemory(GameID)
+	if game == nil {
+		return nil
+	}
+	m.premoveMu.Lock()
+	defer m.premoveMu.Unlock()
+	return append([]string{}, game.Premoves[userID]...)
+}
+
+// PlayPremove применяет первый предход стороны, чей сейчас ход, не списывая время с часов.
+// Если предход стал нелегальным, очередь игрока сбрасывается и возвращается ErrPremoveIllegal.
+// Возвращает nil вместо игрока, если предходов нет.
+func (m *GameService) PlayPremove(
+	ctx context.Context,
+	GameID uuid.UUID,
+) (string, *dto.PlayerConn, error) {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.Game(ctx, GameID)
+	if err != nil {
+		return "", nil, err
+	}
+	if game.Status != chess.StatusInProgress {
+		return "", nil, nil
+	}
+	owner := game.GetCurrentUser()
+
+	m.premoveMu.Lock()
+	queue := game.Premoves[owner.UserID]
+	if len(queue) == 0 {
+		m.premoveMu.Unlock()
+		return "", nil, nil
+	}
+	move := queue[0]
+	game.Premoves[owner.UserID] = queue[1:]
+	m.premoveMu.Unlock()
+
+	canonical, err := m.NormalizeMove(ctx, GameID, move, owner.AutoQueen)
+	if err != nil {
+		_ = m.CancelPremoves(ctx, GameID, owner)
+		return move, owner, errors.ErrPremoveIllegal
+	}
+	return canonical, owner, m.playMove(ctx, game, canonical, owner, 0)
+}
+
+func (m *GameService) SetPlayer(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+) error {
+	game, err := m.GameDB(ctx, GameID)
+	if err != nil {
+		return err
+	}
+	gamePlayer := game.Player(player.UserID)
+	if gamePlayer == nil {
 		return errors.ErrPlayerNotFound
 	}
+	gamePlayer.Conn = player.Conn
+	gamePlayer.Remote = player.Remote
+	gamePlayer.Stream = player.Stream
+	gamePlayer.AutoQueen = player.AutoQueen
 
 	return nil
 }
 
 func (m *GameService) IsConnectPlayers(GameID uuid.UUID) bool {
-	game := m.games[GameID]
+	game := m.GameMemory(GameID)
 	if game == nil {
 		return false
 	}
-	return game.BlackPlayer != nil && game.BlackPlayer.Conn != nil && game.WhitePlayer != nil &&
-		game.WhitePlayer.Conn != nil
+	return game.BlackPlayer.Connected() && game.WhitePlayer.Connected()
 }
 
 func (m *GameService) Opponent(gameID uuid.UUID) *dto.PlayerConn {
-	game := m.games[gameID]
+	game := m.GameMemory(gameID)
 	return game.GetOpponentUser()
 }
 
 func (m *GameService) GameMemory(GameID uuid.UUID) *dto.Game {
+	m.gamesMu.RLock()
+	defer m.gamesMu.RUnlock()
 	return m.games[GameID]
 }
-func (m *GameService) GameDB(gameID uuid.UUID) (*dto.Game, error) {
+
+// ActiveGames возвращает идентификаторы идущих партий в памяти
+func (m *GameService) ActiveGames() []uuid.UUID {
+	m.gamesMu.RLock()
+	defer m.gamesMu.RUnlock()
+	active := make([]uuid.UUID, 0, len(m.games))
+	for id, game := range m.games {
+		if game.Status == chess.StatusInProgress {
+			active = append(active, id)
+		}
+	}
+	return active
+}
+
+// EvictGames выгружает из памяти завершённые партии после FinishedTTL. Идущие партии остаются
+// в памяти, даже если игроки отключились: их завершают только часы узла (FlagExpired
+// и AbortUnstarted), которые видят лишь партии в памяти. Возвращает идентификаторы выгруженных партий.
+func (m *GameService) EvictGames() []uuid.UUID {
+	m.gamesMu.Lock()
+	defer m.gamesMu.Unlock()
+	now := time.Now()
+	evicted := make([]uuid.UUID, 0)
+	for id, game := range m.games {
+		if game.Status != chess.StatusInProgress && now.Sub(game.FinishedAt) >= m.cfg.FinishedTTL {
+			delete(m.games, id)
+			evicted = append(evicted, id)
+		}
+	}
+	m.evicted += uint64(len(evicted))
+	return evicted
+}
+
+// evictOverflowLocked освобождает место под новую партию, выгружая раньше всех завершённую партию
+// без подключённых игроков. Идущие партии не выгружаются. Вызывается под gamesMu.
+func (m *GameService) evictOverflowLocked() {
+	if m.cfg.MaxGames <= 0 || len(m.games) < m.cfg.MaxGames {
+		return
+	}
+	var oldest *dto.Game
+	for _, game := range m.games {
+		if game.Status == chess.StatusInProgress || hasConnectedPlayers(game) {
+			continue
+		}
+		if oldest == nil || game.FinishedAt.Before(oldest.FinishedAt) {
+			oldest = game
+		}
+	}
+	if oldest == nil {
+		m.log.Info("game cache is full of live games", "games", len(m.games))
+		return
+	}
+	delete(m.games, oldest.ID)
+	m.evicted++
+}
+
+func hasConnectedPlayers(game *dto.Game) bool {
+	return game.WhitePlayer.Connected() || game.BlackPlayer.Connected()
+}
+
+// GameStats возвращает число партий в памяти
+func (m *GameService) GameStats() *dto.GameStats {
+	m.gamesMu.RLock()
+	defer m.gamesMu.RUnlock()
+	stats := &dto.GameStats{MaxGames: m.cfg.MaxGames, EvictedGames: m.evicted}
+	for _, game := range m.games {
+		if game.Status == chess.StatusInProgress {
+			stats.LiveGames++
+		} else {
+			stats.CachedGames++
+		}
+	}
+	return stats
+}
+
+// DropGame выгружает партию из памяти, например когда ей стал владеть другой узел
+func (m *GameService) DropGame(GameID uuid.UUID) {
+	m.gamesMu.Lock()
+	defer m.gamesMu.Unlock()
+	delete(m.games, GameID)
+}
+
+// replayClock восстанавливает шахматные часы по времени сохранённых ходов
+func (m *GameService) replayClock(moves []*dto.Move) *dto.Clock {
+	clock := dto.NewClock(m.cfg.TimeControl, m.cfg.Increment)
+	for i, move := range moves {
+		motion := dto.WhiteMotion
+		if i%2 == 1 {
+			motion = dto.BlackMotion
+		}
+		var elapsed time.Duration
+		if i >= 2 {
+			elapsed = move.CreatedAt.Sub(moves[i-1].CreatedAt)
+		}
+		clock.Charge(motion, elapsed, move.CreatedAt)
+	}
+	return clock
+}
+
+// InProgressGames возвращает идентификаторы идущих партий из базы
+func (m *GameService) InProgressGames(ctx context.Context) ([]uuid.UUID, error) {
+	return m.repository.InProgressGames(ctx)
+}
+
+// RecoverGame восстанавливает идущую партию после перезапуска. Время простоя сервера не списывается
+// с часов. Партия без активности дольше StaleAfter прерывается, если в ней меньше двух ходов,
+// иначе присуждается поражение стороне, чей ход.
+func (m *GameService) RecoverGame(ctx context.Context, GameID uuid.UUID) error {
+	unlock := m.lockGame(GameID)
+	defer unlock()
+	game, err := m.GameDB(ctx, GameID)
+	if err != nil {
+		return err
+	}
+	if game.Status != chess.StatusInProgress {
+		return nil
+	}
+
+	lastActivity := game.CreatedAt
+	if !game.Clock.LastMoveAt.IsZero() {
+		lastActivity = game.Clock.LastMoveAt
+	}
+	if time.Since(lastActivity) < m.cfg.StaleAfter {
+		game.Resumed = true
+		if !game.Clock.LastMoveAt.IsZero() {
+			game.Clock.LastMoveAt = time.Now()
+		}
+		return nil
+	}
+	if game.NumMove < 2 {
+		return m.UpdateStatus(ctx, GameID, chesslib.NoOutcome, chess.TerminationAborted)
+	}
+	if time.Since(lastActivity) >= game.Clock.Remaining(game.CurrentMotion) {
+		return m.flagFall(ctx, game, chess.TerminationTimeout)
+	}
+	return m.flagFall(ctx, game, chess.TerminationAdjudication)
+}
+
+func (m *GameService) GameDB(ctx context.Context, gameID uuid.UUID) (*dto.Game, error) {
 	game := m.GameMemory(gameID)
 	if game != nil {
 		return game, nil
 	}
-	gameDB, err := m.GameByID(gameID)
+	gameDB, err := m.GameByID(ctx, gameID)
 	if err != nil {
 		return nil, err
 	}
@@ -219,13 +928,22 @@ func (m *GameService) GameDB(gameID uuid.UUID) (*dto.Game, error) {
 		CreatedAt:     gameDB.CreatedAt,
 		Result:        gameDB.Result,
 		Status:        gameDB.Status,
+		Termination:   gameDB.Termination,
 		Match:         match,
 		WhitePlayer:   &dto.PlayerConn{UserID: gameDB.WhiteUser.ID},
 		BlackPlayer:   &dto.PlayerConn{UserID: gameDB.BlackUser.ID},
 		CurrentMotion: currentMotion,
 		HistoryMove:   historyMove,
 		NumMove:       NumMoves,
+		Clock:         m.replayClock(gameDB.HistoryMove),
+		Premoves:      make(map[uuid.UUID][]string),
+	}
+	m.gamesMu.Lock()
+	defer m.gamesMu.Unlock()
+	if loaded, ok := m.games[gameID]; ok {
+		return loaded, nil
 	}
+	m.evictOverflowLocked()
 	m.games[gameID] = game
 	return game, nil
 }
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"GopherChessParty/ent/chess"
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+	chesslib "github.com/corentings/chess/v2"
+)
+
+func TestHasMatingMaterial(t *testing.T) {
+	tests := []struct {
+		name  string
+		fen   string
+		color chesslib.Color
+		want  bool
+	}{
+		{"lone king", "4k3/8/8/8/8/8/8/4K3 w - - 0 1", chesslib.White, false},
+		{"rook", "4k3/8/8/8/8/8/8/R3K3 w - - 0 1", chesslib.White, true},
+		{"pawn", "4k3/8/8/8/8/8/4P3/4K3 w - - 0 1", chesslib.White, true},
+		{"knight vs king", "4k3/8/8/8/8/8/8/4K1N1 w - - 0 1", chesslib.White, false},
+		{"knight vs queen", "3qk3/8/8/8/8/8/8/4K1N1 w - - 0 1", chesslib.White, false},
+		{"knight vs pawn", "4k3/3p4/8/8/8/8/8/4K1N1 w - - 0 1", chesslib.White, true},
+		{"knight vs rook", "r3k3/8/8/8/8/8/8/4K1N1 w - - 0 1", chesslib.White, true},
+		{"two knights", "4k3/8/8/8/8/8/8/1N2K1N1 w - - 0 1", chesslib.White, true},
+		{"knight and bishop", "4k3/8/8/8/8/8/8/2B1K1N1 w - - 0 1", chesslib.White, true},
+		{"bishop vs king", "4k3/8/8/8/8/8/8/2B1K3 w - - 0 1", chesslib.White, false},
+		{"same coloured bishops", "4k3/8/8/8/8/4B3/8/2B1K3 w - - 0 1", chesslib.White, false},
+		{"opposite coloured bishops", "4k3/8/8/8/8/8/8/2B1KB2 w - - 0 1", chesslib.White, true},
+		{
+			"bishop vs same coloured bishop",
+			"4kb2/8/8/8/8/8/8/2B1K3 w - - 0 1",
+			chesslib.White,
+			false,
+		},
+		{
+			"bishop vs opposite coloured bishop",
+			"2b1k3/8/8/8/8/8/8/2B1K3 w - - 0 1",
+			chesslib.White,
+			true,
+		},
+		{"bishop vs knight", "1n2k3/8/8/8/8/8/8/2B1K3 w - - 0 1", chesslib.White, true},
+		{"bishop vs rook", "r3k3/8/8/8/8/8/8/2B1K3 w - - 0 1", chesslib.White, false},
+		{"black knight vs pawn", "4k1n1/8/8/8/8/8/3P4/4K3 w - - 0 1", chesslib.Black, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			position, err := chesslib.FEN(tt.fen)
+			if err != nil {
+				t.Fatalf("FEN %q: %v", tt.fen, err)
+			}
+			board := chesslib.NewGame(position).Position().Board()
+			if got := hasMatingMaterial(board, tt.color); got != tt.want {
+				t.Fatalf("hasMatingMaterial = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextMatch(t *testing.T) {
+	game := &dto.Game{Match: chesslib.NewGame()}
+	// Неприменённый ход не меняет партию
+	if _, err := nextMatch(game, "d2d4"); err != nil {
+		t.Fatalf("nextMatch: %v", err)
+	}
+	for _, move := range []string{"g1f3", "g8f6", "f3g1", "f6g8", "g1f3", "g8f6", "f3g1", "f6g8"} {
+		match, err := nextMatch(game, move)
+		if err != nil {
+			t.Fatalf("nextMatch(%s): %v", move, err)
+		}
+		game.Match = match
+	}
+	if got := len(game.Match.Moves()); got != 8 {
+		t.Fatalf("moves = %d, want 8", got)
+	}
+	if !slices.Contains(game.Match.EligibleDraws(), chesslib.ThreefoldRepetition) {
+		t.Fatal("threefold repetition is not eligible after repeating the position")
+	}
+}
+
+// concurrently выполняет action n раз параллельно и возвращает число успешных вызовов
+func concurrently(n int, action func() error) int {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if action() == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return succeeded
+}
+
+func TestGameLock(t *testing.T) {
+	ctx := context.Background()
+	users := repository.NewMemoryUserRepository()
+	ids := make([]*dto.User, 0, 2)
+	for _, email := range []string{"white@example.com", "black@example.com"} {
+		user, err := users.CreateUser(ctx, &dto.CreateUser{Name: "gopher", Email: email})
+		if err != nil {
+			t.Fatalf("CreateUser: %v", err)
+		}
+		ids = append(ids, user)
+	}
+	games := NewGameService(
+		logger.New(dto.Application{Env: "prod"}),
+		repository.NewMemoryGameRepository(users),
+		dto.GameConfig{TimeControl: 10 * time.Minute, FirstMoveTimeout: time.Minute},
+	).(*GameService)
+	created, err := games.CreateGame(ctx, ids[0].ID, ids[1].ID)
+	if err != nil {
+		t.Fatalf("CreateGame: %v", err)
+	}
+	game := games.GameMemory(created.ID)
+	white, black := game.WhitePlayer, game.BlackPlayer
+
+	// Один и тот же ход из сокета и REST бота применяется один раз
+	moved := concurrently(10, func() error { return games.MoveGame(ctx, game.ID, "e2e4", white) })
+	if moved != 1 || game.NumMove != 1 {
+		t.Fatalf("moves applied = %d, NumMove = %d, want 1", moved, game.NumMove)
+	}
+	stored, err := games.GameByID(ctx, game.ID)
+	if err != nil || len(stored.HistoryMove) != 1 {
+		t.Fatalf("stored moves = %+v, %v, want 1", stored, err)
+	}
+
+	// Ход, проверенный до чужого хода, больше не легален
+	if err := games.MoveGame(ctx, game.ID, "e7e5", black); err != nil {
+		t.Fatalf("MoveGame: %v", err)
+	}
+	if err := games.MoveGame(ctx, game.ID, "e4e5", white); err == nil {
+		t.Fatal("MoveGame accepted a move into an occupied square")
+	}
+
+	finished := concurrently(10, func() error {
+		_, err := games.OfferDraw(ctx, game.ID, black)
+		if err != nil {
+			return err
+		}
+		return games.Resign(ctx, game.ID, white)
+	})
+	if finished != 1 || game.Status != chess.StatusFinished {
+		t.Fatalf("resigned = %d, status = %s, want one finished game", finished, game.Status)
+	}
+	if len(games.locks) != 0 {
+		t.Fatalf("locks left = %d, want 0", len(games.locks))
+	}
+}
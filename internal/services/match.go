@@ -1,99 +1,251 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
+	"time"
 
 	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
 	"GopherChessParty/internal/interfaces"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 )
 
-// MatchService управляет поиском и созданием пар для игры
+// queueStaleAfter срок, после которого запись очереди без подтверждения узла считается
+// оставленной упавшим узлом
+const queueStaleAfter = 30 * time.Second
+
+// MatchService управляет поиском и созданием пар для игры. Очередь хранится в репозитории и может быть
+// общей для нескольких узлов, сокеты поиска — на узле, где игрок встал в очередь.
 type MatchService struct {
-	log     interfaces.ILogger
-	channel chan dto.PlayerConn // Канал для получения новых подключений
-	exists  chan struct{}       // Сигнальный канал для оповещения о новых игроках
-	queue   []*dto.PlayerConn   // Очередь ожидающих игроков
-	queueMu sync.Mutex          // Мьютекс для безопасного доступа к очереди
-	exits   chan uuid.UUID
+	log        interfaces.ILogger
+	queue      interfaces.IQueueRepo
+	nodeID     string
+	exists     chan struct{}                 // Сигнальный канал для оповещения о новых игроках
+	sockets    map[uuid.UUID]*dto.PlayerConn // Сокеты поиска, открытые на этом узле
+	socketsMu  sync.Mutex                    // Мьютекс для доступа к сокетам поиска
+	avgWait    time.Duration                 // Скользящее среднее времени ожидания соперника
+	avgWaitMu  sync.Mutex
+	writeLocks sync.Map // Мьютексы записи в сокеты: *websocket.Conn -> *sync.Mutex
 }
 
 // NewMatchService создает новый сервис матчмейкинга
-func NewMatchService(log interfaces.ILogger) *MatchService {
+func NewMatchService(
+	log interfaces.ILogger,
+	queue interfaces.IQueueRepo,
+	nodeID string,
+) *MatchService {
 	return &MatchService{
 		log:     log,
-		channel: make(chan dto.PlayerConn, 100), // Буферизованный канал на 100 подключений
-		exists:  make(chan struct{}, 1),         // Буферизованный канал для сигналов
+		queue:   queue,
+		nodeID:  nodeID,
+		exists:  make(chan struct{}, 1), // Буферизованный канал для сигналов
+		sockets: make(map[uuid.UUID]*dto.PlayerConn),
 	}
 }
 
-func (m *MatchService) CheckPair() bool {
-	m.queueMu.Lock()
-	defer m.queueMu.Unlock()
-	return len(m.queue) == 2
+// CheckPair проверяет, можно ли составить пару из очереди
+func (m *MatchService) CheckPair(ctx context.Context) bool {
+	size, err := m.queue.Len(ctx)
+	return err == nil && size >= 2
 }
 
-func (m *MatchService) ExitPlayerAdd(playerID uuid.UUID) {
-	m.log.Info("ExitPlayerAdd", playerID.String(), "exit")
-	m.exits <- playerID
-}
+// RemovePlayer атомарно убирает игрока из очереди, если в очереди именно это подключение.
+// Возвращает true, если игрок был удалён.
+func (m *MatchService) RemovePlayer(ctx context.Context, player *dto.PlayerConn) bool {
+	m.socketsMu.Lock()
+	if current, ok := m.sockets[player.UserID]; !ok || current.Conn != player.Conn {
+		m.socketsMu.Unlock()
+		return false
+	}
+	delete(m.sockets, player.UserID)
+	m.socketsMu.Unlock()
 
-func (m *MatchService) ClearCloseConnection() {
-	for playerID := range m.exits {
-		m.queueMu.Lock()
-		for i, user := range m.queue {
-			if user.UserID == playerID {
-				m.queue = append(m.queue[:i], m.queue[i+1:]...)
-				break
-			}
-		}
-		m.queueMu.Unlock()
+	removed, err := m.queue.Remove(ctx, player.UserID, m.nodeID)
+	if err != nil {
+		m.log.Error(err)
+		return false
 	}
+	return removed
 }
 
-// GetExistsChannel возвращает канал для оповещения о новых игроках
+// ExistsChannel возвращает канал для оповещения о новых игроках
 func (m *MatchService) ExistsChannel() <-chan struct{} {
 	return m.exists
 }
 
-// AddUser добавляет нового игрока в очередь ожидания
-func (m *MatchService) AddUser(player *dto.PlayerConn) error {
-	m.queueMu.Lock()
-	defer m.queueMu.Unlock()
-	for i, user := range m.queue {
-		if user.UserID == player.UserID {
-			m.queue = append(m.queue[:i], m.queue[i+1:]...)
-			break
+// AddUser добавляет нового игрока в очередь ожидания. Предыдущее подключение того же игрока закрывается.
+func (m *MatchService) AddUser(ctx context.Context, player *dto.PlayerConn) error {
+	m.socketsMu.Lock()
+	replaced := m.sockets[player.UserID]
+	m.sockets[player.UserID] = player
+	m.socketsMu.Unlock()
+
+	if replaced != nil && replaced.Conn != player.Conn {
+		_ = m.CloseConnection(replaced)
+	}
+
+	err := m.queue.Enqueue(ctx, &dto.QueueEntry{
+		UserID:     player.UserID,
+		Node:       m.nodeID,
+		AbortCount: player.AbortCount,
+		JoinedAt:   time.Now(),
+	})
+	if err != nil {
+		m.socketsMu.Lock()
+		if m.sockets[player.UserID] == player {
+			delete(m.sockets, player.UserID)
 		}
+		m.socketsMu.Unlock()
+		return err
 	}
-	m.queue = append(m.queue, player)
-	m.exists <- struct{}{} // Сигнализируем о новом игроке
+
+	m.signal()
 	return nil
 }
 
-func (m *MatchService) ReturnPlayers() (*dto.PlayerConn, *dto.PlayerConn) {
-	m.queueMu.Lock()
-	defer m.queueMu.Unlock()
-	player1, player2 := m.queue[0], m.queue[1]
-	m.queue = m.queue[2:]
-	return player1, player2
+// signal сигнализирует о возможной паре, не блокируясь, если сигнал уже ожидает обработки
+func (m *MatchService) signal() {
+	select {
+	case m.exists <- struct{}{}:
+	default:
+	}
+}
+
+// ReturnPlayers забирает из очереди пару игроков. Игроки, часто прерывающие партии, подбираются последними.
+// Возвращает nil, если в очереди меньше двух игроков.
+func (m *MatchService) ReturnPlayers(ctx context.Context) (*dto.QueueEntry, *dto.QueueEntry) {
+	entry1, entry2, err := m.queue.PopPair(ctx)
+	if err != nil || entry1 == nil {
+		return nil, nil
+	}
+
+	now := time.Now()
+	m.avgWaitMu.Lock()
+	for _, entry := range []*dto.QueueEntry{entry1, entry2} {
+		wait := now.Sub(entry.JoinedAt)
+		if m.avgWait == 0 {
+			m.avgWait = wait
+		} else {
+			m.avgWait = (m.avgWait*4 + wait) / 5
+		}
+	}
+	m.avgWaitMu.Unlock()
+	return entry1, entry2
+}
+
+// FinishSearch отправляет игроку итог поиска и закрывает его сокет поиска, если он открыт на этом узле.
+// Возвращает false, если сокета на узле нет.
+func (m *MatchService) FinishSearch(userID uuid.UUID, message map[string]interface{}) bool {
+	m.socketsMu.Lock()
+	player, ok := m.sockets[userID]
+	delete(m.sockets, userID)
+	m.socketsMu.Unlock()
+	if !ok {
+		return false
+	}
+	_ = m.SendMessage(player, message)
+	_ = m.CloseConnection(player)
+	return true
 }
 
-func (m *MatchService) SendGemID(player *dto.PlayerConn, gameID uuid.UUID) error {
-	message := map[string]interface{}{
-		"gameID": gameID,
+// NotifyQueue рассылает игрокам в очереди с сокетами на этом узле их позицию и ожидаемое время ожидания.
+// Игроки, которым не удалось отправить сообщение, удаляются из очереди, а сокеты игроков,
+// вставших в очередь на другом узле, закрываются. Узел подтверждает поиск своих игроков
+// и убирает из очереди игроков узлов, которые перестали подтверждать поиск.
+func (m *MatchService) NotifyQueue(ctx context.Context) {
+	heartbeatAt := time.Now()
+	if err := m.queue.Heartbeat(ctx, m.nodeID, heartbeatAt); err != nil {
+		return
+	}
+	pruned, err := m.queue.PruneStale(ctx, heartbeatAt.Add(-queueStaleAfter))
+	if err == nil && pruned > 0 {
+		m.log.Info("stale queue entries removed", "count", pruned)
 	}
-	err := m.SendMessage(player, message)
+
+	entries, err := m.queue.Entries(ctx)
 	if err != nil {
-		m.log.Error(err)
-		return err
+		return
+	}
+	m.avgWaitMu.Lock()
+	avgWait := m.avgWait
+	m.avgWaitMu.Unlock()
+
+	nodeByUser := make(map[uuid.UUID]string, len(entries))
+	now := time.Now()
+	for i, entry := range entries {
+		nodeByUser[entry.UserID] = entry.Node
+		if entry.Node != m.nodeID {
+			continue
+		}
+		m.socketsMu.Lock()
+		player := m.sockets[entry.UserID]
+		m.socketsMu.Unlock()
+		if player == nil {
+			continue
+		}
+		estimated := avgWait - now.Sub(entry.JoinedAt)
+		if estimated < 0 {
+			estimated = 0
+		}
+		err := m.SendMessage(player, map[string]interface{}{
+			"queuePosition": i + 1,
+			"queueSize":     len(entries),
+			"estimatedWait": estimated.Milliseconds(),
+		})
+		if err != nil {
+			m.RemovePlayer(ctx, player)
+			_ = m.CloseConnection(player)
+		}
+	}
+
+	m.socketsMu.Lock()
+	var replaced []*dto.PlayerConn
+	for userID, player := range m.sockets {
+		if node, ok := nodeByUser[userID]; ok && node != m.nodeID {
+			replaced = append(replaced, player)
+			delete(m.sockets, userID)
+		}
+	}
+	m.socketsMu.Unlock()
+	for _, player := range replaced {
+		_ = m.CloseConnection(player)
+	}
+
+	// Пару могли не составить, пока строки очереди были заблокированы другим узлом
+	if len(entries) >= 2 {
+		m.signal()
+	}
+}
+
+// DrainSearch убирает из очереди всех игроков с сокетами на этом узле, отправляет им сообщение
+// и закрывает сокеты поиска
+func (m *MatchService) DrainSearch(ctx context.Context, message map[string]interface{}) {
+	m.socketsMu.Lock()
+	players := make([]*dto.PlayerConn, 0, len(m.sockets))
+	for _, player := range m.sockets {
+		players = append(players, player)
+	}
+	m.sockets = make(map[uuid.UUID]*dto.PlayerConn)
+	m.socketsMu.Unlock()
+
+	for _, player := range players {
+		if _, err := m.queue.Remove(ctx, player.UserID, m.nodeID); err != nil {
+			m.log.Error(err)
+		}
+		_ = m.SendMessage(player, message)
+		_ = m.CloseConnection(player)
 	}
-	return nil
 }
 
 func (m *MatchService) CloseConnection(player *dto.PlayerConn) error {
+	if player.Stream != nil {
+		player.Stream.Close()
+		return nil
+	}
+	m.writeLocks.Delete(player.Conn)
 	err := player.Conn.Close()
 	if err != nil {
 		return err
@@ -112,13 +264,25 @@ func (m *MatchService) SendMove(player *dto.PlayerConn, move string) error {
 	return nil
 }
 
+// SendMessage отправляет сообщение в сокет или поток игрока. Запись в один сокет из разных горутин
+// сериализуется.
 func (m *MatchService) SendMessage(player *dto.PlayerConn, message map[string]interface{}) error {
 	response, err := json.Marshal(message)
 	if err != nil {
 		m.log.Error(err)
 		return err
 	}
+	if player.Stream != nil {
+		if !player.Stream.Send(response) {
+			return errors.ErrPlayersNotConn
+		}
+		return nil
+	}
+	lock, _ := m.writeLocks.LoadOrStore(player.Conn, &sync.Mutex{})
+	writeMu := lock.(*sync.Mutex)
+	writeMu.Lock()
 	errSend := player.Conn.WriteMessage(websocket.TextMessage, response)
+	writeMu.Unlock()
 	if errSend != nil {
 		m.log.Error(errSend)
 		return errSend
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+
+	"GopherChessParty/internal/errors"
+	chesslib "github.com/corentings/chess/v2"
+)
+
+var (
+	uciPattern = regexp.MustCompile(`^([a-h][1-8])([a-h][1-8])([qrbnQRBN])?$`)
+	// SAN и LAN: Nf3, exd8=N+, Ng1-f3, e7e8Q, O-O-O
+	sanPattern = regexp.MustCompile(
+		`^([KQRBN])?([a-h])?([1-8])?[x\-:]?([a-h][1-8])(?:=?\(?([QRBNqrbn])\)?)?[+#]?[!?]*$`,
+	)
+	castlePattern = regexp.MustCompile(`^([O0])-([O0])(-[O0])?[+#]?[!?]*$`)
+)
+
+var pieceByChar = map[string]chesslib.PieceType{
+	"":  chesslib.Pawn,
+	"K": chesslib.King,
+	"Q": chesslib.Queen,
+	"R": chesslib.Rook,
+	"B": chesslib.Bishop,
+	"N": chesslib.Knight,
+}
+
+var promoByChar = map[string]chesslib.PieceType{
+	"":  chesslib.NoPieceType,
+	"q": chesslib.Queen,
+	"r": chesslib.Rook,
+	"b": chesslib.Bishop,
+	"n": chesslib.Knight,
+}
+
+// ParseMove разбирает ход в нотации UCI, SAN или LAN и находит соответствующий легальный ход позиции.
+// Если фигура превращения не указана, при autoQueen выбирается ферзь, иначе ход считается неоднозначным.
+func ParseMove(pos *chesslib.Position, raw string, autoQueen bool) (*chesslib.Move, error) {
+	move := strings.TrimSpace(raw)
+	if move == "" {
+		return nil, errors.ErrMalformedMove
+	}
+
+	var candidates []chesslib.Move
+	var promo chesslib.PieceType
+	switch {
+	case uciPattern.MatchString(move):
+		parts := uciPattern.FindStringSubmatch(move)
+		promo = promoByChar[strings.ToLower(parts[3])]
+		for _, valid := range pos.ValidMoves() {
+			if valid.S1().String() == parts[1] && valid.S2().String() == parts[2] {
+				candidates = append(candidates, valid)
+			}
+		}
+	case castlePattern.MatchString(move):
+		tag := chesslib.KingSideCastle
+		if castlePattern.FindStringSubmatch(move)[3] != "" {
+			tag = chesslib.QueenSideCastle
+		}
+		for _, valid := range pos.ValidMoves() {
+			if valid.HasTag(tag) {
+				candidates = append(candidates, valid)
+			}
+		}
+	case sanPattern.MatchString(move):
+		parts := sanPattern.FindStringSubmatch(move)
+		piece := pieceByChar[parts[1]]
+		promo = promoByChar[strings.ToLower(parts[5])]
+		for _, valid := range pos.ValidMoves() {
+			if pos.Board().Piece(valid.S1()).Type() != piece ||
+				valid.S2().String() != parts[4] {
+				continue
+			}
+			if parts[2] != "" && valid.S1().File().String() != parts[2] {
+				continue
+			}
+			if parts[3] != "" && valid.S1().Rank().String() != parts[3] {
+				continue
+			}
+			candidates = append(candidates, valid)
+		}
+	default:
+		return nil, errors.ErrMalformedMove
+	}
+
+	return selectMove(candidates, promo, autoQueen)
+}
+
+// selectMove выбирает единственный ход среди кандидатов с учётом фигуры превращения
+func selectMove(
+	candidates []chesslib.Move,
+	promo chesslib.PieceType,
+	autoQueen bool,
+) (*chesslib.Move, error) {
+	if len(candidates) == 0 {
+		return nil, errors.ErrInvalidMove
+	}
+	isPromotion := candidates[0].Promo() != chesslib.NoPieceType
+	if !isPromotion && promo != chesslib.NoPieceType {
+		return nil, errors.ErrInvalidMove
+	}
+	if isPromotion && promo == chesslib.NoPieceType {
+		if !autoQueen {
+			return nil, errors.ErrAmbiguousMove
+		}
+		promo = chesslib.Queen
+	}
+
+	var selected *chesslib.Move
+	for i := range candidates {
+		if candidates[i].Promo() != promo {
+			continue
+		}
+		if selected != nil {
+			return nil, errors.ErrAmbiguousMove
+		}
+		selected = &candidates[i]
+	}
+	if selected == nil {
+		return nil, errors.ErrInvalidMove
+	}
+	return selected, nil
+}
+
+// IsMoveSyntax проверяет, что строка записана в одной из поддерживаемых нотаций
+func IsMoveSyntax(raw string) bool {
+	move := strings.TrimSpace(raw)
+	return uciPattern.MatchString(move) || castlePattern.MatchString(move) ||
+		sanPattern.MatchString(move)
+}
+
+// CanonicalMove возвращает ход в нормализованной нотации UCI, в которой ходы хранятся в базе
+func CanonicalMove(move *chesslib.Move) string {
+	return chesslib.UCINotation{}.Encode(nil, move)
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+// oidcRandomBytes длина state, nonce и verifier PKCE до кодирования
+const oidcRandomBytes = 32
+
+// OIDCService вход через внешних провайдеров OpenID Connect и привязки аккаунтов к ним.
+// Аккаунт по пользователю провайдера находит и создаёт Service.
+type OIDCService struct {
+	log        interfaces.ILogger
+	identities interfaces.IIdentityRepo
+	providers  map[string]interfaces.IIdentityProvider
+	names      []string
+}
+
+func NewOIDCService(
+	log interfaces.ILogger,
+	identities interfaces.IIdentityRepo,
+	providers []interfaces.IIdentityProvider,
+) interfaces.IOIDCService {
+	s := &OIDCService{
+		log:        log,
+		identities: identities,
+		providers:  make(map[string]interfaces.IIdentityProvider, len(providers)),
+		names:      make([]string, 0, len(providers)),
+	}
+	for _, provider := range providers {
+		if _, ok := s.providers[provider.Name()]; ok {
+			panic("duplicate oidc provider " + provider.Name())
+		}
+		s.providers[provider.Name()] = provider
+		s.names = append(s.names, provider.Name())
+	}
+	return s
+}
+
+// OIDCProviders возвращает имена настроенных провайдеров
+func (s *OIDCService) OIDCProviders() []string {
+	return s.names
+}
+
+// StartOIDC начинает вход через провайдера: возвращает новый flow и адрес страницы входа
+func (s *OIDCService) StartOIDC(
+	ctx context.Context,
+	provider string,
+) (*dto.OIDCFlow, string, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, "", errors.ErrProviderNotFound
+	}
+	flow := &dto.OIDCFlow{Provider: provider}
+	for _, value := range []*string{&flow.State, &flow.Nonce, &flow.Verifier} {
+		raw := make([]byte, oidcRandomBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, "", err
+		}
+		*value = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	authURL, err := p.AuthCodeURL(ctx, flow)
+	if err != nil {
+		return nil, "", err
+	}
+	return flow, authURL, nil
+}
+
+// ExchangeOIDC обменивает код от провайдера flow на пользователя провайдера
+func (s *OIDCService) ExchangeOIDC(
+	ctx context.Context,
+	code string,
+	flow *dto.OIDCFlow,
+) (*dto.ExternalIdentity, error) {
+	p, ok := s.providers[flow.Provider]
+	if !ok {
+		return nil, errors.ErrProviderNotFound
+	}
+	return p.Exchange(ctx, code, flow)
+}
+
+func (s *OIDCService) IdentityUser(
+	ctx context.Context,
+	provider, subject string,
+) (uuid.UUID, error) {
+	return s.identities.IdentityUser(ctx, provider, subject)
+}
+
+// LinkIdentity привязывает пользователя провайдера к аккаунту
+func (s *OIDCService) LinkIdentity(
+	ctx context.Context,
+	userID uuid.UUID,
+	identity *dto.ExternalIdentity,
+) error {
+	return s.identities.CreateIdentity(ctx, newIdentity(userID, identity))
+}
+
+// CreateLinkedUser создаёт аккаунт с подтверждённой почтой вместе с привязкой пользователя
+// провайдера. Без привязки аккаунт не создаётся.
+func (s *OIDCService) CreateLinkedUser(
+	ctx context.Context,
+	data *dto.CreateUser,
+	identity *dto.ExternalIdentity,
+) (*dto.User, error) {
+	return s.identities.CreateUserWithIdentity(ctx, data, newIdentity(uuid.Nil, identity))
+}
+
+func newIdentity(userID uuid.UUID, identity *dto.ExternalIdentity) *dto.Identity {
+	return &dto.Identity{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (s *OIDCService) Identities(ctx context.Context, userID uuid.UUID) ([]*dto.Identity, error) {
+	return s.identities.Identities(ctx, userID)
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"slices"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+const (
+	personalTokenBytes = 32
+	// Время последнего использования токена обновляется не чаще этого периода,
+	// чтобы запросы скриптов не писали в базу каждый раз
+	personalTokenTouchPeriod = time.Minute
+)
+
+type PersonalTokenService struct {
+	log        interfaces.ILogger
+	repository interfaces.IPersonalTokenRepo
+}
+
+func NewPersonalTokenService(
+	log interfaces.ILogger,
+	repository interfaces.IPersonalTokenRepo,
+) interfaces.IPersonalTokenService {
+	return &PersonalTokenService{log: log, repository: repository}
+}
+
+// CreatePersonalToken создаёт токен. Сам токен возвращается только здесь, сохраняется его хэш.
+func (s *PersonalTokenService) CreatePersonalToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	data *dto.CreatePersonalToken,
+) (*dto.CreatedPersonalToken, error) {
+	raw := make([]byte, personalTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	secret := dto.PersonalTokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	scopes := slices.Clone(data.Scopes)
+	slices.Sort(scopes)
+	token := dto.PersonalToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      data.Name,
+		TokenHash: hashUserToken(secret),
+		Scopes:    slices.Compact(scopes),
+		CreatedAt: time.Now(),
+	}
+	if data.ExpiresInDays > 0 {
+		expiresAt := token.CreatedAt.AddDate(0, 0, data.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+	if err := s.repository.CreatePersonalToken(ctx, &token); err != nil {
+		return nil, err
+	}
+	return &dto.CreatedPersonalToken{PersonalToken: token, Token: secret}, nil
+}
+
+func (s *PersonalTokenService) PersonalTokens(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*dto.PersonalToken, error) {
+	return s.repository.PersonalTokens(ctx, userID)
+}
+
+func (s *PersonalTokenService) RevokePersonalToken(
+	ctx context.Context,
+	userID, tokenID uuid.UUID,
+) error {
+	return s.repository.RevokePersonalToken(ctx, userID, tokenID)
+}
+
+func (s *PersonalTokenService) RevokePersonalTokens(ctx context.Context, userID uuid.UUID) error {
+	return s.repository.RevokePersonalTokens(ctx, userID)
+}
+
+// AuthenticatePersonalToken возвращает действующий токен или ErrTokenInvalid. Отзыв действует
+// сразу, так как токен проверяется по базе при каждом запросе.
+func (s *PersonalTokenService) AuthenticatePersonalToken(
+	ctx context.Context,
+	secret string,
+) (*dto.PersonalToken, error) {
+	token, err := s.repository.PersonalTokenByHash(ctx, hashUserToken(secret))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= personalTokenTouchPeriod {
+		if err := s.repository.TouchPersonalToken(ctx, token.ID, now); err != nil {
+			s.log.Error(err)
+		}
+		token.LastUsedAt = &now
+	}
+	return token, nil
+}
@@ -1,8 +1,19 @@
 package services
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
 	exc "errors"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
 
+	"GopherChessParty/ent/challenge"
+	"GopherChessParty/ent/user"
 	"GopherChessParty/internal/dto"
 	"GopherChessParty/internal/errors"
 	"GopherChessParty/internal/interfaces"
@@ -10,12 +21,41 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	clockCheckPeriod  = 500 * time.Millisecond // Период проверки шахматных часов
+	queueUpdatePeriod = 2 * time.Second        // Период оповещения очереди поиска
+	leaseCheckPeriod  = time.Second            // Период продления аренд партий
+	gameEvictPeriod   = 10 * time.Second       // Период выгрузки неактивных партий из памяти
+	// Период загрузки отзывов сессий: за это время токены сессии, отозванной на другом узле,
+	// перестают приниматься на этом
+	revocationSyncPeriod = 5 * time.Second
+	keysReloadPeriod     = time.Minute // Период перечитывания каталога ключей подписи
+	loginPrunePeriod     = time.Hour   // Период удаления устаревших счётчиков неудачных входов
+	maxUserNameLen       = 255         // Имя нового аккаунта из профиля провайдера обрезается до этой длины
+	maxEmailLen          = 254         // Длина почты по RFC 5321, длиннее адрес не сохранить
+)
+
+// dummyPasswordHash bcrypt-хэш случайного пароля. Для неизвестной почты пароль сравнивается с ним,
+// чтобы время ответа не выдавало, зарегистрирована ли почта.
+const dummyPasswordHash = "$2a$10$Wnd61cMJLqHfvpNG9hOTCucPOBiAgdYX35gSLuMbDTGa4Khz87FkO"
+
 type Service struct {
 	interfaces.IUserService
 	interfaces.IGameService
 	interfaces.IAuthService
 	interfaces.IMatchService
-	logger interfaces.ILogger
+	interfaces.IClusterService
+	interfaces.ITwoFactorService
+	interfaces.IAccountService
+	interfaces.ILoginThrottleService
+	interfaces.IPersonalTokenService
+	interfaces.IChallengeService
+	interfaces.IOIDCService
+	logger    interfaces.ILogger
+	sockets   map[uuid.UUID]map[uuid.UUID]*dto.PlayerConn // Сокеты партий, открытые на этом узле
+	socketsMu sync.RWMutex
+	streams   map[uuid.UUID]map[*dto.EventStream]struct{} // Потоки событий, открытые на этом узле
+	streamsMu sync.RWMutex
 }
 
 func NewService(
@@ -23,73 +63,461 @@ func NewService(
 	gameService interfaces.IGameService,
 	authService interfaces.IAuthService,
 	matchService interfaces.IMatchService,
+	clusterService interfaces.IClusterService,
+	twoFactorService interfaces.ITwoFactorService,
+	accountService interfaces.IAccountService,
+	loginThrottleService interfaces.ILoginThrottleService,
+	personalTokenService interfaces.IPersonalTokenService,
+	challengeService interfaces.IChallengeService,
+	oidcService interfaces.IOIDCService,
 	logger interfaces.ILogger,
 ) *Service {
 	service := &Service{
-		IUserService:  userService,
-		IGameService:  gameService,
-		IAuthService:  authService,
-		IMatchService: matchService,
-		logger:        logger,
+		IUserService:          userService,
+		IGameService:          gameService,
+		IAuthService:          authService,
+		IMatchService:         matchService,
+		IClusterService:       clusterService,
+		ITwoFactorService:     twoFactorService,
+		IAccountService:       accountService,
+		ILoginThrottleService: loginThrottleService,
+		IPersonalTokenService: personalTokenService,
+		IChallengeService:     challengeService,
+		IOIDCService:          oidcService,
+		logger:                logger,
+		sockets:               make(map[uuid.UUID]map[uuid.UUID]*dto.PlayerConn),
+		streams:               make(map[uuid.UUID]map[*dto.EventStream]struct{}),
+	}
+	err := service.SubscribeEvents(service.handleEvent)
+	if err != nil {
+		panic(err)
 	}
 	go service.SearchPlayerConn()
+	go service.WatchClocks()
+	go service.WatchQueue()
+	go service.WatchLeases()
+	go service.WatchGames()
+	go service.WatchRevocations()
+	go service.WatchKeys()
+	go service.WatchLoginFailures()
 	return service
 }
 
+// IsValidateToken проверяет access-токен и то, что его сессия не отозвана.
+// Отзывы берутся из памяти узла, без запроса к базе.
 func (s *Service) IsValidateToken(tokenString string) (*jwt.Token, bool) {
 	token, err := s.ValidateAccess(tokenString)
-	if err != nil {
+	if err != nil || !token.Valid {
+		return nil, false
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, false
+	}
+	raw, ok := claims["sid"].(string)
+	if !ok {
+		return nil, false
+	}
+	sessionID, err := uuid.Parse(raw)
+	if err != nil || s.IsSessionRevoked(sessionID) {
 		return nil, false
 	}
-	return token, token.Valid
+	return token, true
 }
 
-func (s *Service) CreateUser(data *dto.CreateUser) (*dto.User, error) {
+func (s *Service) CreateUser(ctx context.Context, data *dto.CreateUser) (*dto.User, error) {
+	return s.RegisterUser(ctx, data)
+}
+
+// RegisterUser создаёт пользователя и отправляет письмо подтверждения почты. Если письмо не ушло,
+// пользователь запросит его повторно.
+func (s *Service) RegisterUser(ctx context.Context, data *dto.CreateUser) (*dto.User, error) {
 	hashedPassword, err := s.GeneratePassword(data.Password)
 	if err != nil {
 		return nil, err
 	}
-	return s.SaveUser(data, hashedPassword)
-}
-
-func (s *Service) RegisterUser(data *dto.CreateUser) (*dto.User, error) {
-	hashedPassword, err := s.GeneratePassword(data.Password)
+	user, err := s.SaveUser(ctx, data, hashedPassword)
 	if err != nil {
 		return nil, err
 	}
-	return s.SaveUser(data, hashedPassword)
+	if err := s.SendVerification(ctx, user); err != nil {
+		s.logger.Error(err)
+	}
+	return user, nil
 }
 
-func (s *Service) ValidPassword(data dto.AuthenticateUser) (*uuid.UUID, bool) {
-	userAuth, err := s.UserPassword(data.Email)
+func (s *Service) ValidPassword(ctx context.Context, data dto.AuthenticateUser) (*uuid.UUID, bool) {
+	userAuth, err := s.UserPassword(ctx, data.Email)
 	if err != nil {
+		s.IsValidPassword(dummyPasswordHash, data.Password)
 		return nil, false
 	}
 	return &userAuth.UserID, s.IsValidPassword(userAuth.HashedPassword, data.Password)
 }
 
-// SearchPlayerConn ищет пары игроков в очереди
+// Authenticate проверяет почту и пароль с ограничением попыток по почте и адресу клиента.
+// Пока вход ограничен, возвращает ErrLoginThrottled и время до следующей попытки, не проверяя пароль.
+// Владелец аккаунта получает письмо, когда вход в аккаунт блокируется.
+func (s *Service) Authenticate(
+	ctx context.Context,
+	data dto.AuthenticateUser,
+	ip string,
+) (uuid.UUID, time.Duration, error) {
+	attempt, retryAfter, err := s.LoginAttempt(ctx, data.Email, ip)
+	if err != nil {
+		return uuid.Nil, retryAfter, err
+	}
+	userID, ok := s.ValidPassword(ctx, data)
+	if ok {
+		if err := s.LoginSucceeded(ctx, data.Email); err != nil {
+			s.logger.Error(err)
+		}
+		return *userID, 0, nil
+	}
+	locked, err := s.LoginFailed(ctx, attempt, data.Email, ip)
+	if err != nil {
+		s.logger.Error(err)
+	}
+	if locked && userID != nil {
+		if user, err := s.UserByID(ctx, *userID); err == nil {
+			s.SendLockoutNotice(user, ip)
+		}
+	}
+	return uuid.Nil, 0, errors.ErrBadCredentials
+}
+
+// EnrollTwoFactor начинает подключение 2FA. Аккаунт в приложении подписывается почтой пользователя.
+func (s *Service) EnrollTwoFactor(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.TOTPEnrollment, error) {
+	user, err := s.UserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.EnrollTOTP(ctx, userID, user.Email)
+}
+
+// DisableTwoFactor отключает 2FA после проверки пароля и текущего кода из приложения. Попытки
+// учитываются вместе с вводом кодов при входе; пока они ограничены, возвращает ErrLoginThrottled и
+// время до следующей попытки. Неверный пароль и неверный код не различаются.
+func (s *Service) DisableTwoFactor(
+	ctx context.Context,
+	userID uuid.UUID,
+	data *dto.DisableTwoFactor,
+) (time.Duration, error) {
+	retryAfter, err := s.TwoFactorCodeAttempt(ctx, userID)
+	if err != nil {
+		return retryAfter, err
+	}
+	user, err := s.UserByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	userAuth, err := s.UserPassword(ctx, user.Email)
+	if err != nil {
+		return 0, err
+	}
+	if !s.IsValidPassword(userAuth.HashedPassword, data.Password) {
+		return 0, errors.ErrBadSecondFactor
+	}
+	if err := s.VerifyTOTP(ctx, userID, data.Code); err != nil {
+		if exc.Is(err, errors.ErrInvalidOTP) {
+			return 0, errors.ErrBadSecondFactor
+		}
+		return 0, err
+	}
+	if err := s.TwoFactorSucceeded(ctx, userID); err != nil {
+		s.logger.Error(err)
+	}
+	return 0, s.DisableTOTP(ctx, userID)
+}
+
+// LoginTwoFactor завершает вход с 2FA: по токену второго шага и коду открывает сессию.
+// Пока ввод кодов ограничен, возвращает ErrLoginThrottled и время до следующей попытки.
+func (s *Service) LoginTwoFactor(
+	ctx context.Context,
+	data *dto.TwoFactorLogin,
+	device dto.Device,
+) (*dto.TokenPair, time.Duration, error) {
+	userID, challengeID, err := s.ValidateChallenge(data.ChallengeToken)
+	if err != nil {
+		return nil, 0, err
+	}
+	retryAfter, err := s.TwoFactorAttempt(ctx, userID, challengeID)
+	if err != nil {
+		return nil, retryAfter, err
+	}
+	if err := s.VerifySecondFactor(ctx, userID, data.Code); err != nil {
+		return nil, 0, err
+	}
+	if err := s.TwoFactorSucceeded(ctx, userID); err != nil {
+		s.logger.Error(err)
+	}
+	tokens, err := s.GenerateTokenPair(ctx, userID, device)
+	return tokens, 0, err
+}
+
+// StartOIDCLogin начинает вход через провайдера OpenID Connect. Flow-токен клиент хранит у себя
+// и передаёт вместе с кодом, который провайдер вернёт на RedirectURL.
+func (s *Service) StartOIDCLogin(ctx context.Context, provider string) (*dto.OIDCStart, error) {
+	flow, authURL, err := s.StartOIDC(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	flowToken, err := s.GenerateOIDCFlow(flow)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.OIDCStart{AuthorizationURL: authURL, FlowToken: flowToken}, nil
+}
+
+// AuthenticateOIDC завершает вход через провайдера и возвращает аккаунт. Пользователь провайдера
+// без привязки связывается с аккаунтом по почте без учёта регистра, только если провайдер её
+// подтвердил, а без аккаунта с такой почтой аккаунт создаётся. Аккаунт с неподтверждённой почтой
+// не связывается: его мог зарегистрировать кто угодно, не владея адресом.
+func (s *Service) AuthenticateOIDC(
+	ctx context.Context,
+	provider string,
+	data *dto.OIDCCallback,
+) (uuid.UUID, error) {
+	flow, err := s.ValidateOIDCFlow(data.FlowToken)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if flow.Provider != provider ||
+		subtle.ConstantTimeCompare([]byte(flow.State), []byte(data.State)) != 1 {
+		return uuid.Nil, errors.ErrOIDCState
+	}
+	identity, err := s.ExchangeOIDC(ctx, data.Code, flow)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	userID, err := s.IdentityUser(ctx, identity.Provider, identity.Subject)
+	if !exc.Is(err, errors.ErrIdentityNotFound) {
+		return userID, err
+	}
+	identity.Email = strings.ToLower(strings.TrimSpace(identity.Email))
+	if !identity.EmailVerified || identity.Email == "" {
+		return uuid.Nil, errors.ErrOIDCEmailUnverified
+	}
+	if len(identity.Email) > maxEmailLen {
+		return uuid.Nil, errors.ErrOIDCEmailTooLong
+	}
+
+	userID, err = s.linkOIDCAccount(ctx, identity)
+	if exc.Is(err, errors.ErrEmailTaken) {
+		// Параллельный первый вход с той же почтой уже создал аккаунт: привязываемся к нему
+		userID, err = s.linkOIDCAccount(ctx, identity)
+	}
+	if exc.Is(err, errors.ErrIdentityTaken) {
+		// Параллельный вход того же пользователя провайдера уже создал привязку
+		return s.IdentityUser(ctx, identity.Provider, identity.Subject)
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	s.logger.Info("identity linked", "provider", identity.Provider, "user", userID)
+	return userID, nil
+}
+
+// linkOIDCAccount привязывает пользователя провайдера к аккаунту с его почтой или создаёт аккаунт
+// вместе с привязкой
+func (s *Service) linkOIDCAccount(
+	ctx context.Context,
+	identity *dto.ExternalIdentity,
+) (uuid.UUID, error) {
+	account, err := s.UserByEmail(ctx, identity.Email)
+	if exc.Is(err, errors.ErrUserNotFound) {
+		account, err = s.createOIDCUser(ctx, identity)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return account.ID, nil
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !account.EmailVerified {
+		return uuid.Nil, errors.ErrEmailUnverified
+	}
+	if err := s.LinkIdentity(ctx, account.ID, identity); err != nil {
+		return uuid.Nil, err
+	}
+	return account.ID, nil
+}
+
+// createOIDCUser создаёт аккаунт для пользователя провайдера вместе с привязкой. Пароль случайный
+// и никому не известен: задать свой можно через сброс пароля.
+func (s *Service) createOIDCUser(
+	ctx context.Context,
+	identity *dto.ExternalIdentity,
+) (*dto.User, error) {
+	raw := make([]byte, oidcRandomBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := s.GeneratePassword(base64.RawURLEncoding.EncodeToString(raw))
+	if err != nil {
+		return nil, err
+	}
+	name := []rune(identity.Name)
+	if len(name) == 0 {
+		name = []rune(strings.Split(identity.Email, "@")[0])
+	}
+	if len(name) > maxUserNameLen {
+		name = name[:maxUserNameLen]
+	}
+	return s.CreateLinkedUser(ctx, &dto.CreateUser{
+		Name:     string(name),
+		Email:    identity.Email,
+		Password: hashedPassword,
+	}, identity)
+}
+
+// RequestEmailVerification повторно отправляет письмо подтверждения почты
+func (s *Service) RequestEmailVerification(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.UserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified {
+		return errors.ErrEmailVerified
+	}
+	return s.SendVerification(ctx, user)
+}
+
+// VerifyEmail подтверждает почту по токену из письма
+func (s *Service) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := s.ConsumeVerification(ctx, token)
+	if err != nil {
+		return err
+	}
+	return s.SetEmailVerified(ctx, userID)
+}
+
+// RequestPasswordReset отправляет письмо сброса пароля. Для неизвестной почты молча ничего не делает,
+// чтобы по ответу нельзя было проверить, зарегистрирован ли адрес.
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) error {
+	userAuth, err := s.UserPassword(ctx, email)
+	if exc.Is(err, errors.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	user, err := s.UserByID(ctx, userAuth.UserID)
+	if err != nil {
+		return err
+	}
+	return s.SendPasswordReset(ctx, user)
+}
+
+// ResetPassword задаёт новый пароль по токену из письма, завершает все сессии пользователя
+// и отзывает его персональные токены
+func (s *Service) ResetPassword(ctx context.Context, data *dto.PasswordReset) error {
+	userID, err := s.ConsumePasswordReset(ctx, data.Token)
+	if err != nil {
+		return err
+	}
+	hashedPassword, err := s.GeneratePassword(data.Password)
+	if err != nil {
+		return err
+	}
+	if err := s.SetPassword(ctx, userID, hashedPassword); err != nil {
+		return err
+	}
+	return s.LogoutEverywhere(ctx, userID)
+}
+
+// LogoutEverywhere отзывает все сессии и персональные токены пользователя
+func (s *Service) LogoutEverywhere(ctx context.Context, userID uuid.UUID) error {
+	if err := s.LogoutAll(ctx, userID); err != nil {
+		return err
+	}
+	return s.RevokePersonalTokens(ctx, userID)
+}
+
+// JoinSearch ставит игрока в очередь поиска соперника с учётом прерванных им партий.
+// Рейтинговые партии доступны только с подтверждённой почтой, боты в очередь людей не допускаются.
+func (s *Service) JoinSearch(ctx context.Context, player *dto.PlayerConn) error {
+	user, err := s.UserByID(ctx, player.UserID)
+	if err != nil {
+		return err
+	}
+	if user.Bot {
+		return errors.ErrBotSearch
+	}
+	if !user.EmailVerified {
+		return errors.ErrEmailUnverified
+	}
+	player.AbortCount = user.AbortCount
+	return s.AddUser(ctx, player)
+}
+
+// SearchPlayerConn ищет пары игроков в очереди, пока в ней есть хотя бы два игрока
 func (s *Service) SearchPlayerConn() {
+	ctx := context.Background()
 	for range s.ExistsChannel() {
-		if s.CheckPair() {
-			player1, player2 := s.ReturnPlayers()
-			game, err := s.CreateGame(player1.UserID, player2.UserID)
+		for s.CheckPair(ctx) {
+			entry1, entry2 := s.ReturnPlayers(ctx)
+			if entry1 == nil {
+				break
+			}
+			message := map[string]interface{}{}
+			game, err := s.CreateGame(ctx, entry1.UserID, entry2.UserID)
 			if err != nil {
-				_ = s.AddUser(player1)
-				_ = s.AddUser(player2)
-				continue
+				s.logger.Error(err)
+				message["error"] = "game not created"
+			} else {
+				message["gameID"] = game.ID
+				s.notifyGameStart(game.ID, entry1.UserID, entry2.UserID)
 			}
-			_ = s.SendGemID(player1, game.ID)
-			_ = s.SendGemID(player2, game.ID)
-			_ = player1.Conn.Close()
-			_ = player2.Conn.Close()
+			s.finishSearch(entry1, message)
+			s.finishSearch(entry2, message)
 		}
 	}
 }
 
+// finishSearch сообщает игроку итог поиска на узле, где открыт его сокет поиска
+func (s *Service) finishSearch(entry *dto.QueueEntry, message map[string]interface{}) {
+	if entry.Node == s.NodeID() {
+		s.FinishSearch(entry.UserID, message)
+		return
+	}
+	payload, err := json.Marshal(message)
+	if err != nil {
+		s.logger.Error(err)
+		return
+	}
+	_ = s.PublishEvent(&dto.Event{
+		Type:    dto.EventMatched,
+		Node:    entry.Node,
+		UserID:  entry.UserID,
+		Payload: payload,
+	})
+}
+
+// WatchQueue периодически сообщает игрокам в очереди их позицию и удаляет мёртвые подключения
+func (s *Service) WatchQueue() {
+	ctx := context.Background()
+	ticker := time.NewTicker(queueUpdatePeriod)
+	defer ticker.Stop()
+	for range ticker.C {
+		s.NotifyQueue(ctx)
+	}
+}
+
+// CancelSearch убирает игрока из очереди поиска по его запросу и закрывает сокет поиска
+func (s *Service) CancelSearch(player *dto.PlayerConn) error {
+	s.RemovePlayer(context.Background(), player)
+	_ = s.SendMessage(player, map[string]interface{}{"cancelled": true})
+	return s.CloseConnection(player)
+}
+
 func (s *Service) PlayerExit(player *dto.PlayerConn) error {
-	s.ExitPlayerAdd(player.UserID)
-	err := player.Conn.Close()
+	s.RemovePlayer(context.Background(), player)
+	err := s.CloseConnection(player)
 	if err != nil {
 		s.logger.Error(err)
 		return err
@@ -97,64 +525,926 @@ func (s *Service) PlayerExit(player *dto.PlayerConn) error {
 	return nil
 }
 
-func (s *Service) MoveGameStr(gameID uuid.UUID, move string, player *dto.PlayerConn) bool {
+// MoveGameStr проверяет и применяет ход игрока, оповещает соперника и разыгрывает его предходы.
+// Возвращает причину отказа, если ход не принят.
+func (s *Service) MoveGameStr(
+	ctx context.Context,
+	gameID uuid.UUID,
+	move string,
+	player *dto.PlayerConn,
+) error {
 	if !s.IsConnectPlayers(gameID) {
 		s.logger.Error(errors.ErrPlayersNotConn)
-		return false
+		return errors.ErrPlayersNotConn
 	}
 
+	if s.GameMemory(gameID).GetCurrentUser().UserID != player.UserID {
+		return errors.ErrCurrentUserMotion
+	}
 	opponentMotionUser := s.Opponent(gameID)
 
-	err := s.MoveValid(gameID, move)
+	canonical, err := s.NormalizeMove(ctx, gameID, move, player.AutoQueen)
+	if err != nil {
+		return err
+	}
+
+	errMove := s.MoveGame(ctx, gameID, canonical, player)
+	if exc.Is(errMove, errors.ErrTimeOut) {
+		// Ход не применён: флаг упал раньше
+		canonical = ""
+	} else if errMove != nil && !exc.Is(errMove, errors.ErrGameEnd) {
+		return errMove
+	}
+
+	err = s.SendGameInfo(ctx, gameID, opponentMotionUser, errMove == nil, canonical)
+	if err != nil {
+		return err
+	}
+	if errMove == nil {
+		s.playPremoves(ctx, gameID)
+	}
+	if exc.Is(errMove, errors.ErrTimeOut) {
+		return errMove
+	}
+	return nil
+}
+
+// playPremoves применяет очередь предходов сразу после хода соперника
+func (s *Service) playPremoves(ctx context.Context, gameID uuid.UUID) {
+	for {
+		move, owner, err := s.PlayPremove(ctx, gameID)
+		if owner == nil {
+			return
+		}
+		game := s.GameMemory(gameID)
+		if exc.Is(err, errors.ErrPremoveIllegal) {
+			_ = s.SendMessage(owner, map[string]interface{}{
+				"ok":              false,
+				"premoveRejected": move,
+				"reason":          errors.MoveRejectReason(errors.ErrInvalidMove),
+				"message":         "Предход стал недопустимым",
+			})
+			return
+		}
+		if err != nil && !exc.Is(err, errors.ErrGameEnd) && !exc.Is(err, errors.ErrTimeOut) {
+			return
+		}
+		if exc.Is(err, errors.ErrTimeOut) {
+			move = ""
+		}
+		_ = s.SendGameInfo(ctx, gameID, game.OpponentOf(owner.UserID), err == nil, move)
+		_ = s.SendGameInfo(ctx, gameID, owner, err == nil, move)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// GameAction обрабатывает действия игрока в сокете партии, отличные от хода
+func (s *Service) GameAction(
+	ctx context.Context,
+	GameID uuid.UUID,
+	action *dto.GameAction,
+	player *dto.PlayerConn,
+) error {
+	var err error
+	switch action.Action {
+	case dto.ActionPremove:
+		err = s.AddPremove(ctx, GameID, action.Move, player)
+		if exc.Is(err, errors.ErrCurrentUserMotion) {
+			// Соперник уже сходил — предход играется как обычный ход
+			return s.SendMoveResult(
+				ctx,
+				GameID,
+				player,
+				s.MoveGameStr(ctx, GameID, action.Move, player),
+			)
+		}
+	case dto.ActionCancelPremove:
+		err = s.CancelPremoves(ctx, GameID, player)
+	case dto.ActionAbort:
+		err = s.Abort(ctx, GameID, player)
+		if err == nil {
+			s.trackAbort(ctx, player.UserID)
+			s.BroadcastGameInfo(ctx, GameID)
+			return nil
+		}
+	case dto.ActionClaimDraw:
+		err = s.ClaimDraw(ctx, GameID, player, action.Method)
+		if err == nil {
+			s.BroadcastGameInfo(ctx, GameID)
+			return nil
+		}
+	case dto.ActionResign:
+		err = s.Resign(ctx, GameID, player)
+		if err == nil {
+			s.BroadcastGameInfo(ctx, GameID)
+			return nil
+		}
+	case dto.ActionOfferDraw:
+		_, err = s.OfferDraw(ctx, GameID, player)
+		if err == nil {
+			s.BroadcastGameInfo(ctx, GameID)
+			return nil
+		}
+	case dto.ActionDeclineDraw:
+		err = s.DeclineDraw(ctx, GameID, player)
+		if err == nil {
+			s.BroadcastGameInfo(ctx, GameID)
+			return nil
+		}
+	default:
+		err = errors.ErrUnknownAction
+	}
+	if err != nil {
+		_ = s.SendMessage(player, map[string]interface{}{"ok": false, "message": err.Error()})
+		return err
+	}
+	return s.SendGameInfo(ctx, GameID, player, true, "")
+}
+
+// SetConnGame подключает сокет игрока к партии и отправляет ему её состояние.
+// Если партией владеет другой узел, подключение передаётся ему через шину.
+func (s *Service) SetConnGame(ctx context.Context, GameID uuid.UUID, player *dto.PlayerConn) error {
+	user, err := s.UserByID(ctx, player.UserID)
 	if err != nil {
-		return false
+		return err
+	}
+	player.AutoQueen = user.AutoQueen
+
+	owned, err := s.ownGame(ctx, GameID)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		match, err := s.GameByID(ctx, GameID)
+		if err != nil {
+			return err
+		}
+		if match.WhiteUser.ID != player.UserID && match.BlackUser.ID != player.UserID {
+			return errors.ErrPlayerNotFound
+		}
+		s.registerSocket(GameID, player)
+		return s.PublishEvent(&dto.Event{
+			Type:      dto.EventConnect,
+			Node:      s.NodeID(),
+			GameID:    GameID,
+			UserID:    player.UserID,
+			AutoQueen: player.AutoQueen,
+		})
 	}
 
-	errMove := s.MoveGame(gameID, move, player)
-	if errMove != nil && !exc.Is(errMove, errors.ErrGameEnd) {
-		return false
+	err = s.SetPlayer(ctx, GameID, player)
+	if err != nil {
+		return err
 	}
+	s.registerSocket(GameID, player)
+	return s.SendGameInfo(ctx, GameID, player, true, "")
+}
 
-	response, errSend := s.GetGameInfoMemory(gameID, errMove == nil, move)
-	if errSend != nil {
-		return false
+// LeaveGame отключает закрытый сокет игрока от партии
+func (s *Service) LeaveGame(GameID uuid.UUID, player *dto.PlayerConn) {
+	s.socketsMu.Lock()
+	defer s.socketsMu.Unlock()
+	if s.sockets[GameID][player.UserID] == player {
+		delete(s.sockets[GameID], player.UserID)
+	}
+	if len(s.sockets[GameID]) == 0 {
+		delete(s.sockets, GameID)
 	}
-	err = s.SendMessage(opponentMotionUser, response)
-	return err == nil
 }
 
-func (s *Service) SetConnGame(GameID uuid.UUID, player *dto.PlayerConn) error {
-	err := s.SetPlayer(GameID, player)
+// HandleGameMessage обрабатывает сообщение из сокета партии на узле-владельце
+func (s *Service) HandleGameMessage(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+	message []byte,
+) error {
+	owned, err := s.ownGame(ctx, GameID)
 	if err != nil {
 		return err
 	}
+	if !owned {
+		return s.PublishEvent(&dto.Event{
+			Type:      dto.EventMessage,
+			Node:      s.NodeID(),
+			GameID:    GameID,
+			UserID:    player.UserID,
+			AutoQueen: player.AutoQueen,
+			Payload:   message,
+		})
+	}
+	s.processGameMessage(ctx, GameID, player, message)
 	return nil
 }
 
+// processGameMessage разбирает сообщение игрока: JSON — действие, остальное — ход
+func (s *Service) processGameMessage(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+	message []byte,
+) {
+	var action dto.GameAction
+	if json.Unmarshal(message, &action) != nil || action.Action == "" {
+		action = dto.GameAction{Move: string(message)}
+	}
+	_ = s.playerAction(ctx, GameID, player, &action)
+}
+
+// playerAction выполняет действие игрока на узле-владельце партии. Действие без названия — ход.
+func (s *Service) playerAction(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+	action *dto.GameAction,
+) error {
+	if action.Action != "" {
+		return s.GameAction(ctx, GameID, action, player)
+	}
+	moveErr := s.MoveGameStr(ctx, GameID, action.Move, player)
+	_ = s.SendMoveResult(ctx, GameID, player, moveErr)
+	return moveErr
+}
+
+// SetUserRole назначает роль пользователю. Свою роль менять нельзя, чтобы администратор
+// не лишил себя прав по ошибке. Сессии пользователя отзываются: роль записана в access-токен,
+// и без этого снятые права действовали бы до его истечения.
+func (s *Service) SetUserRole(
+	ctx context.Context,
+	actorID, userID uuid.UUID,
+	role user.Role,
+) error {
+	if actorID == userID {
+		return errors.ErrOwnRole
+	}
+	if err := s.SetRole(ctx, userID, role); err != nil {
+		return err
+	}
+	return s.LogoutAll(ctx, userID)
+}
+
+// BootstrapAdmins назначает администраторами аккаунты из конфига. Аккаунт без подтверждённой
+// почты пропускается: иначе роль получил бы тот, кто первым зарегистрировал адрес.
+func (s *Service) BootstrapAdmins(ctx context.Context, emails []string) {
+	for _, email := range emails {
+		auth, err := s.UserPassword(ctx, email)
+		if err != nil {
+			s.logger.Info("admin account not found", "email", email)
+			continue
+		}
+		account, err := s.UserByID(ctx, auth.UserID)
+		if err != nil {
+			s.logger.Error(err)
+			continue
+		}
+		if !account.EmailVerified {
+			s.logger.Info("admin account email is not verified", "email", email)
+			continue
+		}
+		if account.Role == user.RoleAdmin {
+			continue
+		}
+		if err := s.SetRole(ctx, account.ID, user.RoleAdmin); err != nil {
+			s.logger.Error(err)
+			continue
+		}
+		s.logger.Info("admin role granted", "email", email)
+	}
+}
+
+// UpgradeToBot переводит аккаунт в боты. Ботом становится только аккаунт без партий,
+// чтобы партии людей не смешивались с партиями программ.
+func (s *Service) UpgradeToBot(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.UserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Bot {
+		return errors.ErrAlreadyBot
+	}
+	games, err := s.GamesByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(games) > 0 {
+		return errors.ErrBotHasGames
+	}
+	return s.SetBot(ctx, userID)
+}
+
+// RequireBot возвращает ErrNotBot, если аккаунт не является ботом
+func (s *Service) RequireBot(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.UserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.Bot {
+		return errors.ErrNotBot
+	}
+	return nil
+}
+
+// BotGameAction выполняет ход или действие бота по тем же правилам и часам, что и из сокета.
+// Если партией владеет другой узел, действие передаётся ему через шину и возвращается false:
+// результат придёт в поток партии.
+func (s *Service) BotGameAction(
+	ctx context.Context,
+	GameID uuid.UUID,
+	userID uuid.UUID,
+	action *dto.GameAction,
+) (bool, error) {
+	if err := s.RequireBot(ctx, userID); err != nil {
+		return false, err
+	}
+	owned, err := s.ownGame(ctx, GameID)
+	if err != nil {
+		return false, err
+	}
+	if !owned {
+		payload, err := json.Marshal(action)
+		if err != nil {
+			s.logger.Error(err)
+			return false, err
+		}
+		return false, s.PublishEvent(&dto.Event{
+			Type:    dto.EventAction,
+			Node:    s.NodeID(),
+			GameID:  GameID,
+			UserID:  userID,
+			Payload: payload,
+		})
+	}
+	player := s.GameMemory(GameID).Player(userID)
+	if player == nil {
+		return false, errors.ErrPlayerNotFound
+	}
+	return true, s.playerAction(ctx, GameID, player, action)
+}
+
+// SendChallenge создаёт вызов и сообщает о нём вызванному в поток событий
+func (s *Service) SendChallenge(
+	ctx context.Context,
+	userID uuid.UUID,
+	data *dto.CreateChallenge,
+) (*dto.Challenge, error) {
+	if err := s.checkChallengePlayers(ctx, userID, data.DestUserID); err != nil {
+		return nil, err
+	}
+	item, err := s.CreateChallenge(ctx, userID, data)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyUser(item.DestUserID, map[string]interface{}{"type": "challenge", "challenge": item})
+	return item, nil
+}
+
+// AcceptChallenge принимает вызов, создаёт партию и сообщает о её начале обоим игрокам.
+// Если партию создать не удалось, вызов остаётся принятым без партии.
+func (s *Service) AcceptChallenge(
+	ctx context.Context,
+	userID, challengeID uuid.UUID,
+) (*dto.Challenge, error) {
+	pending, err := s.Challenge(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	// Почту проверяем до ответа, чтобы отказ не закрыл вызов
+	if pending.DestUserID == userID {
+		err := s.checkChallengePlayers(ctx, pending.ChallengerID, pending.DestUserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	item, err := s.AnswerChallenge(ctx, userID, challengeID, challenge.StatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+	white, black := challengePlayers(item)
+	game, err := s.CreateGame(ctx, white, black)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.SetChallengeGame(ctx, item.ID, game.ID); err != nil {
+		s.logger.Error(err)
+	}
+	item.GameID = &game.ID
+	s.notifyGameStart(game.ID, white, black)
+	return item, nil
+}
+
+// checkChallengePlayers проверяет, что игроки могут сыграть партию по вызову. Партия двух людей,
+// как и из очереди поиска, доступна только с подтверждённой почтой у обоих.
+func (s *Service) checkChallengePlayers(ctx context.Context, challengerID, destID uuid.UUID) error {
+	challenger, err := s.UserByID(ctx, challengerID)
+	if err != nil {
+		return err
+	}
+	dest, err := s.UserByID(ctx, destID)
+	if err != nil {
+		return err
+	}
+	if challenger.Bot || dest.Bot {
+		return nil
+	}
+	if !challenger.EmailVerified || !dest.EmailVerified {
+		return errors.ErrEmailUnverified
+	}
+	return nil
+}
+
+// DeclineChallenge отклоняет вызов и сообщает об этом вызвавшему
+func (s *Service) DeclineChallenge(
+	ctx context.Context,
+	userID, challengeID uuid.UUID,
+) (*dto.Challenge, error) {
+	item, err := s.AnswerChallenge(ctx, userID, challengeID, challenge.StatusDeclined)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyUser(
+		item.ChallengerID,
+		map[string]interface{}{"type": "challengeDeclined", "challenge": item},
+	)
+	return item, nil
+}
+
+// CancelChallenge отменяет вызов и сообщает об этом вызванному
+func (s *Service) CancelChallenge(
+	ctx context.Context,
+	userID, challengeID uuid.UUID,
+) (*dto.Challenge, error) {
+	item, err := s.AnswerChallenge(ctx, userID, challengeID, challenge.StatusCanceled)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyUser(
+		item.DestUserID,
+		map[string]interface{}{"type": "challengeCanceled", "challenge": item},
+	)
+	return item, nil
+}
+
+// OpenEventStream открывает поток событий пользователя на этом узле. В поток сразу попадают
+// ожидающие входящие вызовы, чтобы переподключившийся бот их не пропустил.
+func (s *Service) OpenEventStream(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*dto.EventStream, error) {
+	pending, err := s.PendingChallenges(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	stream := dto.NewEventStream()
+	s.streamsMu.Lock()
+	if s.streams[userID] == nil {
+		s.streams[userID] = make(map[*dto.EventStream]struct{})
+	}
+	s.streams[userID][stream] = struct{}{}
+	s.streamsMu.Unlock()
+	for _, item := range pending {
+		if item.DestUserID != userID {
+			continue
+		}
+		payload, err := json.Marshal(map[string]interface{}{"type": "challenge", "challenge": item})
+		if err != nil {
+			s.logger.Error(err)
+			continue
+		}
+		stream.Send(payload)
+	}
+	return stream, nil
+}
+
+// CloseEventStream закрывает поток событий пользователя
+func (s *Service) CloseEventStream(userID uuid.UUID, stream *dto.EventStream) {
+	s.streamsMu.Lock()
+	delete(s.streams[userID], stream)
+	if len(s.streams[userID]) == 0 {
+		delete(s.streams, userID)
+	}
+	s.streamsMu.Unlock()
+	stream.Close()
+}
+
+// notifyUser отправляет событие в потоки пользователя на всех узлах
+func (s *Service) notifyUser(userID uuid.UUID, message map[string]interface{}) {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		s.logger.Error(err)
+		return
+	}
+	err = s.PublishEvent(&dto.Event{
+		Type:    dto.EventUser,
+		Node:    s.NodeID(),
+		UserID:  userID,
+		Payload: payload,
+	})
+	if err != nil {
+		s.logger.Error(err)
+	}
+}
+
+// notifyGameStart сообщает обоим игрокам о начале партии и их цвете
+func (s *Service) notifyGameStart(gameID uuid.UUID, white, black uuid.UUID) {
+	for userID, color := range map[uuid.UUID]string{white: "white", black: "black"} {
+		s.notifyUser(userID, map[string]interface{}{
+			"type": "gameStart",
+			"game": map[string]interface{}{"id": gameID, "color": color},
+		})
+	}
+}
+
+// localStreams возвращает потоки событий пользователя, открытые на этом узле
+func (s *Service) localStreams(userID uuid.UUID) []*dto.EventStream {
+	s.streamsMu.RLock()
+	defer s.streamsMu.RUnlock()
+	streams := make([]*dto.EventStream, 0, len(s.streams[userID]))
+	for stream := range s.streams[userID] {
+		streams = append(streams, stream)
+	}
+	return streams
+}
+
+// ownGame захватывает партию узлом и загружает её в память, подключая открытые на узле сокеты.
+// Возвращает false, если партией владеет другой узел.
+func (s *Service) ownGame(ctx context.Context, GameID uuid.UUID) (bool, error) {
+	if !s.AcquireGame(ctx, GameID) {
+		s.DropGame(GameID)
+		return false, nil
+	}
+	if s.GameMemory(GameID) != nil {
+		return true, nil
+	}
+	_, err := s.GameDB(ctx, GameID)
+	if err != nil {
+		s.ReleaseGame(ctx, GameID)
+		return false, err
+	}
+	s.socketsMu.RLock()
+	players := make([]*dto.PlayerConn, 0, len(s.sockets[GameID]))
+	for _, player := range s.sockets[GameID] {
+		players = append(players, player)
+	}
+	s.socketsMu.RUnlock()
+	for _, player := range players {
+		_ = s.SetPlayer(ctx, GameID, player)
+	}
+	return true, nil
+}
+
+func (s *Service) registerSocket(GameID uuid.UUID, player *dto.PlayerConn) {
+	s.socketsMu.Lock()
+	defer s.socketsMu.Unlock()
+	if s.sockets[GameID] == nil {
+		s.sockets[GameID] = make(map[uuid.UUID]*dto.PlayerConn)
+	}
+	s.sockets[GameID][player.UserID] = player
+}
+
+func (s *Service) localSocket(GameID uuid.UUID, userID uuid.UUID) *dto.PlayerConn {
+	s.socketsMu.RLock()
+	defer s.socketsMu.RUnlock()
+	return s.sockets[GameID][userID]
+}
+
+// SendMessage отправляет сообщение игроку в сокет или поток на этом узле или через шину на узел,
+// где открыт его сокет
+func (s *Service) SendMessage(player *dto.PlayerConn, message map[string]interface{}) error {
+	if player.Conn != nil || player.Stream != nil {
+		return s.IMatchService.SendMessage(player, message)
+	}
+	if player.Remote == nil {
+		return errors.ErrPlayersNotConn
+	}
+	payload, err := json.Marshal(message)
+	if err != nil {
+		s.logger.Error(err)
+		return err
+	}
+	return s.PublishEvent(&dto.Event{
+		Type:    dto.EventDeliver,
+		Node:    player.Remote.Node,
+		GameID:  player.Remote.GameID,
+		UserID:  player.UserID,
+		Payload: payload,
+	})
+}
+
+// handleEvent обрабатывает события других узлов
+func (s *Service) handleEvent(event *dto.Event) {
+	ctx := context.Background()
+	switch event.Type {
+	case dto.EventMatched:
+		if event.Node != s.NodeID() {
+			return
+		}
+		var message map[string]interface{}
+		if err := json.Unmarshal(event.Payload, &message); err != nil {
+			s.logger.Error(err)
+			return
+		}
+		s.FinishSearch(event.UserID, message)
+	case dto.EventDeliver:
+		if event.Node != s.NodeID() {
+			return
+		}
+		player := s.localSocket(event.GameID, event.UserID)
+		if player == nil {
+			return
+		}
+		var message map[string]interface{}
+		if err := json.Unmarshal(event.Payload, &message); err != nil {
+			s.logger.Error(err)
+			return
+		}
+		_ = s.IMatchService.SendMessage(player, message)
+	case dto.EventConnect, dto.EventMessage:
+		if !s.OwnsGame(event.GameID) || s.GameMemory(event.GameID) == nil {
+			return
+		}
+		remote := &dto.PlayerConn{
+			UserID:    event.UserID,
+			AutoQueen: event.AutoQueen,
+			Remote:    &dto.RemoteSocket{Node: event.Node, GameID: event.GameID},
+		}
+		if err := s.SetPlayer(ctx, event.GameID, remote); err != nil {
+			return
+		}
+		if event.Type == dto.EventConnect {
+			_ = s.SendGameInfo(ctx, event.GameID, remote, true, "")
+			return
+		}
+		s.processGameMessage(ctx, event.GameID, remote, event.Payload)
+	case dto.EventAction:
+		// Поток партии бота уже подключён, поэтому его узел не меняется
+		if !s.OwnsGame(event.GameID) {
+			return
+		}
+		game := s.GameMemory(event.GameID)
+		if game == nil || game.Player(event.UserID) == nil {
+			return
+		}
+		var action dto.GameAction
+		if err := json.Unmarshal(event.Payload, &action); err != nil {
+			s.logger.Error(err)
+			return
+		}
+		_ = s.playerAction(ctx, event.GameID, game.Player(event.UserID), &action)
+	case dto.EventUser:
+		for _, stream := range s.localStreams(event.UserID) {
+			stream.Send(event.Payload)
+		}
+	}
+}
+
+// WatchLeases продлевает аренды идущих партий и выгружает партии, которыми завладел другой узел
+func (s *Service) WatchLeases() {
+	ctx := context.Background()
+	ticker := time.NewTicker(leaseCheckPeriod)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, gameID := range s.ActiveGames() {
+			if !s.AcquireGame(ctx, gameID) {
+				s.DropGame(gameID)
+			}
+		}
+	}
+}
+
 func (s *Service) GetGameInfoMemory(
+	ctx context.Context,
 	GameID uuid.UUID,
 	ok bool,
 	move string,
 ) (map[string]interface{}, error) {
-	game, err := s.GameDB(GameID)
+	game, err := s.GameDB(ctx, GameID)
 	if err != nil {
 		return nil, err
 	}
 	answer := map[string]interface{}{
-		"ok":          ok,
-		"status":      game.Status,
-		"historyMove": game.HistoryMove,
-		"currentMove": game.CurrentMotion,
-		"result":      game.Result,
-		"WhiteUserId": game.WhitePlayer.UserID,
-		"BlackUserId": game.BlackPlayer.UserID,
+		"ok":            ok,
+		"status":        game.Status,
+		"historyMove":   game.HistoryMove,
+		"currentMove":   game.CurrentMotion,
+		"result":        game.Result,
+		"termination":   game.Termination,
+		"WhiteUserId":   game.WhitePlayer.UserID,
+		"BlackUserId":   game.BlackPlayer.UserID,
+		"whiteTime":     game.Clock.White.Milliseconds(),
+		"blackTime":     game.Clock.Black.Milliseconds(),
+		"eligibleDraws": s.EligibleDraws(GameID),
+	}
+	if game.DrawOffer != uuid.Nil {
+		answer["drawOffer"] = game.DrawOffer
 	}
 	if !ok {
 		answer["message"] = "Недопустимый ход"
 	}
+	if game.Resumed {
+		answer["resumed"] = true
+	}
 	if move != "" {
 		answer["move"] = move
 	}
 
 	return answer, nil
 }
+
+// SendGameInfo отправляет игроку состояние партии вместе с его предходами
+func (s *Service) SendGameInfo(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+	ok bool,
+	move string,
+) error {
+	response, err := s.GetGameInfoMemory(ctx, GameID, ok, move)
+	if err != nil {
+		return err
+	}
+	response["premoves"] = s.Premoves(GameID, player.UserID)
+	return s.SendMessage(player, response)
+}
+
+// SendMoveResult отправляет игроку состояние партии после его хода и причину отказа, если ход не принят
+func (s *Service) SendMoveResult(
+	ctx context.Context,
+	GameID uuid.UUID,
+	player *dto.PlayerConn,
+	moveErr error,
+) error {
+	response, err := s.GetGameInfoMemory(ctx, GameID, moveErr == nil, "")
+	if err != nil {
+		return err
+	}
+	response["premoves"] = s.Premoves(GameID, player.UserID)
+	if moveErr != nil {
+		response["reason"] = errors.MoveRejectReason(moveErr)
+	}
+	return s.SendMessage(player, response)
+}
+
+// BroadcastGameInfo отправляет состояние партии обоим подключённым игрокам
+func (s *Service) BroadcastGameInfo(ctx context.Context, GameID uuid.UUID) {
+	game := s.GameMemory(GameID)
+	if game == nil {
+		return
+	}
+	for _, player := range []*dto.PlayerConn{game.WhitePlayer, game.BlackPlayer} {
+		if player.Connected() {
+			_ = s.SendGameInfo(ctx, GameID, player, true, "")
+		}
+	}
+}
+
+// WatchClocks периодически завершает партии с упавшим флагом, прерывает партии без первого хода
+// и оповещает игроков
+func (s *Service) WatchClocks() {
+	ctx := context.Background()
+	ticker := time.NewTicker(clockCheckPeriod)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, gameID := range s.FlagExpired() {
+			s.BroadcastGameInfo(ctx, gameID)
+		}
+		for _, gameID := range s.AbortUnstarted() {
+			if game := s.GameMemory(gameID); game != nil {
+				s.trackAbort(ctx, game.GetCurrentUser().UserID)
+			}
+			s.BroadcastGameInfo(ctx, gameID)
+		}
+	}
+}
+
+// WatchGames периодически выгружает из памяти завершённые и неактивные партии и освобождает их аренды
+func (s *Service) WatchGames() {
+	ctx := context.Background()
+	ticker := time.NewTicker(gameEvictPeriod)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, gameID := range s.EvictGames() {
+			s.ReleaseGame(ctx, gameID)
+		}
+	}
+}
+
+// WatchRevocations периодически загружает отозванные сессии в память узла
+func (s *Service) WatchRevocations() {
+	ctx := context.Background()
+	ticker := time.NewTicker(revocationSyncPeriod)
+	defer ticker.Stop()
+	for ; ; <-ticker.C {
+		if err := s.SyncRevocations(ctx); err != nil {
+			s.logger.Error(err)
+		}
+	}
+}
+
+// WatchKeys периодически перечитывает каталог ключей подписи, чтобы подхватить новые ключи
+// и снять удалённые без перезапуска
+func (s *Service) WatchKeys() {
+	ticker := time.NewTicker(keysReloadPeriod)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := s.ReloadKeys(); err != nil {
+			s.logger.Error(err)
+		}
+	}
+}
+
+// WatchLoginFailures периодически удаляет счётчики неудачных входов, вышедшие из окна учёта
+func (s *Service) WatchLoginFailures() {
+	ctx := context.Background()
+	ticker := time.NewTicker(loginPrunePeriod)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := s.PruneLoginFailures(ctx); err != nil {
+			s.logger.Error(err)
+		}
+	}
+}
+
+// Stats возвращает состояние партий в памяти узла
+func (s *Service) Stats() *dto.GameStats {
+	stats := s.GameStats()
+	stats.Node = s.NodeID()
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	stats.HeapAllocBytes = memStats.HeapAlloc
+	return stats
+}
+
+// RecoverGames восстанавливает идущие партии после перезапуска. Партии, которыми владеет другой узел,
+// пропускаются.
+func (s *Service) RecoverGames() {
+	ctx := context.Background()
+	ids, err := s.InProgressGames(ctx)
+	if err != nil {
+		return
+	}
+	recovered := 0
+	for _, gameID := range ids {
+		if !s.AcquireGame(ctx, gameID) {
+			continue
+		}
+		if err := s.RecoverGame(ctx, gameID); err != nil {
+			s.logger.Error(err)
+			s.ReleaseGame(ctx, gameID)
+			continue
+		}
+		recovered++
+	}
+	s.logger.Info("games recovered", "count", recovered)
+}
+
+// Shutdown сообщает всем сокетам и потокам узла о перезапуске сервера, закрывает их
+// и освобождает аренды партий, чтобы их сразу подхватил другой узел
+func (s *Service) Shutdown() {
+	ctx := context.Background()
+	message := map[string]interface{}{"serverRestarting": true}
+	s.DrainSearch(ctx, message)
+
+	s.socketsMu.Lock()
+	players := make([]*dto.PlayerConn, 0)
+	for _, gameSockets := range s.sockets {
+		for _, player := range gameSockets {
+			players = append(players, player)
+		}
+	}
+	s.sockets = make(map[uuid.UUID]map[uuid.UUID]*dto.PlayerConn)
+	s.socketsMu.Unlock()
+	for _, player := range players {
+		_ = s.IMatchService.SendMessage(player, message)
+		_ = s.CloseConnection(player)
+	}
+
+	s.streamsMu.Lock()
+	streams := make([]*dto.EventStream, 0)
+	for _, userStreams := range s.streams {
+		for stream := range userStreams {
+			streams = append(streams, stream)
+		}
+	}
+	s.streams = make(map[uuid.UUID]map[*dto.EventStream]struct{})
+	s.streamsMu.Unlock()
+	if payload, err := json.Marshal(message); err == nil {
+		for _, stream := range streams {
+			stream.Send(payload)
+			stream.Close()
+		}
+	}
+
+	for _, gameID := range s.ActiveGames() {
+		s.ReleaseGame(ctx, gameID)
+	}
+}
+
+// trackAbort учитывает прерванную игроком партию для матчмейкинга
+func (s *Service) trackAbort(ctx context.Context, userID uuid.UUID) {
+	err := s.IncrementAborts(ctx, userID)
+	if err != nil {
+		s.logger.Error(err)
+	}
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+)
+
+const (
+	// Окно учёта неудачных входов: счётчик без новых неудач дольше окна начинается заново,
+	// поэтому после блокировки следующая неудача снова блокирует вход
+	loginFailureWindow = time.Hour
+	loginMaxDelay      = time.Minute      // Наибольшая пауза между попытками до блокировки
+	loginLockout       = 15 * time.Minute // Время блокировки после серии неудач
+	// Число попыток ввода кода 2FA по одному токену второго шага, после которого токен сгорает
+	twoFactorChallengeAttempts = 5
+	twoFactorChallengePrefix   = "2fa-challenge:"
+)
+
+// loginPolicy первые free неудач проходят без пауз, дальше пауза удваивается от секунды,
+// а с lockout неудач вход блокируется на loginLockout
+type loginPolicy struct {
+	prefix  string
+	free    int
+	lockout int
+}
+
+var (
+	emailLoginPolicy = loginPolicy{prefix: "email:", free: 3, lockout: 10}
+	// С одного адреса входят пользователи за общим NAT, поэтому порог выше
+	ipLoginPolicy = loginPolicy{prefix: "ip:", free: 20, lockout: 50}
+	// Код 2FA перебирается по пользователю через новые токены второго шага, поэтому счётчик общий
+	twoFactorLoginPolicy = loginPolicy{prefix: "2fa:", free: 3, lockout: 10}
+)
+
+func (p loginPolicy) key(value string) string {
+	return p.prefix + value
+}
+
+// delay пауза после count неудач подряд
+func (p loginPolicy) delay(count int) time.Duration {
+	switch {
+	case count >= p.lockout:
+		return loginLockout
+	case count <= p.free:
+		return 0
+	}
+	delay := time.Second << (count - p.free - 1)
+	if delay <= 0 || delay > loginMaxDelay {
+		return loginMaxDelay
+	}
+	return delay
+}
+
+// LoginThrottleService ограничивает попытки входа по почте и по адресу клиента. Счётчики хранятся
+// в репозитории, поэтому ограничения общие для всех узлов.
+type LoginThrottleService struct {
+	log        interfaces.ILogger
+	repository interfaces.ILoginFailureRepo
+}
+
+func NewLoginThrottleService(
+	log interfaces.ILogger,
+	repository interfaces.ILoginFailureRepo,
+) interfaces.ILoginThrottleService {
+	return &LoginThrottleService{log: log, repository: repository}
+}
+
+// retryAfter время до следующей попытки по счётчику failure
+func (p loginPolicy) retryAfter(failure *dto.LoginFailures, now time.Time) time.Duration {
+	if failure.LastFailedAt.Before(now.Add(-loginFailureWindow)) {
+		return 0
+	}
+	return max(failure.LastFailedAt.Add(p.delay(failure.Count)).Sub(now), 0)
+}
+
+// loginRetryAfter возвращает, сколько ждать до следующей попытки входа. Ноль — вход разрешён.
+func (s *LoginThrottleService) loginRetryAfter(
+	ctx context.Context,
+	email, ip string,
+) (time.Duration, error) {
+	emailKey, ipKey := emailLoginPolicy.key(normalizeEmail(email)), ipLoginPolicy.key(ip)
+	failures, err := s.repository.LoginFailures(ctx, emailKey, ipKey)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	var retryAfter time.Duration
+	for _, failure := range failures {
+		policy := emailLoginPolicy
+		if failure.Key == ipKey {
+			policy = ipLoginPolicy
+		}
+		retryAfter = max(retryAfter, policy.retryAfter(failure, now))
+	}
+	return retryAfter, nil
+}
+
+// LoginAttempt учитывает попытку входа по почте до проверки пароля и возвращает её номер в окне учёта.
+// Счётчик увеличивается атомарно, поэтому одновременные попытки получают разные номера и сверх
+// блокировки не проходят. Пока вход ограничен, возвращает ErrLoginThrottled и время ожидания.
+func (s *LoginThrottleService) LoginAttempt(
+	ctx context.Context,
+	email, ip string,
+) (int, time.Duration, error) {
+	retryAfter, err := s.loginRetryAfter(ctx, email, ip)
+	if err != nil {
+		return 0, 0, err
+	}
+	if retryAfter > 0 {
+		return 0, retryAfter, errors.ErrLoginThrottled
+	}
+	failure, err := s.repository.AddLoginFailure(
+		ctx,
+		emailLoginPolicy.key(normalizeEmail(email)),
+		time.Now(),
+		loginFailureWindow,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	if failure.Count > emailLoginPolicy.lockout {
+		return 0, loginLockout, errors.ErrLoginThrottled
+	}
+	return failure.Count, 0, nil
+}
+
+// LoginFailed учитывает неудачный вход по адресу клиента; по почте попытка attempt уже учтена.
+// Возвращает true, если эта неудача заблокировала вход по почте, чтобы предупредить владельца
+// аккаунта.
+func (s *LoginThrottleService) LoginFailed(
+	ctx context.Context,
+	attempt int,
+	email, ip string,
+) (bool, error) {
+	locked := attempt == emailLoginPolicy.lockout
+	if locked {
+		s.log.Info(
+			"login locked",
+			"key", emailLoginPolicy.key(normalizeEmail(email)),
+			"failures", attempt,
+			"ip", ip,
+		)
+	}
+	failure, err := s.repository.AddLoginFailure(
+		ctx,
+		ipLoginPolicy.key(ip),
+		time.Now(),
+		loginFailureWindow,
+	)
+	if err != nil {
+		return locked, err
+	}
+	if failure.Count == ipLoginPolicy.lockout {
+		s.log.Info("login locked", "key", failure.Key, "failures", failure.Count, "ip", ip)
+	}
+	return locked, nil
+}
+
+// LoginSucceeded сбрасывает счётчик почты вместе с попыткой, учтённой до проверки пароля.
+// Счётчик адреса не сбрасывается, чтобы вход в свой аккаунт не открывал перебор чужих с того же
+// адреса.
+func (s *LoginThrottleService) LoginSucceeded(ctx context.Context, email string) error {
+	return s.repository.ClearLoginFailures(ctx, emailLoginPolicy.key(normalizeEmail(email)))
+}
+
+// TwoFactorAttempt учитывает попытку ввода кода 2FA до проверки кода. Попытка считается неудачной,
+// пока вход не завершится TwoFactorSucceeded, а счётчики увеличиваются атомарно, поэтому
+// одновременные попытки не проходят сверх лимита. Возвращает ErrLoginThrottled и время ожидания,
+// пока ввод кодов пользователя ограничен, и ErrChallengeExhausted, если токен второго шага сгорел.
+func (s *LoginThrottleService) TwoFactorAttempt(
+	ctx context.Context,
+	userID, challengeID uuid.UUID,
+) (time.Duration, error) {
+	now := time.Now()
+	retryAfter, err := s.twoFactorRetryAfter(ctx, userID, now)
+	if err != nil {
+		return 0, err
+	}
+	if retryAfter > 0 {
+		return retryAfter, errors.ErrLoginThrottled
+	}
+	challenge, err := s.repository.AddLoginFailure(
+		ctx,
+		twoFactorChallengePrefix+challengeID.String(),
+		now,
+		loginFailureWindow,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if challenge.Count > twoFactorChallengeAttempts {
+		return 0, errors.ErrChallengeExhausted
+	}
+	return s.addTwoFactorFailure(ctx, userID, now)
+}
+
+// TwoFactorCodeAttempt учитывает попытку ввода кода 2FA вне входа, например при отключении 2FA.
+// Счётчик общий с TwoFactorAttempt, поэтому перебор кода не обходит ограничение входа.
+func (s *LoginThrottleService) TwoFactorCodeAttempt(
+	ctx context.Context,
+	userID uuid.UUID,
+) (time.Duration, error) {
+	now := time.Now()
+	retryAfter, err := s.twoFactorRetryAfter(ctx, userID, now)
+	if err != nil {
+		return 0, err
+	}
+	if retryAfter > 0 {
+		return retryAfter, errors.ErrLoginThrottled
+	}
+	return s.addTwoFactorFailure(ctx, userID, now)
+}
+
+// twoFactorRetryAfter время до следующей попытки ввода кода 2FA пользователем
+func (s *LoginThrottleService) twoFactorRetryAfter(
+	ctx context.Context,
+	userID uuid.UUID,
+	now time.Time,
+) (time.Duration, error) {
+	failures, err := s.repository.LoginFailures(ctx, twoFactorLoginPolicy.key(userID.String()))
+	if err != nil {
+		return 0, err
+	}
+	var retryAfter time.Duration
+	for _, failure := range failures {
+		retryAfter = max(retryAfter, twoFactorLoginPolicy.retryAfter(failure, now))
+	}
+	return retryAfter, nil
+}
+
+// addTwoFactorFailure увеличивает счётчик кодов 2FA пользователя
+func (s *LoginThrottleService) addTwoFactorFailure(
+	ctx context.Context,
+	userID uuid.UUID,
+	now time.Time,
+) (time.Duration, error) {
+	failure, err := s.repository.AddLoginFailure(
+		ctx,
+		twoFactorLoginPolicy.key(userID.String()),
+		now,
+		loginFailureWindow,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if failure.Count > twoFactorLoginPolicy.lockout {
+		return loginLockout, errors.ErrLoginThrottled
+	}
+	if failure.Count == twoFactorLoginPolicy.lockout {
+		s.log.Info("two-factor login locked", "user", userID, "failures", failure.Count)
+	}
+	return 0, nil
+}
+
+// TwoFactorSucceeded сбрасывает счётчик кодов 2FA пользователя
+func (s *LoginThrottleService) TwoFactorSucceeded(ctx context.Context, userID uuid.UUID) error {
+	return s.repository.ClearLoginFailures(ctx, twoFactorLoginPolicy.key(userID.String()))
+}
+
+// PruneLoginFailures удаляет счётчики, вышедшие из окна учёта
+func (s *LoginThrottleService) PruneLoginFailures(ctx context.Context) error {
+	return s.repository.PruneLoginFailures(ctx, time.Now().Add(-loginFailureWindow))
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
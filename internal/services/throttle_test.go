@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	exc "errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+	"github.com/google/uuid"
+)
+
+func TestLoginPolicyDelay(t *testing.T) {
+	policy := loginPolicy{prefix: "test:", free: 3, lockout: 10}
+	tests := []struct {
+		count int
+		want  time.Duration
+	}{
+		{0, 0},
+		{3, 0},
+		{4, time.Second},
+		{5, 2 * time.Second},
+		{8, 16 * time.Second},
+		{9, 32 * time.Second},
+		{10, loginLockout},
+		{25, loginLockout},
+	}
+	for _, tt := range tests {
+		if got := policy.delay(tt.count); got != tt.want {
+			t.Errorf("delay(%d) = %v, want %v", tt.count, got, tt.want)
+		}
+	}
+
+	// Пауза не растёт больше loginMaxDelay, даже если сдвиг переполняется
+	wide := loginPolicy{prefix: "test:", free: 0, lockout: 1000}
+	for _, count := range []int{7, 8, 64, 100} {
+		if got := wide.delay(count); got != loginMaxDelay {
+			t.Errorf("delay(%d) = %v, want %v", count, got, loginMaxDelay)
+		}
+	}
+}
+
+func newThrottle() *LoginThrottleService {
+	return NewLoginThrottleService(
+		logger.New(dto.Application{Env: "prod"}),
+		repository.NewMemoryLoginFailureRepository(),
+	).(*LoginThrottleService)
+}
+
+func TestTwoFactorAttempt(t *testing.T) {
+	ctx := context.Background()
+	throttle := newThrottle()
+	userID, challengeID := uuid.New(), uuid.New()
+	for attempt := 1; attempt <= twoFactorLoginPolicy.free+1; attempt++ {
+		if _, err := throttle.TwoFactorAttempt(ctx, userID, challengeID); err != nil {
+			t.Fatalf("attempt %d: %v", attempt, err)
+		}
+	}
+	retryAfter, err := throttle.TwoFactorAttempt(ctx, userID, uuid.New())
+	if !exc.Is(err, errors.ErrLoginThrottled) || retryAfter <= 0 {
+		t.Fatalf("attempt after free ones = %v, %v, want throttled", retryAfter, err)
+	}
+
+	if err := throttle.TwoFactorSucceeded(ctx, userID); err != nil {
+		t.Fatalf("TwoFactorSucceeded: %v", err)
+	}
+	// Счётчик пользователя сброшен, но токен второго шага сгорает после лимита кодов
+	if _, err := throttle.TwoFactorAttempt(ctx, userID, challengeID); err != nil {
+		t.Fatalf("attempt after success: %v", err)
+	}
+	_, err = throttle.TwoFactorAttempt(ctx, userID, challengeID)
+	if !exc.Is(err, errors.ErrChallengeExhausted) {
+		t.Fatalf(
+			"attempt over the challenge limit = %v, want %v",
+			err,
+			errors.ErrChallengeExhausted,
+		)
+	}
+}
+
+func TestTwoFactorAttemptConcurrent(t *testing.T) {
+	ctx := context.Background()
+	throttle := newThrottle()
+	userID := uuid.New()
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for range 4 * twoFactorLoginPolicy.lockout {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := throttle.TwoFactorAttempt(ctx, userID, uuid.New()); err == nil {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := int(allowed.Load()); got > twoFactorLoginPolicy.lockout {
+		t.Fatalf(
+			"concurrent attempts allowed = %d, want at most %d",
+			got,
+			twoFactorLoginPolicy.lockout,
+		)
+	}
+}
+
+func TestLoginAttempt(t *testing.T) {
+	ctx := context.Background()
+	throttle := newThrottle()
+	for want := 1; want <= emailLoginPolicy.free+1; want++ {
+		attempt, _, err := throttle.LoginAttempt(ctx, "Gopher@example.com", "192.0.2.1")
+		if err != nil || attempt != want {
+			t.Fatalf("attempt = %d, %v, want %d", attempt, err, want)
+		}
+		if _, err := throttle.LoginFailed(ctx, attempt, "gopher@example.com", "192.0.2.1"); err != nil {
+			t.Fatalf("LoginFailed: %v", err)
+		}
+	}
+	// Почта сравнивается без учёта регистра, адрес клиента не спасает от паузы
+	_, retryAfter, err := throttle.LoginAttempt(ctx, " gopher@EXAMPLE.com", "192.0.2.2")
+	if !exc.Is(err, errors.ErrLoginThrottled) || retryAfter <= 0 {
+		t.Fatalf("attempt after free ones = %v, %v, want throttled", retryAfter, err)
+	}
+
+	if err := throttle.LoginSucceeded(ctx, "gopher@example.com"); err != nil {
+		t.Fatalf("LoginSucceeded: %v", err)
+	}
+	if attempt, _, err := throttle.LoginAttempt(ctx, "gopher@example.com", "192.0.2.1"); err != nil ||
+		attempt != 1 {
+		t.Fatalf("attempt after success = %d, %v, want 1", attempt, err)
+	}
+}
+
+func TestLoginAttemptLocks(t *testing.T) {
+	ctx := context.Background()
+	throttle := newThrottle()
+	email := uuid.New().String() + "@example.com"
+	for attempt := 1; attempt <= emailLoginPolicy.lockout; attempt++ {
+		// Паузы между попытками проверяются отдельно, здесь считаются только неудачи
+		locked, err := throttle.LoginFailed(ctx, attempt, email, "192.0.2.1")
+		if err != nil {
+			t.Fatalf("LoginFailed: %v", err)
+		}
+		if locked != (attempt == emailLoginPolicy.lockout) {
+			t.Fatalf("attempt %d locked = %v", attempt, locked)
+		}
+	}
+}
+
+func TestLoginAttemptConcurrent(t *testing.T) {
+	ctx := context.Background()
+	throttle := newThrottle()
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for i := range 4 * emailLoginPolicy.lockout {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ip := fmt.Sprintf("192.0.2.%d", i)
+			if _, _, err := throttle.LoginAttempt(ctx, "gopher@example.com", ip); err == nil {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := int(allowed.Load()); got > emailLoginPolicy.lockout {
+		t.Fatalf("concurrent attempts allowed = %d, want at most %d", got, emailLoginPolicy.lockout)
+	}
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/interfaces"
+	"github.com/google/uuid"
+	"github.com/skip2/go-qrcode"
+)
+
+// Параметры TOTP по RFC 6238, которые понимают все приложения-аутентификаторы
+const (
+	totpIssuer      = "GopherChessParty"
+	totpPeriod      = 30 * time.Second
+	totpDigits      = 6
+	totpSkew        = 1 // Допустимое расхождение часов в шагах
+	totpSecretBytes = 20
+	totpQRSize      = 256
+
+	recoveryCodeCount = 10
+	recoveryCodeBytes = 5 // 8 символов base32, в ответе как xxxx-xxxx
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type TwoFactorService struct {
+	log        interfaces.ILogger
+	repository interfaces.ITwoFactorRepo
+}
+
+func NewTwoFactorService(
+	log interfaces.ILogger,
+	repository interfaces.ITwoFactorRepo,
+) interfaces.ITwoFactorService {
+	return &TwoFactorService{log: log, repository: repository}
+}
+
+func (s *TwoFactorService) TwoFactorEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	totp, err := s.repository.TOTP(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return totp.Enabled, nil
+}
+
+// EnrollTOTP создаёт новый секрет и коды восстановления. 2FA включается только после ConfirmTOTP,
+// повторная запись до подтверждения заменяет секрет.
+func (s *TwoFactorService) EnrollTOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	account string,
+) (*dto.TOTPEnrollment, error) {
+	secretBytes := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return nil, err
+	}
+	secret := base32NoPadding.EncodeToString(secretBytes)
+
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	if err := s.repository.StartTOTP(ctx, userID, secret, hashes); err != nil {
+		return nil, err
+	}
+
+	uri := provisioningURI(account, secret)
+	png, err := qrcode.Encode(uri, qrcode.Medium, totpQRSize)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: uri,
+		QRCode:          "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+		RecoveryCodes:   codes,
+	}, nil
+}
+
+// ConfirmTOTP включает 2FA, если код из приложения совпал с новым секретом
+func (s *TwoFactorService) ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	totp, err := s.repository.TOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if totp.Enabled {
+		return errors.ErrTOTPEnabled
+	}
+	if totp.Secret == "" {
+		return errors.ErrTOTPNotEnabled
+	}
+	step, ok := verifyTOTP(totp.Secret, code, time.Now())
+	if !ok {
+		return errors.ErrInvalidOTP
+	}
+	confirmed, err := s.repository.ConfirmTOTP(ctx, userID, step)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.ErrTOTPEnabled
+	}
+	return nil
+}
+
+// VerifyTOTP принимает текущий код из приложения. Код одного шага принимается один раз.
+func (s *TwoFactorService) VerifyTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	totp, err := s.repository.TOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !totp.Enabled {
+		return errors.ErrTOTPNotEnabled
+	}
+	step, ok := verifyTOTP(totp.Secret, code, time.Now())
+	if !ok {
+		return errors.ErrInvalidOTP
+	}
+	used, err := s.repository.UseTOTPStep(ctx, userID, step)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errors.ErrInvalidOTP
+	}
+	return nil
+}
+
+// VerifySecondFactor принимает код из приложения или неиспользованный код восстановления
+func (s *TwoFactorService) VerifySecondFactor(
+	ctx context.Context,
+	userID uuid.UUID,
+	code string,
+) error {
+	if isTOTPCode(code) {
+		return s.VerifyTOTP(ctx, userID, code)
+	}
+	used, err := s.repository.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errors.ErrInvalidOTP
+	}
+	s.log.Info("recovery code used", "user", userID)
+	return nil
+}
+
+func (s *TwoFactorService) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	return s.repository.DisableTOTP(ctx, userID)
+}
+
+func provisioningURI(account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", totpIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + account,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// verifyTOTP сверяет код с шагами вокруг now и возвращает совпавший шаг
+func verifyTOTP(secret, code string, now time.Time) (int64, bool) {
+	key, err := base32NoPadding.DecodeString(secret)
+	if err != nil || !isTOTPCode(code) {
+		return 0, false
+	}
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode HOTP шага step по RFC 4226
+func totpCode(key []byte, step int64) string {
+	mac := hmac.New(sha1.New, key)
+	_ = binary.Write(mac, binary.BigEndian, step)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1_000_000)
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRecoveryCode() (string, error) {
+	raw := make([]byte, recoveryCodeBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32NoPadding.EncodeToString(raw))
+	return code[:4] + "-" + code[4:], nil
+}
+
+// hashRecoveryCode хэширует код без учёта регистра и дефисов. Коды случайны, поэтому
+// медленный хэш паролей не нужен.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
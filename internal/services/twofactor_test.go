@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	exc "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"GopherChessParty/internal/dto"
+	"GopherChessParty/internal/errors"
+	"GopherChessParty/internal/logger"
+	"GopherChessParty/internal/repository"
+)
+
+func TestVerifyTOTP(t *testing.T) {
+	// Тестовый вектор RFC 6238 для SHA1, код обрезан до шести цифр
+	secret := base32NoPadding.EncodeToString([]byte("12345678901234567890"))
+	at := time.Unix(59, 0)
+	if step, ok := verifyTOTP(secret, "287082", at); !ok || step != 1 {
+		t.Fatalf("verifyTOTP = %d, %v, want step 1", step, ok)
+	}
+	// Соседний шаг допустим из-за расхождения часов, дальний — нет
+	if _, ok := verifyTOTP(secret, "287082", at.Add(totpPeriod)); !ok {
+		t.Fatal("code of the previous step was rejected")
+	}
+	if _, ok := verifyTOTP(secret, "287082", at.Add(3*totpPeriod)); ok {
+		t.Fatal("code of a distant step was accepted")
+	}
+	for _, code := range []string{"28708", "2870822", "28708a", ""} {
+		if _, ok := verifyTOTP(secret, code, at); ok {
+			t.Fatalf("malformed code %q was accepted", code)
+		}
+	}
+}
+
+func TestRecoveryCodeHash(t *testing.T) {
+	code, err := newRecoveryCode()
+	if err != nil {
+		t.Fatalf("newRecoveryCode: %v", err)
+	}
+	if len(code) != 9 || code[4] != '-' {
+		t.Fatalf("recovery code %q, want xxxx-xxxx", code)
+	}
+	// Код можно ввести без дефиса, с пробелами и в другом регистре
+	for _, typed := range []string{code[:4] + code[5:], " " + code + " ", strings.ToUpper(code)} {
+		if hashRecoveryCode(typed) != hashRecoveryCode(code) {
+			t.Fatalf("code typed as %q does not match %q", typed, code)
+		}
+	}
+	if hashRecoveryCode("aaaa-aaaa") == hashRecoveryCode("aaaa-aaab") {
+		t.Fatal("different codes have the same hash")
+	}
+}
+
+func TestTwoFactorFlow(t *testing.T) {
+	ctx := context.Background()
+	users := repository.NewMemoryUserRepository()
+	user, err := users.CreateUser(ctx, &dto.CreateUser{Name: "gopher", Email: "gopher@example.com"})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	twoFactor := NewTwoFactorService(
+		logger.New(dto.Application{Env: "prod"}),
+		repository.NewMemoryTwoFactorRepository(users),
+	)
+	enrollment, err := twoFactor.EnrollTOTP(ctx, user.ID, user.Email)
+	if err != nil {
+		t.Fatalf("EnrollTOTP: %v", err)
+	}
+	key, err := base32NoPadding.DecodeString(enrollment.Secret)
+	if err != nil {
+		t.Fatalf("secret: %v", err)
+	}
+	step := time.Now().Unix() / int64(totpPeriod.Seconds())
+
+	if err := twoFactor.VerifyTOTP(ctx, user.ID, totpCode(key, step)); !exc.Is(
+		err,
+		errors.ErrTOTPNotEnabled,
+	) {
+		t.Fatalf("VerifyTOTP before confirm = %v, want %v", err, errors.ErrTOTPNotEnabled)
+	}
+	if err := twoFactor.ConfirmTOTP(ctx, user.ID, totpCode(key, step)); err != nil {
+		t.Fatalf("ConfirmTOTP: %v", err)
+	}
+	// Код, которым подтвердили 2FA, второй раз не принимается
+	if err := twoFactor.VerifyTOTP(ctx, user.ID, totpCode(key, step)); !exc.Is(
+		err,
+		errors.ErrInvalidOTP,
+	) {
+		t.Fatalf("replayed code = %v, want %v", err, errors.ErrInvalidOTP)
+	}
+
+	recovery := enrollment.RecoveryCodes[0]
+	if err := twoFactor.VerifySecondFactor(ctx, user.ID, recovery); err != nil {
+		t.Fatalf("VerifySecondFactor with a recovery code: %v", err)
+	}
+	if err := twoFactor.VerifySecondFactor(ctx, user.ID, recovery); !exc.Is(
+		err,
+		errors.ErrInvalidOTP,
+	) {
+		t.Fatalf("reused recovery code = %v, want %v", err, errors.ErrInvalidOTP)
+	}
+}
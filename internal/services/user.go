@@ -1,6 +1,9 @@
 package services
 
 import (
+	"context"
+
+	"GopherChessParty/ent/user"
 	"GopherChessParty/internal/dto"
 	"GopherChessParty/internal/interfaces"
 	"github.com/google/uuid"
@@ -18,19 +21,55 @@ func NewUserService(
 	return &UserService{repository: repository, log: logger}
 }
 
-func (m *UserService) SaveUser(data *dto.CreateUser, hashedPassword string) (*dto.User, error) {
+func (m *UserService) SaveUser(
+	ctx context.Context,
+	data *dto.CreateUser,
+	hashedPassword string,
+) (*dto.User, error) {
 	data.Password = hashedPassword
-	return m.repository.CreateUser(data)
+	return m.repository.CreateUser(ctx, data)
+}
+
+func (m *UserService) Users(ctx context.Context) ([]*dto.User, error) {
+	return m.repository.Users(ctx)
+}
+
+func (m *UserService) UserPassword(ctx context.Context, email string) (*dto.AuthUser, error) {
+	return m.repository.UserPassword(ctx, email)
+}
+
+func (m *UserService) UserByEmail(ctx context.Context, email string) (*dto.User, error) {
+	return m.repository.UserByEmail(ctx, email)
+}
+
+func (m *UserService) UserByID(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
+	return m.repository.UserByID(ctx, userID)
+}
+
+func (m *UserService) SetAutoQueen(ctx context.Context, userID uuid.UUID, autoQueen bool) error {
+	return m.repository.SetAutoQueen(ctx, userID, autoQueen)
+}
+
+func (m *UserService) IncrementAborts(ctx context.Context, userID uuid.UUID) error {
+	return m.repository.IncrementAborts(ctx, userID)
+}
+
+func (m *UserService) SetEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	return m.repository.SetEmailVerified(ctx, userID)
 }
 
-func (m *UserService) Users() ([]*dto.User, error) {
-	return m.repository.Users()
+func (m *UserService) SetPassword(
+	ctx context.Context,
+	userID uuid.UUID,
+	hashedPassword string,
+) error {
+	return m.repository.SetPassword(ctx, userID, hashedPassword)
 }
 
-func (m *UserService) UserPassword(email string) (*dto.AuthUser, error) {
-	return m.repository.UserPassword(email)
+func (m *UserService) SetBot(ctx context.Context, userID uuid.UUID) error {
+	return m.repository.SetBot(ctx, userID)
 }
 
-func (m *UserService) UserByID(userID uuid.UUID) (*dto.User, error) {
-	return m.repository.UserByID(userID)
+func (m *UserService) SetRole(ctx context.Context, userID uuid.UUID, role user.Role) error {
+	return m.repository.SetRole(ctx, userID, role)
 }